@@ -0,0 +1,42 @@
+package atc
+
+import (
+	"regexp"
+	"strings"
+)
+
+type AcrossConfig struct {
+	// name of the local var bound to each value, e.g. go_version
+	Var string `yaml:"var" json:"var" mapstructure:"var"`
+
+	// values to run the step with, one copy of the step per value: either a
+	// list, or a ((var)) resolved to a list when the step runs
+	Values interface{} `yaml:"values" json:"values" mapstructure:"values"`
+
+	// how many copies of the step may run at once; 0 means all of them
+	MaxInFlight int `yaml:"max_in_flight,omitempty" json:"max_in_flight,omitempty" mapstructure:"max_in_flight"`
+}
+
+var acrossValuesVarRegex = regexp.MustCompile(`^\(\(([^()]+)\)\)$`)
+
+// StaticValues returns the values if they're given as a list.
+func (config AcrossConfig) StaticValues() ([]interface{}, bool) {
+	values, ok := config.Values.([]interface{})
+	return values, ok
+}
+
+// ValuesVar returns the name of the var the values are given by, if they're
+// given as a ((var)).
+func (config AcrossConfig) ValuesVar() (string, bool) {
+	str, ok := config.Values.(string)
+	if !ok {
+		return "", false
+	}
+
+	match := acrossValuesVarRegex.FindStringSubmatch(strings.TrimSpace(str))
+	if match == nil {
+		return "", false
+	}
+
+	return strings.TrimSpace(match[1]), true
+}
@@ -0,0 +1,101 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AcrossConfig", func() {
+	var config atc.AcrossConfig
+
+	BeforeEach(func() {
+		config = atc.AcrossConfig{Var: "go_version"}
+	})
+
+	Context("when the values are a list", func() {
+		BeforeEach(func() {
+			config.Values = []interface{}{"1.10", "1.11"}
+		})
+
+		It("returns them as static values", func() {
+			values, ok := config.StaticValues()
+			Expect(ok).To(BeTrue())
+			Expect(values).To(Equal([]interface{}{"1.10", "1.11"}))
+		})
+
+		It("has no values var", func() {
+			_, ok := config.ValuesVar()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when the values are a ((var))", func() {
+		BeforeEach(func() {
+			config.Values = "(( go_versions ))"
+		})
+
+		It("returns the name of the var", func() {
+			name, ok := config.ValuesVar()
+			Expect(ok).To(BeTrue())
+			Expect(name).To(Equal("go_versions"))
+		})
+
+		It("has no static values", func() {
+			_, ok := config.StaticValues()
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Context("when the values are a string other than a ((var))", func() {
+		BeforeEach(func() {
+			config.Values = "go_versions"
+		})
+
+		It("has neither static values nor a values var", func() {
+			_, ok := config.StaticValues()
+			Expect(ok).To(BeFalse())
+
+			_, ok = config.ValuesVar()
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("Plan", func() {
+	Describe("Each", func() {
+		It("visits the plan and every plan it is composed of", func() {
+			plan := atc.Plan{
+				ID: "1",
+				Do: &atc.DoPlan{
+					{
+						ID: "2",
+						Across: &atc.AcrossPlan{
+							Step: &atc.Plan{
+								ID:  "3",
+								Try: &atc.TryPlan{Step: atc.Plan{ID: "4"}},
+							},
+						},
+					},
+					{
+						ID: "5",
+						OnSuccess: &atc.OnSuccessPlan{
+							Step: atc.Plan{ID: "6"},
+							Next: atc.Plan{ID: "7"},
+						},
+					},
+				},
+			}
+
+			ids := []atc.PlanID{}
+			plan.Each(func(p *atc.Plan) {
+				ids = append(ids, p.ID)
+				p.ID += "!"
+			})
+
+			Expect(ids).To(Equal([]atc.PlanID{"1", "2", "3", "4", "5", "6", "7"}))
+			Expect((*plan.Do)[0].Across.Step.Try.Step.ID).To(Equal(atc.PlanID("4!")))
+		})
+	})
+})
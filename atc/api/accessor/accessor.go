@@ -15,11 +15,13 @@ type Access interface {
 	IsSystem() bool
 	TeamNames() []string
 	CSRFToken() string
+	UserName() string
 }
 
 type access struct {
 	*jwt.Token
-	action string
+	action      string
+	customRoles CustomRoles
 }
 
 func (a *access) IsAuthenticated() bool {
@@ -41,18 +43,22 @@ func (a *access) IsAuthorized(team string) bool {
 
 func CompareRoles(role1, role2 string) bool {
 	switch role1 {
-	case "owner":
+	case OwnerRole:
 		return true
-	case "member":
-		return role2 != "owner"
-	case "viewer":
-		return role2 == "viewer"
+	case MemberRole:
+		return role2 != OwnerRole
+	case ViewerRole:
+		return role2 == ViewerRole
 	default:
 		return false
 	}
 }
 
 func (a *access) HasPermission(role string) bool {
+	if _, isCustom := a.customRoles[role]; isCustom {
+		return a.customRoles.permits(role, a.action)
+	}
+
 	return CompareRoles(role, requiredRoles[a.action])
 }
 
@@ -86,7 +92,7 @@ func (a *access) TeamRoles() map[string][]string {
 			if teamsArr, ok := teamsClaim.([]interface{}); ok {
 				for _, teamObj := range teamsArr {
 					if teamName, ok := teamObj.(string); ok {
-						teamRoles[teamName] = []string{"owner"}
+						teamRoles[teamName] = []string{OwnerRole}
 					}
 				}
 			} else {
@@ -119,6 +125,17 @@ func (a *access) CSRFToken() string {
 	return ""
 }
 
+func (a *access) UserName() string {
+	if claims, ok := a.Token.Claims.(jwt.MapClaims); ok {
+		if userNameClaim, ok := claims["user_name"]; ok {
+			if userName, ok := userNameClaim.(string); ok {
+				return userName
+			}
+		}
+	}
+	return ""
+}
+
 var requiredRoles = map[string]string{
 	atc.SaveConfig:                    "member",
 	atc.GetConfig:                     "viewer",
@@ -128,13 +145,18 @@ var requiredRoles = map[string]string{
 	atc.ListBuilds:                    "viewer",
 	atc.BuildEvents:                   "viewer",
 	atc.BuildResources:                "viewer",
+	atc.BuildTestResults:              "viewer",
 	atc.AbortBuild:                    "member",
+	atc.CommentBuild:                  "member",
 	atc.GetBuildPreparation:           "viewer",
 	atc.GetJob:                        "viewer",
 	atc.CreateJobBuild:                "member",
+	atc.RerunJobBuild:                 "member",
 	atc.ListAllJobs:                   "viewer",
 	atc.ListJobs:                      "viewer",
 	atc.ListJobBuilds:                 "viewer",
+	atc.SearchJobLogs:                 "viewer",
+	atc.ListFlakyTests:                "viewer",
 	atc.ListJobInputs:                 "viewer",
 	atc.GetJobBuild:                   "viewer",
 	atc.PauseJob:                      "member",
@@ -152,7 +174,9 @@ var requiredRoles = map[string]string{
 	atc.UnpinResource:                 "member",
 	atc.CheckResource:                 "member",
 	atc.CheckResourceWebHook:          "member",
+	atc.ReceiveGlobalWebhook:          "viewer",
 	atc.CheckResourceType:             "member",
+	atc.ListResourceChecks:            "viewer",
 	atc.ListResourceVersions:          "viewer",
 	atc.GetResourceVersion:            "viewer",
 	atc.EnableResourceVersion:         "member",
@@ -168,10 +192,12 @@ var requiredRoles = map[string]string{
 	atc.OrderPipelines:                "member",
 	atc.PausePipeline:                 "member",
 	atc.UnpausePipeline:               "member",
+	atc.ArchivePipeline:               "member",
 	atc.ExposePipeline:                "member",
 	atc.HidePipeline:                  "member",
 	atc.RenamePipeline:                "member",
 	atc.ListPipelineBuilds:            "viewer",
+	atc.SearchPipelineLogs:            "viewer",
 	atc.CreatePipelineBuild:           "member",
 	atc.PipelineBadge:                 "viewer",
 	atc.RegisterWorker:                "member",
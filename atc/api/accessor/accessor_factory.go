@@ -17,12 +17,14 @@ type AccessFactory interface {
 }
 
 type accessFactory struct {
-	publicKey *rsa.PublicKey
+	publicKey   *rsa.PublicKey
+	customRoles CustomRoles
 }
 
-func NewAccessFactory(key *rsa.PublicKey) AccessFactory {
+func NewAccessFactory(key *rsa.PublicKey, customRoles CustomRoles) AccessFactory {
 	return &accessFactory{
-		publicKey: key,
+		publicKey:   key,
+		customRoles: customRoles,
 	}
 }
 
@@ -33,7 +35,7 @@ func (a *accessFactory) Create(r *http.Request, action string) Access {
 		token = &jwt.Token{}
 	}
 
-	return &access{token, action}
+	return &access{token, action, a.customRoles}
 }
 
 func (a *accessFactory) parseToken(r *http.Request) (*jwt.Token, error) {
@@ -29,7 +29,7 @@ var _ = Describe("AccessorFactory", func() {
 
 			publicKey := &key.PublicKey
 			//publicKey = rsa.GenerateKey(random, bits)
-			accessorFactory = accessor.NewAccessFactory(publicKey)
+			accessorFactory = accessor.NewAccessFactory(publicKey, nil)
 
 			req, err = http.NewRequest("GET", "localhost:8080", nil)
 			Expect(err).NotTo(HaveOccurred())
@@ -22,6 +22,7 @@ var _ = Describe("Accessor", func() {
 		accessorFactory accessor.AccessFactory
 		claims          *jwt.MapClaims
 		access          accessor.Access
+		customRoles     accessor.CustomRoles
 	)
 	BeforeEach(func() {
 		var err error
@@ -34,8 +35,12 @@ var _ = Describe("Accessor", func() {
 		key, err = rsa.GenerateKey(reader, bitSize)
 		Expect(err).NotTo(HaveOccurred())
 
+		customRoles = accessor.CustomRoles{
+			"pipeline-operator": {atc.PausePipeline, atc.UnpausePipeline, atc.CreateJobBuild},
+		}
+
 		publicKey := &key.PublicKey
-		accessorFactory = accessor.NewAccessFactory(publicKey)
+		accessorFactory = accessor.NewAccessFactory(publicKey, customRoles)
 
 	})
 	Describe("Is Admin", func() {
@@ -160,6 +165,57 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Is Authorized custom role action", func() {
+		var action string
+
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, action)
+		})
+
+		BeforeEach(func() {
+			claims = &jwt.MapClaims{"teams": map[string][]string{"some-team": {"pipeline-operator"}}}
+		})
+
+		Context("when the action is granted to the custom role", func() {
+			BeforeEach(func() {
+				action = atc.PausePipeline
+			})
+
+			It("returns true", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeTrue())
+			})
+
+			It("returns false for other teams", func() {
+				Expect(access.IsAuthorized("some-other-team")).To(BeFalse())
+			})
+		})
+
+		Context("when the action is not granted to the custom role", func() {
+			BeforeEach(func() {
+				action = atc.SaveConfig
+			})
+
+			It("returns false", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+
+		Context("when the action is only granted to built-in roles", func() {
+			BeforeEach(func() {
+				action = atc.GetPipeline
+			})
+
+			It("returns false", func() {
+				Expect(access.IsAuthorized("some-team")).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Is Authorized owner action", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -270,6 +326,41 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("Get User Name", func() {
+		JustBeforeEach(func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+			tokenString, err := token.SignedString(key)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", fmt.Sprintf("BEARER %s", tokenString))
+			access = accessorFactory.Create(req, "some-action")
+		})
+
+		Context("when request has user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": "some-user"}
+			})
+			It("returns the user name", func() {
+				Expect(access.UserName()).To(Equal("some-user"))
+			})
+		})
+		Context("when request has user_name claim set to nil", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{"user_name": nil}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+		Context("when request does not have user_name claim set", func() {
+			BeforeEach(func() {
+				claims = &jwt.MapClaims{}
+			})
+			It("returns empty", func() {
+				Expect(access.UserName()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Get Team Names", func() {
 		JustBeforeEach(func() {
 			token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
@@ -367,10 +458,18 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.BuildResources, atc.BuildResources, "member", true),
 		Entry("viewer :: "+atc.BuildResources, atc.BuildResources, "viewer", true),
 
+		Entry("owner :: "+atc.BuildTestResults, atc.BuildTestResults, "owner", true),
+		Entry("member :: "+atc.BuildTestResults, atc.BuildTestResults, "member", true),
+		Entry("viewer :: "+atc.BuildTestResults, atc.BuildTestResults, "viewer", true),
+
 		Entry("owner :: "+atc.AbortBuild, atc.AbortBuild, "owner", true),
 		Entry("member :: "+atc.AbortBuild, atc.AbortBuild, "member", true),
 		Entry("viewer :: "+atc.AbortBuild, atc.AbortBuild, "viewer", false),
 
+		Entry("owner :: "+atc.CommentBuild, atc.CommentBuild, "owner", true),
+		Entry("member :: "+atc.CommentBuild, atc.CommentBuild, "member", true),
+		Entry("viewer :: "+atc.CommentBuild, atc.CommentBuild, "viewer", false),
+
 		Entry("owner :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "owner", true),
 		Entry("member :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "member", true),
 		Entry("viewer :: "+atc.GetBuildPreparation, atc.GetBuildPreparation, "viewer", true),
@@ -383,6 +482,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CreateJobBuild, atc.CreateJobBuild, "member", true),
 		Entry("viewer :: "+atc.CreateJobBuild, atc.CreateJobBuild, "viewer", false),
 
+		Entry("owner :: "+atc.RerunJobBuild, atc.RerunJobBuild, "owner", true),
+		Entry("member :: "+atc.RerunJobBuild, atc.RerunJobBuild, "member", true),
+		Entry("viewer :: "+atc.RerunJobBuild, atc.RerunJobBuild, "viewer", false),
+
 		Entry("owner :: "+atc.ListAllJobs, atc.ListAllJobs, "owner", true),
 		Entry("member :: "+atc.ListAllJobs, atc.ListAllJobs, "member", true),
 		Entry("viewer :: "+atc.ListAllJobs, atc.ListAllJobs, "viewer", true),
@@ -395,6 +498,14 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListJobBuilds, atc.ListJobBuilds, "member", true),
 		Entry("viewer :: "+atc.ListJobBuilds, atc.ListJobBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.SearchJobLogs, atc.SearchJobLogs, "owner", true),
+		Entry("member :: "+atc.SearchJobLogs, atc.SearchJobLogs, "member", true),
+		Entry("viewer :: "+atc.SearchJobLogs, atc.SearchJobLogs, "viewer", true),
+
+		Entry("owner :: "+atc.ListFlakyTests, atc.ListFlakyTests, "owner", true),
+		Entry("member :: "+atc.ListFlakyTests, atc.ListFlakyTests, "member", true),
+		Entry("viewer :: "+atc.ListFlakyTests, atc.ListFlakyTests, "viewer", true),
+
 		Entry("owner :: "+atc.ListJobInputs, atc.ListJobInputs, "owner", true),
 		Entry("member :: "+atc.ListJobInputs, atc.ListJobInputs, "member", true),
 		Entry("viewer :: "+atc.ListJobInputs, atc.ListJobInputs, "viewer", true),
@@ -459,10 +570,18 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "member", true),
 		Entry("viewer :: "+atc.CheckResourceWebHook, atc.CheckResourceWebHook, "viewer", false),
 
+		Entry("owner :: "+atc.ReceiveGlobalWebhook, atc.ReceiveGlobalWebhook, "owner", true),
+		Entry("member :: "+atc.ReceiveGlobalWebhook, atc.ReceiveGlobalWebhook, "member", true),
+		Entry("viewer :: "+atc.ReceiveGlobalWebhook, atc.ReceiveGlobalWebhook, "viewer", true),
+
 		Entry("owner :: "+atc.CheckResourceType, atc.CheckResourceType, "owner", true),
 		Entry("member :: "+atc.CheckResourceType, atc.CheckResourceType, "member", true),
 		Entry("viewer :: "+atc.CheckResourceType, atc.CheckResourceType, "viewer", false),
 
+		Entry("owner :: "+atc.ListResourceChecks, atc.ListResourceChecks, "owner", true),
+		Entry("member :: "+atc.ListResourceChecks, atc.ListResourceChecks, "member", true),
+		Entry("viewer :: "+atc.ListResourceChecks, atc.ListResourceChecks, "viewer", true),
+
 		Entry("owner :: "+atc.ListResourceVersions, atc.ListResourceVersions, "owner", true),
 		Entry("member :: "+atc.ListResourceVersions, atc.ListResourceVersions, "member", true),
 		Entry("viewer :: "+atc.ListResourceVersions, atc.ListResourceVersions, "viewer", true),
@@ -519,6 +638,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.UnpausePipeline, atc.UnpausePipeline, "member", true),
 		Entry("viewer :: "+atc.UnpausePipeline, atc.UnpausePipeline, "viewer", false),
 
+		Entry("owner :: "+atc.ArchivePipeline, atc.ArchivePipeline, "owner", true),
+		Entry("member :: "+atc.ArchivePipeline, atc.ArchivePipeline, "member", true),
+		Entry("viewer :: "+atc.ArchivePipeline, atc.ArchivePipeline, "viewer", false),
+
 		Entry("owner :: "+atc.ExposePipeline, atc.ExposePipeline, "owner", true),
 		Entry("member :: "+atc.ExposePipeline, atc.ExposePipeline, "member", true),
 		Entry("viewer :: "+atc.ExposePipeline, atc.ExposePipeline, "viewer", false),
@@ -535,6 +658,10 @@ var _ = Describe("Accessor", func() {
 		Entry("member :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "member", true),
 		Entry("viewer :: "+atc.ListPipelineBuilds, atc.ListPipelineBuilds, "viewer", true),
 
+		Entry("owner :: "+atc.SearchPipelineLogs, atc.SearchPipelineLogs, "owner", true),
+		Entry("member :: "+atc.SearchPipelineLogs, atc.SearchPipelineLogs, "member", true),
+		Entry("viewer :: "+atc.SearchPipelineLogs, atc.SearchPipelineLogs, "viewer", true),
+
 		Entry("owner :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "owner", true),
 		Entry("member :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "member", true),
 		Entry("viewer :: "+atc.CreatePipelineBuild, atc.CreatePipelineBuild, "viewer", false),
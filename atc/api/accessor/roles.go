@@ -0,0 +1,124 @@
+package accessor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	yaml "gopkg.in/yaml.v2"
+)
+
+const (
+	OwnerRole  = "owner"
+	MemberRole = "member"
+	ViewerRole = "viewer"
+)
+
+var DefaultRoles = []string{OwnerRole, MemberRole, ViewerRole}
+
+// CustomRoles maps the name of an operator-defined role to the list of API
+// actions (route names in atc.Routes) that the role is permitted to perform.
+//
+// Custom roles are loaded from a YAML policy file at startup, e.g.:
+//
+//	pipeline-operator:
+//	- PausePipeline
+//	- UnpausePipeline
+//	- CreateJobBuild
+type CustomRoles map[string][]string
+
+func LoadCustomRoles(path string) (CustomRoles, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles CustomRoles
+	err = yaml.UnmarshalStrict(content, &roles)
+	if err != nil {
+		return nil, fmt.Errorf("malformed role policy file: %s", err)
+	}
+
+	err = roles.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+func (roles CustomRoles) Validate() error {
+	actions := map[string]bool{}
+	for _, route := range atc.Routes {
+		actions[route.Name] = true
+	}
+
+	for _, role := range roles.Names() {
+		if role == "" {
+			return fmt.Errorf("custom role name must not be empty")
+		}
+
+		if isDefaultRole(role) {
+			return fmt.Errorf("custom role '%s' conflicts with a built-in role", role)
+		}
+
+		for _, action := range roles[role] {
+			if !actions[action] {
+				return fmt.Errorf("custom role '%s' refers to unknown action '%s'", role, action)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (roles CustomRoles) Names() []string {
+	names := []string{}
+	for name := range roles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+func (roles CustomRoles) IsValidRole(role string) bool {
+	if isDefaultRole(role) {
+		return true
+	}
+
+	_, found := roles[role]
+	return found
+}
+
+func (roles CustomRoles) ValidateTeamAuth(auth atc.TeamAuth) error {
+	for role := range auth {
+		if !roles.IsValidRole(role) {
+			return fmt.Errorf("unknown role '%s'", role)
+		}
+	}
+
+	return nil
+}
+
+func (roles CustomRoles) permits(role string, action string) bool {
+	for _, allowed := range roles[role] {
+		if allowed == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDefaultRole(role string) bool {
+	for _, defaultRole := range DefaultRoles {
+		if role == defaultRole {
+			return true
+		}
+	}
+
+	return false
+}
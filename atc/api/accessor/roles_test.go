@@ -0,0 +1,119 @@
+package accessor_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomRoles", func() {
+	var roles accessor.CustomRoles
+
+	BeforeEach(func() {
+		roles = accessor.CustomRoles{
+			"pipeline-operator": {atc.PausePipeline, atc.UnpausePipeline},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts roles referring to known actions", func() {
+			Expect(roles.Validate()).To(Succeed())
+		})
+
+		Context("when a role refers to an unknown action", func() {
+			BeforeEach(func() {
+				roles["pipeline-operator"] = append(roles["pipeline-operator"], "DoSomethingUnheardOf")
+			})
+
+			It("returns an error", func() {
+				Expect(roles.Validate()).To(MatchError("custom role 'pipeline-operator' refers to unknown action 'DoSomethingUnheardOf'"))
+			})
+		})
+
+		Context("when a role shadows a built-in role", func() {
+			BeforeEach(func() {
+				roles["member"] = []string{atc.GetPipeline}
+			})
+
+			It("returns an error", func() {
+				Expect(roles.Validate()).To(MatchError("custom role 'member' conflicts with a built-in role"))
+			})
+		})
+	})
+
+	Describe("ValidateTeamAuth", func() {
+		It("accepts built-in and custom roles", func() {
+			Expect(roles.ValidateTeamAuth(atc.TeamAuth{
+				"owner":             {"users": {"local:some-user"}},
+				"pipeline-operator": {"groups": {"github:some-org"}},
+			})).To(Succeed())
+		})
+
+		It("rejects unknown roles", func() {
+			Expect(roles.ValidateTeamAuth(atc.TeamAuth{
+				"janitor": {"users": {"local:some-user"}},
+			})).To(MatchError("unknown role 'janitor'"))
+		})
+	})
+
+	Describe("LoadCustomRoles", func() {
+		var (
+			policyFile string
+			loaded     accessor.CustomRoles
+			loadErr    error
+		)
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "roles")
+			Expect(err).NotTo(HaveOccurred())
+			policyFile = file.Name()
+			Expect(file.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(policyFile)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			loaded, loadErr = accessor.LoadCustomRoles(policyFile)
+		})
+
+		Context("when the file is a valid policy", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(policyFile, []byte("pipeline-operator:\n- PausePipeline\n- CreateJobBuild\n"), 0644)).To(Succeed())
+			})
+
+			It("loads the roles", func() {
+				Expect(loadErr).NotTo(HaveOccurred())
+				Expect(loaded).To(Equal(accessor.CustomRoles{
+					"pipeline-operator": {atc.PausePipeline, atc.CreateJobBuild},
+				}))
+			})
+		})
+
+		Context("when the file refers to an unknown action", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(policyFile, []byte("pipeline-operator:\n- Bogus\n"), 0644)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when the file is malformed", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(policyFile, []byte("- not a map"), 0644)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(loadErr).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -13,9 +13,11 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
@@ -47,6 +49,7 @@ var (
 	dbJobFactory            *dbfakes.FakeJobFactory
 	dbResourceFactory       *dbfakes.FakeResourceFactory
 	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	dbCheckFactory          *dbfakes.FakeCheckFactory
 	fakePipeline            *dbfakes.FakePipeline
 	fakeAccessor            *accessorfakes.FakeAccessFactory
 	dbWorkerFactory         *dbfakes.FakeWorkerFactory
@@ -56,9 +59,11 @@ var (
 	dbTeam                  *dbfakes.FakeTeam
 	fakeSchedulerFactory    *jobserverfakes.FakeSchedulerFactory
 	fakeScannerFactory      *resourceserverfakes.FakeScannerFactory
+	globalWebhook           resourceserver.GlobalWebhook
 	fakeVariablesFactory    *credsfakes.FakeVariablesFactory
 	credsManagers           creds.Managers
 	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
+	customRoles             accessor.CustomRoles
 	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
 	peerURL                 string
 	drain                   chan struct{}
@@ -99,6 +104,7 @@ var _ = BeforeEach(func() {
 	dbJobFactory = new(dbfakes.FakeJobFactory)
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
@@ -127,6 +133,11 @@ var _ = BeforeEach(func() {
 
 	fakeSchedulerFactory = new(jobserverfakes.FakeSchedulerFactory)
 	fakeScannerFactory = new(resourceserverfakes.FakeScannerFactory)
+	globalWebhook = resourceserver.GlobalWebhook{
+		Provider:    atc.WebhookProviderGitHub,
+		Secret:      "global-secret",
+		SourceField: "uri",
+	}
 
 	fakeVolumeRepository = new(dbfakes.FakeVolumeRepository)
 	fakeContainerRepository = new(dbfakes.FakeContainerRepository)
@@ -134,6 +145,9 @@ var _ = BeforeEach(func() {
 
 	fakeVariablesFactory = new(credsfakes.FakeVariablesFactory)
 	credsManagers = make(creds.Managers)
+	customRoles = accessor.CustomRoles{
+		"pipeline-operator": {atc.PausePipeline, atc.UnpausePipeline},
+	}
 	var err error
 
 	cliDownloadsDir, err = ioutil.TempDir("", "cli-downloads")
@@ -181,6 +195,7 @@ var _ = BeforeEach(func() {
 		fakeDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
 
 		peerURL,
 		constructedEventHandler.Construct,
@@ -192,6 +207,7 @@ var _ = BeforeEach(func() {
 
 		fakeSchedulerFactory,
 		fakeScannerFactory,
+		globalWebhook,
 
 		sink,
 
@@ -203,6 +219,8 @@ var _ = BeforeEach(func() {
 		fakeVariablesFactory,
 		credsManagers,
 		interceptTimeoutFactory,
+		customRoles,
+		1000,
 	)
 
 	Expect(err).NotTo(HaveOccurred())
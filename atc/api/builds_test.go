@@ -8,6 +8,7 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -572,6 +573,36 @@ var _ = Describe("Builds API", func() {
 						It("returns 200 OK", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusOK))
 						})
+
+						Context("when the build has annotations", func() {
+							BeforeEach(func() {
+								build.AnnotationsReturns(map[string]string{"coverage": "87%"})
+							})
+
+							It("does not return them", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.Annotations).To(BeEmpty())
+							})
+						})
+
+						Context("when the build has comments", func() {
+							BeforeEach(func() {
+								build.CommentsReturns([]db.BuildComment{
+									{Author: "some-user", Comment: "rerunning", Time: time.Unix(10, 0)},
+								})
+							})
+
+							It("does not return them", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.Comments).To(BeEmpty())
+							})
+						})
 					})
 
 					Context("when user is authorized", func() {
@@ -608,6 +639,54 @@ var _ = Describe("Builds API", func() {
 						"reap_time": 200
 					}`))
 						})
+
+						Context("when the build has annotations", func() {
+							BeforeEach(func() {
+								build.AnnotationsReturns(map[string]string{"coverage": "87%"})
+							})
+
+							It("returns them", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.Annotations).To(Equal(map[string]string{"coverage": "87%"}))
+							})
+						})
+
+						Context("when the build has comments", func() {
+							BeforeEach(func() {
+								build.CommentsReturns([]db.BuildComment{
+									{Author: "some-user", Comment: "rerunning", Time: time.Unix(10, 0)},
+									{Author: "other-user", Comment: "aborted", Time: time.Unix(20, 0)},
+								})
+							})
+
+							It("returns them in order", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.Comments).To(Equal([]atc.BuildComment{
+									{Author: "some-user", Comment: "rerunning", Time: 10},
+									{Author: "other-user", Comment: "aborted", Time: 20},
+								}))
+							})
+						})
+
+						Context("when the build has test results", func() {
+							BeforeEach(func() {
+								build.TestSummaryReturns(&atc.TestSummary{Passed: 10, Failed: 2, Skipped: 1})
+							})
+
+							It("returns their summary", func() {
+								var presented atc.Build
+								err := json.NewDecoder(response.Body).Decode(&presented)
+								Expect(err).NotTo(HaveOccurred())
+
+								Expect(presented.TestSummary).To(Equal(&atc.TestSummary{Passed: 10, Failed: 2, Skipped: 1}))
+							})
+						})
 					})
 				})
 			})
@@ -1108,6 +1187,242 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/builds/:build_id/comments", func() {
+		var (
+			body     string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			body = `{"comment":"rerunning after the outage"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/builds/128/comments", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.UserNameReturns("some-user")
+			})
+
+			Context("when the build can be found", func() {
+				BeforeEach(func() {
+					build.TeamNameReturns("some-team")
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when accessing same team's build", func() {
+					BeforeEach(func() {
+						fakeaccess.IsAuthorizedReturns(true)
+					})
+
+					Context("when saving the comment succeeds", func() {
+						BeforeEach(func() {
+							build.CommentReturns(db.BuildComment{
+								Author:  "some-user",
+								Comment: "rerunning after the outage",
+								Time:    time.Unix(10, 0),
+							}, nil)
+						})
+
+						It("comments on the build as the user", func() {
+							Expect(build.CommentCallCount()).To(Equal(1))
+							author, comment := build.CommentArgsForCall(0)
+							Expect(author).To(Equal("some-user"))
+							Expect(comment).To(Equal("rerunning after the outage"))
+						})
+
+						It("returns 201 with the comment", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusCreated))
+							Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(body).To(MatchJSON(`{
+								"author": "some-user",
+								"comment": "rerunning after the outage",
+								"time": 10
+							}`))
+						})
+					})
+
+					Context("when saving the comment fails", func() {
+						BeforeEach(func() {
+							build.CommentReturns(db.BuildComment{}, errors.New("oh no!"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the comment is blank", func() {
+						BeforeEach(func() {
+							body = `{"comment":"  "}`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+
+						It("does not comment on the build", func() {
+							Expect(build.CommentCallCount()).To(BeZero())
+						})
+					})
+
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							body = `{`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+				})
+
+				Context("when accessing other team's build", func() {
+					BeforeEach(func() {
+						fakeaccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+
+					It("does not comment on the build", func() {
+						Expect(build.CommentCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/tests", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/3/tests")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(fakePipeline, true, nil)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the job is private", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(false)
+					fakePipeline.PublicReturns(true)
+
+					fakeJob := new(dbfakes.FakeJob)
+					fakeJob.ConfigReturns(atc.JobConfig{Name: "job1", Public: false})
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated, but not authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authenticated and authorized", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthenticatedReturns(true)
+					fakeaccess.IsAuthorizedReturns(true)
+
+					build.TestResultsReturns([]atc.TestResult{
+						{Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+						{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "off by one"},
+					}, nil)
+				})
+
+				It("returns 200 OK", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+				})
+
+				It("returns the build's test results", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{"suite": "math", "name": "adds", "status": "passed", "duration": 0.5},
+						{"suite": "math", "name": "subtracts", "status": "failed", "message": "off by one"}
+					]`))
+				})
+
+				Context("when getting the test results fails", func() {
+					BeforeEach(func() {
+						build.TestResultsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
@@ -0,0 +1,57 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CommentBuild(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("comment", lager.Data{
+			"build": build.ID(),
+		})
+
+		var comment atc.BuildComment
+		err := json.NewDecoder(r.Body).Decode(&comment)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if strings.TrimSpace(comment.Comment) == "" {
+			logger.Info("empty-comment")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+
+		author := acc.UserName()
+		if author == "" && acc.IsSystem() {
+			author = "system"
+		}
+
+		saved, err := build.Comment(author, comment.Comment)
+		if err != nil {
+			logger.Error("failed-to-comment-on-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(present.BuildComment(saved))
+		if err != nil {
+			logger.Error("failed-to-encode-comment", err)
+		}
+	})
+}
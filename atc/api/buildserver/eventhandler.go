@@ -7,22 +7,40 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/gorilla/websocket"
 	"github.com/vito/go-sse/sse"
 )
 
 const ProtocolVersionHeader = "X-ATC-Stream-Version"
 const CurrentProtocolVersion = "2.0"
 
+// DefaultHeartbeatInterval is how often WebSocket event streams are pinged,
+// keeping proxies from closing them while a build is quiet.
+const DefaultHeartbeatInterval = 30 * time.Second
+
 func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
+	return NewEventHandlerWithHeartbeat(logger, build, DefaultHeartbeatInterval)
+}
+
+// NewEventHandlerWithHeartbeat streams the build's events as Server-Sent
+// Events, or over a WebSocket if the request asks to upgrade to one.
+func NewEventHandlerWithHeartbeat(logger lager.Logger, build db.Build, heartbeatInterval time.Duration) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		clientNotifier := w.(http.CloseNotifier)
+		isWebSocket := websocket.IsWebSocketUpgrade(r)
+
+		// browsers can't set headers on WebSocket requests, so the last event
+		// may be given as a query param instead
+		startString := r.Header.Get("Last-Event-ID")
+		if startString == "" && isWebSocket {
+			startString = r.URL.Query().Get("last_event_id")
+		}
 
 		var eventID uint = 0
-		if r.Header.Get("Last-Event-ID") != "" {
-			startString := r.Header.Get("Last-Event-ID")
+		if startString != "" {
 			_, err := fmt.Sscanf(startString, "%d", &eventID)
 			if err != nil {
 				logger.Info("failed-to-parse-last-event-id", lager.Data{"last-event-id": startString})
@@ -33,6 +51,13 @@ func NewEventHandler(logger lager.Logger, build db.Build) http.Handler {
 			eventID++
 		}
 
+		if isWebSocket {
+			serveWebSocketEvents(logger, build, eventID, heartbeatInterval, w, r)
+			return
+		}
+
+		clientNotifier := w.(http.CloseNotifier)
+
 		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 		w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 		w.Header().Add("X-Accel-Buffering", "no")
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager/lagertest"
@@ -13,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/gorilla/websocket"
 	"github.com/vito/go-sse/sse"
 
 	. "github.com/onsi/ginkgo"
@@ -275,4 +277,192 @@ var _ = Describe("Handler", func() {
 			})
 		})
 	})
+	Describe("WebSocket", func() {
+		var (
+			wsServer *httptest.Server
+			wsURL    string
+
+			fakeEventSource *dbfakes.FakeEventSource
+			returnedEvents  []event.Envelope
+			moreEvents      chan struct{}
+		)
+
+		BeforeEach(func() {
+			wsServer = httptest.NewServer(NewEventHandlerWithHeartbeat(lagertest.NewTestLogger("test"), build, 10*time.Millisecond))
+			wsURL = "ws" + strings.TrimPrefix(wsServer.URL, "http")
+
+			returnedEvents = []event.Envelope{
+				fakeEvent(`{"event":1}`),
+				fakeEvent(`{"event":2}`),
+				fakeEvent(`{"event":3}`),
+			}
+
+			moreEvents = make(chan struct{})
+			close(moreEvents)
+
+			fakeEventSource = new(dbfakes.FakeEventSource)
+
+			build.EventsStub = func(from uint) (db.EventSource, error) {
+				fakeEventSource.NextStub = func() (event.Envelope, error) {
+					if from >= uint(len(returnedEvents)) {
+						<-moreEvents
+						return event.Envelope{}, db.ErrEndOfBuildEventStream
+					}
+
+					from++
+
+					return returnedEvents[from-1], nil
+				}
+
+				return fakeEventSource, nil
+			}
+		})
+
+		AfterEach(func() {
+			wsServer.Close()
+		})
+
+		readMessage := func(conn *websocket.Conn) event.WebSocketMessage {
+			var message event.WebSocketMessage
+			Expect(conn.ReadJSON(&message)).To(Succeed())
+			return message
+		}
+
+		It("emits the events with the same envelope, followed by an end message", func() {
+			conn, response, err := websocket.DefaultDialer.Dial(wsURL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(conn)
+
+			Expect(response.Header.Get("X-ATC-Stream-Version")).To(Equal("2.0"))
+
+			Expect(build.EventsCallCount()).To(Equal(1))
+			Expect(build.EventsArgsForCall(0)).To(BeZero())
+
+			for i, envelope := range returnedEvents {
+				message := readMessage(conn)
+				Expect(message.ID).To(Equal(uint(i)))
+				Expect(message.Name).To(Equal("event"))
+
+				payload, err := json.Marshal(envelope)
+				Expect(err).NotTo(HaveOccurred())
+				Expect([]byte(*message.Data)).To(MatchJSON(payload))
+			}
+
+			Expect(readMessage(conn)).To(Equal(event.WebSocketMessage{ID: 3, Name: "end"}))
+
+			_, _, err = conn.ReadMessage()
+			Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+
+			Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+		})
+
+		It("hangs up on a client that never answers the close", func() {
+			conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(conn)
+
+			conn.SetCloseHandler(func(int, string) error {
+				return nil
+			})
+
+			for range returnedEvents {
+				readMessage(conn)
+			}
+
+			Expect(readMessage(conn).Name).To(Equal("end"))
+
+			_, _, err = conn.ReadMessage()
+			Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue())
+
+			Eventually(fakeEventSource.CloseCallCount, 5*time.Second).Should(Equal(1))
+
+			_, err = conn.UnderlyingConn().Read(make([]byte, 1))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("resumes after the event ID given as a query param", func() {
+			conn, _, err := websocket.DefaultDialer.Dial(wsURL+"?last_event_id=1", nil)
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(conn)
+
+			Expect(build.EventsArgsForCall(0)).To(Equal(uint(2)))
+
+			message := readMessage(conn)
+			Expect(message.ID).To(Equal(uint(2)))
+			Expect([]byte(*message.Data)).To(MatchJSON(`{"data":{"event":3},"event":"fake","version":"42.0"}`))
+		})
+
+		It("resumes after the event ID given as the Last-Event-ID header", func() {
+			conn, _, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Last-Event-ID": []string{"0"}})
+			Expect(err).NotTo(HaveOccurred())
+			defer db.Close(conn)
+
+			Expect(build.EventsArgsForCall(0)).To(Equal(uint(1)))
+		})
+
+		It("rejects an invalid event ID", func() {
+			_, response, err := websocket.DefaultDialer.Dial(wsURL+"?last_event_id=nope", nil)
+			Expect(err).To(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		Context("while waiting for more events", func() {
+			BeforeEach(func() {
+				moreEvents = make(chan struct{})
+			})
+
+			It("sends heartbeats", func() {
+				conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+				Expect(err).NotTo(HaveOccurred())
+				defer db.Close(conn)
+
+				pinged := make(chan struct{}, 10)
+				conn.SetPingHandler(func(string) error {
+					pinged <- struct{}{}
+					return nil
+				})
+
+				go func() {
+					for {
+						if _, _, err := conn.NextReader(); err != nil {
+							return
+						}
+					}
+				}()
+
+				Eventually(pinged).Should(Receive())
+				Eventually(pinged).Should(Receive())
+
+				close(moreEvents)
+			})
+
+			It("closes the event source when the client goes away", func() {
+				conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				for range returnedEvents {
+					readMessage(conn)
+				}
+
+				Expect(conn.Close()).To(Succeed())
+
+				Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+
+				close(moreEvents)
+			})
+		})
+
+		Context("when subscribing to the build fails", func() {
+			BeforeEach(func() {
+				build.EventsReturns(nil, errors.New("nope"))
+				build.EventsStub = nil
+			})
+
+			It("returns 500 without upgrading", func() {
+				_, response, err := websocket.DefaultDialer.Dial(wsURL, nil)
+				Expect(err).To(Equal(websocket.ErrBadHandshake))
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
 })
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -15,7 +16,12 @@ func (s *Server) GetBuild(build db.Build) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
-		err := json.NewEncoder(w).Encode(present.Build(build))
+		presented := present.Build(build)
+		if accessor.GetAccessor(r).IsAuthorized(build.TeamName()) {
+			presented = present.DetailedBuild(build)
+		}
+
+		err := json.NewEncoder(w).Encode(presented)
 		if err != nil {
 			logger.Error("failed-to-encode-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -69,7 +69,11 @@ func (s *Server) ListBuilds(w http.ResponseWriter, r *http.Request) {
 	atc := make([]atc.Build, len(builds))
 	for i := 0; i < len(builds); i++ {
 		build := builds[i]
-		atc[i] = present.Build(build)
+		if acc.IsAuthorized(build.TeamName()) {
+			atc[i] = present.DetailedBuild(build)
+		} else {
+			atc[i] = present.Build(build)
+		}
 	}
 
 	err = json.NewEncoder(w).Encode(atc)
@@ -0,0 +1,30 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) BuildTestResults(build db.Build) http.Handler {
+	logger := s.logger.Session("build-test-results")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		results, err := build.TestResults()
+		if err != nil {
+			logger.Error("failed-to-get-test-results", err, lager.Data{"buildID": build.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(results)
+		if err != nil {
+			logger.Error("failed-to-encode-test-results", err)
+		}
+	})
+}
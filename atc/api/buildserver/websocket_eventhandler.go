@@ -0,0 +1,155 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/gorilla/websocket"
+)
+
+var upgrader = websocket.Upgrader{
+	HandshakeTimeout: 5 * time.Second,
+}
+
+func serveWebSocketEvents(
+	logger lager.Logger,
+	build db.Build,
+	eventID uint,
+	heartbeatInterval time.Duration,
+	w http.ResponseWriter,
+	r *http.Request,
+) {
+	events, err := build.Events(eventID)
+	if err != nil {
+		logger.Error("failed-to-get-build-events", err, lager.Data{"build-id": build.ID(), "start": eventID})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	// the event source is closed either once the client's gone, to stop
+	// waiting for the next event, or when the stream ends
+	var closeOnce sync.Once
+	closeEvents := func() {
+		closeOnce.Do(func() { db.Close(events) })
+	}
+
+	defer closeEvents()
+
+	header := http.Header{}
+	header.Add(ProtocolVersionHeader, CurrentProtocolVersion)
+
+	conn, err := upgrader.Upgrade(w, r, header)
+	if err != nil {
+		logger.Info("failed-to-upgrade-connection", lager.Data{"error": err.Error()})
+		return
+	}
+
+	defer db.Close(conn)
+
+	clientGone := make(chan struct{})
+	go func() {
+		defer close(clientGone)
+
+		// reading handles the client's pongs and close, and notices when the
+		// connection drops; clients aren't expected to send anything else
+		for {
+			_, _, err := conn.NextReader()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go heartbeat(logger, conn, heartbeatInterval, done)
+
+	go func() {
+		select {
+		case <-clientGone:
+			closeEvents()
+		case <-done:
+		}
+	}()
+
+	for {
+		logger = logger.WithData(lager.Data{"id": eventID})
+
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				err := conn.WriteJSON(event.WebSocketMessage{ID: eventID, Name: "end"})
+				if err != nil {
+					logger.Info("failed-to-write-end", lager.Data{"error": err.Error()})
+					return
+				}
+
+				err = conn.WriteControl(
+					websocket.CloseMessage,
+					websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+					time.Now().Add(time.Second),
+				)
+				if err != nil {
+					logger.Info("failed-to-write-close", lager.Data{"error": err.Error()})
+					return
+				}
+
+				// give the client a moment to answer the close, so that one
+				// that never does can't hold the connection open
+				err = conn.SetReadDeadline(time.Now().Add(time.Second))
+				if err != nil {
+					logger.Info("failed-to-set-read-deadline", lager.Data{"error": err.Error()})
+					return
+				}
+
+				<-clientGone
+			} else if err != db.ErrBuildEventStreamClosed {
+				logger.Error("failed-to-get-next-build-event", err)
+			}
+
+			return
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return
+		}
+
+		err = conn.WriteJSON(event.WebSocketMessage{
+			ID:   eventID,
+			Name: "event",
+			Data: (*json.RawMessage)(&payload),
+		})
+		if err != nil {
+			logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+			return
+		}
+
+		eventID++
+	}
+}
+
+func heartbeat(logger lager.Logger, conn *websocket.Conn, interval time.Duration, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(interval))
+			if err != nil {
+				logger.Info("failed-to-write-heartbeat", lager.Data{"error": err.Error()})
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
@@ -16,6 +16,18 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Info("malformed-instance-vars", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	pipelineRef := atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		logger.Error("failed-to-find-team", err)
@@ -29,7 +41,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		logger.Error("failed-to-find-pipeline", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -37,7 +49,7 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !found {
-		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineName})
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineRef.String()})
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
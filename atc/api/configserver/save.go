@@ -74,6 +74,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, []string{err.Error()}, session)
+		return
+	}
+
 	config, pausedState, err := saveConfigRequestUnmarshaler(r)
 	switch err {
 	case ErrStatusUnsupportedMediaType:
@@ -146,7 +153,12 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, pausedState)
+	pipelineRef := atc.PipelineRef{
+		Name:         pipelineName,
+		InstanceVars: instanceVars,
+	}
+
+	_, created, err := team.SavePipeline(pipelineRef, config, version, pausedState)
 	if err != nil {
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -188,6 +200,13 @@ func validateCredParams(credMgrVars creds.Variables, config atc.Config, session
 		if err != nil {
 			errs = multierror.Append(errs, err)
 		}
+
+		if resource.Webhook != nil {
+			_, err = creds.NewString(credMgrVars, resource.Webhook.Secret).Evaluate()
+			if err != nil {
+				errs = multierror.Append(errs, err)
+			}
+		}
 	}
 
 	for _, job := range config.Jobs {
@@ -70,9 +70,17 @@ func createContainerLocatorFromRequest(team db.Team, r *http.Request, variablesF
 	}
 
 	if query.Get("type") == "check" {
+		instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+		if err != nil {
+			return nil, err
+		}
+
 		return &checkContainerLocator{
-			team:             team,
-			pipelineName:     query.Get("pipeline_name"),
+			team: team,
+			pipelineRef: atc.PipelineRef{
+				Name:         query.Get("pipeline_name"),
+				InstanceVars: instanceVars,
+			},
 			resourceName:     query.Get("resource_name"),
 			variablesFactory: variablesFactory,
 		}, nil
@@ -133,13 +141,13 @@ func (l *allContainersLocator) Locate(logger lager.Logger) ([]db.Container, map[
 
 type checkContainerLocator struct {
 	team             db.Team
-	pipelineName     string
+	pipelineRef      atc.PipelineRef
 	resourceName     string
 	variablesFactory creds.VariablesFactory
 }
 
 func (l *checkContainerLocator) Locate(logger lager.Logger) ([]db.Container, map[int]time.Time, error) {
-	return l.team.FindCheckContainers(logger, l.pipelineName, l.resourceName, l.variablesFactory)
+	return l.team.FindCheckContainers(logger, l.pipelineRef, l.resourceName, l.variablesFactory)
 }
 
 type stepContainerLocator struct {
@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
 )
 
@@ -477,6 +478,25 @@ var _ = Describe("Jobs API", func() {
 
 					})
 
+					Context("when the job's pipeline is an instance", func() {
+						BeforeEach(func() {
+							instanceVars := atc.InstanceVars{"branch": "feature"}
+							fakeJob.PipelineInstanceVarsReturns(instanceVars)
+							build1.PipelineInstanceVarsReturns(instanceVars)
+							build2.PipelineInstanceVarsReturns(instanceVars)
+						})
+
+						It("returns the instance vars of the job and its builds", func() {
+							var job atc.Job
+							err := json.NewDecoder(response.Body).Decode(&job)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(job.PipelineInstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+							Expect(job.NextBuild.PipelineInstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+							Expect(job.FinishedBuild.PipelineInstanceVars).To(Equal(atc.InstanceVars{"branch": "feature"}))
+						})
+					})
+
 					Context("when there are no running or finished builds", func() {
 						BeforeEach(func() {
 							fakeJob.FinishedAndNextBuildReturns(nil, nil, nil)
@@ -1356,6 +1376,26 @@ var _ = Describe("Jobs API", func() {
 				]`))
 					})
 
+					Context("when the builds have comments", func() {
+						BeforeEach(func() {
+							returnedBuilds[0].(*dbfakes.FakeBuild).CommentsReturns([]db.BuildComment{
+								{Author: "some-user", Comment: "rerunning", Time: time.Unix(10, 0)},
+							})
+						})
+
+						It("includes them", func() {
+							var builds []atc.Build
+							err := json.NewDecoder(response.Body).Decode(&builds)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(builds).To(HaveLen(2))
+							Expect(builds[0].Comments).To(Equal([]atc.BuildComment{
+								{Author: "some-user", Comment: "rerunning", Time: 10},
+							}))
+							Expect(builds[1].Comments).To(BeEmpty())
+						})
+					})
+
 					Context("when next/previous pages are available", func() {
 						BeforeEach(func() {
 							fakeJob.BuildsReturns(returnedBuilds, db.Pagination{
@@ -1406,6 +1446,299 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/logs", func() {
+		var response *http.Response
+		var queryParams string
+
+		BeforeEach(func() {
+			queryParams = "?query=some-match"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/logs" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			Context("when the query is missing", func() {
+				BeforeEach(func() {
+					queryParams = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the query is an invalid regex", func() {
+				BeforeEach(func() {
+					queryParams = "?query=(&regex=true"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the builds have logs", func() {
+				BeforeEach(func() {
+					queryParams = "?query=some-match&since=5&limit=2"
+
+					build1 := new(dbfakes.FakeBuild)
+					build1.IDReturns(4)
+					build1.NameReturns("2")
+					build1.JobNameReturns("some-job")
+					build1.PipelineNameReturns("some-pipeline")
+					build1.TeamNameReturns("some-team")
+					build1.StatusReturns(db.BuildStatusFailed)
+					build1.EventsReturns(fakeLogEventSource(
+						"some-match in a ",
+						"split line\nno luck here\n",
+						"another some-match",
+					), nil)
+
+					build2 := new(dbfakes.FakeBuild)
+					build2.IDReturns(2)
+					build2.NameReturns("1")
+					build2.JobNameReturns("some-job")
+					build2.PipelineNameReturns("some-pipeline")
+					build2.TeamNameReturns("some-team")
+					build2.StatusReturns(db.BuildStatusSucceeded)
+					build2.EventsReturns(fakeLogEventSource("nothing to see\n"), nil)
+
+					fakeJob.BuildsReturns([]db.Build{build1, build2}, db.Pagination{
+						Next: &db.Page{Since: 2, Limit: 2},
+					}, nil)
+				})
+
+				It("searches the requested page of builds", func() {
+					Expect(fakeJob.BuildsCallCount()).To(Equal(1))
+					Expect(fakeJob.BuildsArgsForCall(0)).To(Equal(db.Page{
+						Since: 5,
+						Limit: 2,
+					}))
+				})
+
+				It("returns the matching lines of each build", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"build": {
+								"id": 4,
+								"name": "2",
+								"job_name": "some-job",
+								"status": "failed",
+								"api_url": "/api/v1/builds/4",
+								"pipeline_name": "some-pipeline",
+								"team_name": "some-team"
+							},
+							"matches": [
+								{"line": "some-match in a split line", "origin": {"id": "some-step", "source": "stdout"}},
+								{"line": "another some-match", "origin": {"id": "some-step", "source": "stdout"}}
+							]
+						}
+					]`))
+				})
+
+				It("returns Link headers keeping the search", func() {
+					Expect(response.Header["Link"]).To(ConsistOf([]string{
+						fmt.Sprintf(`<%s/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/logs?limit=2&query=some-match&since=2>; rel="next"`, externalURL),
+					}))
+				})
+			})
+
+			Context("when getting the builds fails", func() {
+				BeforeEach(func() {
+					fakeJob.BuildsReturns(nil, db.Pagination{}, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			Context("and the job is private", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{Public: false})
+				})
+
+				Context("and not authenticated", func() {
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+
+				Context("and authenticated", func() {
+					BeforeEach(func() {
+						fakeaccess.IsAuthenticatedReturns(true)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+
+				It("does not read any logs", func() {
+					Expect(fakeJob.BuildsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("and the job is public", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{Public: true})
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/flaky-tests", func() {
+		var response *http.Response
+		var queryParams string
+
+		BeforeEach(func() {
+			queryParams = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/flaky-tests" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+
+				fakeJob.FlakyTestsReturns([]atc.FlakyTest{
+					{Suite: "math", Name: "subtracts", Passed: 3, Failed: 2, LastFailedBuild: "7"},
+				}, nil)
+			})
+
+			It("considers the job's last 20 builds by default", func() {
+				Expect(fakeJob.FlakyTestsCallCount()).To(Equal(1))
+				Expect(fakeJob.FlakyTestsArgsForCall(0)).To(Equal(20))
+			})
+
+			It("returns the flaky tests", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{"suite": "math", "name": "subtracts", "passed": 3, "failed": 2, "last_failed_build": "7"}
+				]`))
+			})
+
+			Context("when the number of builds is given", func() {
+				BeforeEach(func() {
+					queryParams = "?builds=50"
+				})
+
+				It("considers that many builds", func() {
+					Expect(fakeJob.FlakyTestsArgsForCall(0)).To(Equal(50))
+				})
+			})
+
+			Context("when the number of builds is invalid", func() {
+				BeforeEach(func() {
+					queryParams = "?builds=0"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeJob.FlakyTestsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the flaky tests fails", func() {
+				BeforeEach(func() {
+					fakeJob.FlakyTestsReturns(nil, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+				fakePipeline.JobReturns(fakeJob, true, nil)
+			})
+
+			Context("and the job is private", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{Public: false})
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					Expect(fakeJob.FlakyTestsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("and the job is public", func() {
+				BeforeEach(func() {
+					fakeJob.ConfigReturns(atc.JobConfig{Public: true})
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", func() {
 		var request *http.Request
 		var response *http.Response
@@ -1465,6 +1798,21 @@ var _ = Describe("Jobs API", func() {
 					})
 				})
 
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+						fakePipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not trigger the build", func() {
+						Expect(fakeScheduler.TriggerImmediatelyCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when getting the job config succeeds", func() {
 					BeforeEach(func() {
 						fakeJob.ConfigReturns(atc.JobConfig{
@@ -1555,6 +1903,16 @@ var _ = Describe("Jobs API", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 						})
 					})
+
+					Context("when the pipeline is archived while triggering the build", func() {
+						BeforeEach(func() {
+							fakeScheduler.TriggerImmediatelyReturns(nil, nil, db.ErrPipelineArchived)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+					})
 				})
 			})
 
@@ -1580,6 +1938,187 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/3", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized and authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(true)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when getting the job succeeds", func() {
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				Context("when manual triggering is disabled", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name:                 "some-job",
+							DisableManualTrigger: true,
+						})
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+						fakePipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not rerun the build", func() {
+						Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the build exists", func() {
+					var buildToRerun *dbfakes.FakeBuild
+
+					BeforeEach(func() {
+						buildToRerun = new(dbfakes.FakeBuild)
+						buildToRerun.IDReturns(7)
+						buildToRerun.NameReturns("3")
+						buildToRerun.IsScheduledReturns(true)
+						fakeJob.BuildReturns(buildToRerun, true, nil)
+						fakeJob.RerunBuildReturns(new(dbfakes.FakeBuild), nil)
+					})
+
+					It("looks up the build by name", func() {
+						Expect(fakeJob.BuildCallCount()).To(Equal(1))
+						Expect(fakeJob.BuildArgsForCall(0)).To(Equal("3"))
+					})
+
+					Context("when rerunning the build succeeds", func() {
+						BeforeEach(func() {
+							build := new(dbfakes.FakeBuild)
+							build.IDReturns(42)
+							build.NameReturns("4")
+							build.JobNameReturns("some-job")
+							build.PipelineNameReturns("some-pipeline")
+							build.TeamNameReturns("some-team")
+							build.StatusReturns(db.BuildStatusPending)
+							build.RerunOfReturns(7)
+							build.RerunOfNameReturns("3")
+							fakeJob.RerunBuildReturns(build, nil)
+						})
+
+						It("reruns the build", func() {
+							Expect(fakeJob.RerunBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.RerunBuildArgsForCall(0)).To(Equal(buildToRerun))
+						})
+
+						It("returns 200 OK", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+
+						It("returns the new build linked to the original", func() {
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(body).To(MatchJSON(`{
+								"id": 42,
+								"name": "4",
+								"job_name": "some-job",
+								"status": "pending",
+								"api_url": "/api/v1/builds/42",
+								"pipeline_name": "some-pipeline",
+								"team_name": "some-team",
+								"rerun_of": {"id": 7, "name": "3"}
+							}`))
+						})
+					})
+
+					Context("when rerunning the build fails", func() {
+						BeforeEach(func() {
+							fakeJob.RerunBuildReturns(nil, errors.New("oh no!"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the build has not been scheduled", func() {
+						BeforeEach(func() {
+							buildToRerun.IsScheduledReturns(false)
+						})
+
+						It("returns 409", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						})
+
+						It("does not rerun the build", func() {
+							Expect(fakeJob.RerunBuildCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when the build does not exist", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when getting the build fails", func() {
+					BeforeEach(func() {
+						fakeJob.BuildReturns(nil, false, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", func() {
 		var response *http.Response
 
@@ -1598,8 +2137,8 @@ var _ = Describe("Jobs API", func() {
 
 			It("looked up the proper pipeline", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("some-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 			})
 
 			Context("when getting the job succeeds", func() {
@@ -2270,3 +2809,33 @@ func fakeDBResourceType(t atc.VersionedResourceType) *dbfakes.FakeResourceType {
 	fake.VersionReturns(t.Version)
 	return fake
 }
+
+func fakeLogEventSource(payloads ...string) *dbfakes.FakeEventSource {
+	events := []event.Envelope{}
+	for _, payload := range payloads {
+		data, err := json.Marshal(event.Log{
+			Origin:  event.Origin{ID: "some-step", Source: event.OriginSourceStdout},
+			Payload: payload,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		events = append(events, event.Envelope{
+			Data:    (*json.RawMessage)(&data),
+			Event:   event.EventTypeLog,
+			Version: "5.1",
+		})
+	}
+
+	source := new(dbfakes.FakeEventSource)
+	source.NextStub = func() (event.Envelope, error) {
+		if len(events) == 0 {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		next := events[0]
+		events = events[1:]
+		return next, nil
+	}
+
+	return source
+}
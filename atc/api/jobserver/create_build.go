@@ -33,6 +33,12 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
+		if pipeline.Archived() {
+			logger.Info("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
 		scheduler := s.schedulerFactory.BuildScheduler(pipeline, s.externalURL, s.variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name()))
 
 		resourceTypes, err := pipeline.ResourceTypes()
@@ -52,6 +58,12 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 		}
 
 		build, _, err := scheduler.TriggerImmediately(logger, job, resources, versionedResourceTypes)
+		if err == db.ErrPipelineArchived {
+			logger.Info("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
 		if err != nil {
 			logger.Error("failed-to-trigger", err)
 			w.WriteHeader(http.StatusInternalServerError)
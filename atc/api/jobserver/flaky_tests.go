@@ -0,0 +1,73 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// defaultFlakyTestsBuilds is how many of the job's recent builds are
+// considered when the request doesn't say.
+const defaultFlakyTestsBuilds = 20
+
+const maxFlakyTestsBuilds = 500
+
+func (s *Server) ListFlakyTests(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-flaky-tests")
+
+		jobName := r.FormValue(":job_name")
+
+		builds := defaultFlakyTestsBuilds
+		if urlBuilds := r.FormValue("builds"); urlBuilds != "" {
+			var err error
+			builds, err = strconv.Atoi(urlBuilds)
+			if err != nil || builds < 1 || builds > maxFlakyTestsBuilds {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte("builds must be between 1 and " + strconv.Itoa(maxFlakyTestsBuilds)))
+				return
+			}
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAuthorized(pipeline.TeamName()) && !job.Config().Public {
+			if acc.IsAuthenticated() {
+				s.rejector.Forbidden(w, r)
+				return
+			}
+
+			s.rejector.Unauthorized(w, r)
+			return
+		}
+
+		flakyTests, err := job.FlakyTests(builds)
+		if err != nil {
+			logger.Error("failed-to-get-flaky-tests", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(flakyTests)
+		if err != nil {
+			logger.Error("failed-to-encode-flaky-tests", err)
+		}
+	})
+}
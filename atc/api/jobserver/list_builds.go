@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -82,9 +83,15 @@ func (s *Server) ListJobBuilds(pipeline db.Pipeline) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
+		authorized := accessor.GetAccessor(r).IsAuthorized(teamName)
+
 		jobBuilds := make([]atc.Build, len(builds))
 		for i := 0; i < len(builds); i++ {
-			jobBuilds[i] = present.Build(builds[i])
+			if authorized {
+				jobBuilds[i] = present.DetailedBuild(builds[i])
+			} else {
+				jobBuilds[i] = present.Build(builds[i])
+			}
 		}
 
 		err = json.NewEncoder(w).Encode(jobBuilds)
@@ -0,0 +1,87 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) RerunJobBuild(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+		buildName := r.FormValue(":build_name")
+
+		logger := s.logger.Session("rerun-job-build", lager.Data{
+			"job":   jobName,
+			"build": buildName,
+		})
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if job.Config().DisableManualTrigger {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		if pipeline.Archived() {
+			logger.Info("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		buildToRerun, found, err := job.Build(buildName)
+		if err != nil {
+			logger.Error("failed-to-get-job-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// the inputs of a build are only determined once it is scheduled, so
+		// until then there is nothing to pin the rerun to
+		if !buildToRerun.IsScheduled() {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "build %s has not been scheduled yet", buildToRerun.Name())
+			return
+		}
+
+		build, err := job.RerunBuild(buildToRerun)
+		if err == db.ErrPipelineArchived {
+			logger.Info("pipeline-is-archived")
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		if err != nil {
+			logger.Error("failed-to-rerun-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.Build(build))
+		if err != nil {
+			logger.Error("failed-to-encode-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/logsearch"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchJobLogs(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-job-logs")
+
+		jobName := r.FormValue(":job_name")
+
+		match, err := logsearch.MatcherFromRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAuthorized(pipeline.TeamName()) && !job.Config().Public {
+			if acc.IsAuthenticated() {
+				s.rejector.Forbidden(w, r)
+				return
+			}
+
+			s.rejector.Unauthorized(w, r)
+			return
+		}
+
+		page := logsearch.PageFromRequest(r)
+
+		builds, pagination, err := job.Builds(page)
+		if err != nil {
+			logger.Error("failed-to-get-job-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		results, err := s.logSearcher.Search(builds, match)
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logsearch.AddPaginationLinks(w, r, s.externalURL, pagination, results, page.Limit)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(results)
+		if err != nil {
+			logger.Error("failed-to-encode-results", err)
+		}
+	})
+}
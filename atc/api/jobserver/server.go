@@ -3,6 +3,7 @@ package jobserver
 import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/api/logsearch"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/scheduler"
@@ -22,6 +23,7 @@ type Server struct {
 	rejector         auth.Rejector
 	variablesFactory creds.VariablesFactory
 	jobFactory       db.JobFactory
+	logSearcher      logsearch.Searcher
 }
 
 func NewServer(
@@ -30,6 +32,7 @@ func NewServer(
 	externalURL string,
 	variablesFactory creds.VariablesFactory,
 	jobFactory db.JobFactory,
+	logSearcher logsearch.Searcher,
 ) *Server {
 	return &Server{
 		logger:           logger,
@@ -38,5 +41,6 @@ func NewServer(
 		rejector:         auth.UnauthorizedRejector{},
 		variablesFactory: variablesFactory,
 		jobFactory:       jobFactory,
+		logSearcher:      logSearcher,
 	}
 }
@@ -0,0 +1,223 @@
+package logsearch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var ErrMissingQuery = errors.New("missing query")
+
+// Matcher reports whether a log line matches a search.
+type Matcher func(line string) bool
+
+// MatcherFromRequest builds a Matcher from the query parameters of a search
+// request, treating the query as a regular expression if requested and as a
+// substring otherwise.
+func MatcherFromRequest(r *http.Request) (Matcher, error) {
+	query := r.FormValue(atc.BuildLogSearchQuery)
+	if query == "" {
+		return nil, ErrMissingQuery
+	}
+
+	if r.FormValue(atc.BuildLogSearchRegex) != "true" {
+		return func(line string) bool {
+			return strings.Contains(line, query)
+		}, nil
+	}
+
+	re, err := regexp.Compile(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return re.MatchString, nil
+}
+
+// Searcher finds log lines matching a search across builds, scanning at most
+// MaxEvents events per search so that a single request can't tie up the
+// database reading the logs of thousands of builds.
+type Searcher struct {
+	MaxEvents int
+}
+
+// Search returns a result for each build with matching lines, in the order
+// of the builds given. Running builds are skipped, as their event streams
+// don't end. If the limit on events is reached, the search stops and the
+// result for the build being searched is marked as truncated.
+func (searcher Searcher) Search(builds []db.Build, match Matcher) ([]atc.BuildLogSearchResult, error) {
+	results := []atc.BuildLogSearchResult{}
+
+	scanned := 0
+	for _, build := range builds {
+		if build.IsRunning() {
+			continue
+		}
+
+		matches, truncated, err := searcher.searchBuild(build, match, &scanned)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) > 0 || truncated {
+			results = append(results, atc.BuildLogSearchResult{
+				Build:     present.Build(build),
+				Matches:   matches,
+				Truncated: truncated,
+			})
+		}
+
+		if truncated {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+func (searcher Searcher) searchBuild(build db.Build, match Matcher, scanned *int) ([]atc.BuildLogMatch, bool, error) {
+	events, err := build.Events(0)
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer db.Close(events)
+
+	matches := []atc.BuildLogMatch{}
+
+	// log events carry arbitrary chunks of output, so lines are reassembled
+	// per origin before being matched
+	partial := map[event.Origin]string{}
+	origins := []event.Origin{}
+
+	matchLines := func(origin event.Origin, lines []string) {
+		for _, line := range lines {
+			if match(line) {
+				matches = append(matches, atc.BuildLogMatch{
+					Line: line,
+					Origin: atc.BuildLogOrigin{
+						ID:     string(origin.ID),
+						Source: string(origin.Source),
+					},
+				})
+			}
+		}
+	}
+
+	flush := func() {
+		for _, origin := range origins {
+			if partial[origin] != "" {
+				matchLines(origin, []string{partial[origin]})
+			}
+		}
+	}
+
+	for {
+		if searcher.MaxEvents > 0 && *scanned >= searcher.MaxEvents {
+			flush()
+			return matches, true, nil
+		}
+
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return nil, false, err
+		}
+
+		*scanned++
+
+		if ev.Event != event.EventTypeLog {
+			continue
+		}
+
+		var log event.Log
+		err = json.Unmarshal(*ev.Data, &log)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if _, seen := partial[log.Origin]; !seen {
+			origins = append(origins, log.Origin)
+		}
+
+		lines := strings.Split(partial[log.Origin]+log.Payload, "\n")
+		partial[log.Origin] = lines[len(lines)-1]
+
+		matchLines(log.Origin, lines[:len(lines)-1])
+	}
+
+	flush()
+
+	return matches, false, nil
+}
+
+// AddPaginationLinks adds Link headers pointing at the next and previous
+// pages of builds to search, keeping the search parameters of the request.
+//
+// If the search was truncated, the next page carries on with the builds
+// after the one it stopped at rather than from the end of the page. The
+// truncated build itself is not searched again, so that a single build with
+// more events than the limit can't stop the search from making progress.
+func AddPaginationLinks(w http.ResponseWriter, r *http.Request, externalURL string, pagination db.Pagination, results []atc.BuildLogSearchResult, limit int) {
+	if len(results) > 0 && results[len(results)-1].Truncated {
+		pagination.Next = &db.Page{
+			Since: results[len(results)-1].Build.ID,
+			Limit: limit,
+		}
+	}
+
+	addLink := func(page db.Page, rel string) {
+		query := url.Values{}
+		query.Set(atc.BuildLogSearchQuery, r.FormValue(atc.BuildLogSearchQuery))
+
+		if r.FormValue(atc.BuildLogSearchRegex) != "" {
+			query.Set(atc.BuildLogSearchRegex, r.FormValue(atc.BuildLogSearchRegex))
+		}
+
+		if page.Since != 0 {
+			query.Set(atc.PaginationQuerySince, strconv.Itoa(page.Since))
+		}
+
+		if page.Until != 0 {
+			query.Set(atc.PaginationQueryUntil, strconv.Itoa(page.Until))
+		}
+
+		query.Set(atc.PaginationQueryLimit, strconv.Itoa(page.Limit))
+
+		w.Header().Add("Link", fmt.Sprintf(`<%s%s?%s>; rel="%s"`, externalURL, r.URL.Path, query.Encode(), rel))
+	}
+
+	if pagination.Next != nil {
+		addLink(*pagination.Next, atc.LinkRelNext)
+	}
+
+	if pagination.Previous != nil {
+		addLink(*pagination.Previous, atc.LinkRelPrevious)
+	}
+}
+
+// PageFromRequest returns the page of builds to search.
+func PageFromRequest(r *http.Request) db.Page {
+	since, _ := strconv.Atoi(r.FormValue(atc.PaginationQuerySince))
+	until, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryUntil))
+
+	limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if limit == 0 {
+		limit = atc.PaginationAPIDefaultLimit
+	}
+
+	return db.Page{Since: since, Until: until, Limit: limit}
+}
@@ -0,0 +1,121 @@
+package logsearch_test
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/logsearch"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Searcher", func() {
+	Describe("MatcherFromRequest", func() {
+		matcher := func(query string) (logsearch.Matcher, error) {
+			request, err := http.NewRequest("GET", "/logs?"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			return logsearch.MatcherFromRequest(request)
+		}
+
+		It("matches substrings by default", func() {
+			match, err := matcher("query=a.c")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(match("xa.cx")).To(BeTrue())
+			Expect(match("abc")).To(BeFalse())
+		})
+
+		It("matches regular expressions when asked to", func() {
+			match, err := matcher("query=a.c&regex=true")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(match("abc")).To(BeTrue())
+		})
+
+		It("requires a query", func() {
+			_, err := matcher("regex=true")
+			Expect(err).To(Equal(logsearch.ErrMissingQuery))
+		})
+	})
+
+	Describe("Search", func() {
+		var (
+			searcher logsearch.Searcher
+			builds   []db.Build
+		)
+
+		BeforeEach(func() {
+			searcher = logsearch.Searcher{MaxEvents: 3}
+
+			running := new(dbfakes.FakeBuild)
+			running.IDReturns(3)
+			running.IsRunningReturns(true)
+
+			first := new(dbfakes.FakeBuild)
+			first.IDReturns(2)
+			first.EventsReturns(logEvents("match one\n", "match two\n"), nil)
+
+			second := new(dbfakes.FakeBuild)
+			second.IDReturns(1)
+			second.EventsReturns(logEvents("match three\n", "match four\n"), nil)
+
+			builds = []db.Build{running, first, second}
+		})
+
+		It("skips running builds", func() {
+			results, err := searcher.Search(builds, func(string) bool { return true })
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(results[0].Build.ID).To(Equal(2))
+			Expect(builds[0].(*dbfakes.FakeBuild).EventsCallCount()).To(BeZero())
+		})
+
+		It("stops and marks the result as truncated once the event limit is reached", func() {
+			results, err := searcher.Search(builds, func(string) bool { return true })
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Truncated).To(BeFalse())
+			Expect(results[0].Matches).To(HaveLen(2))
+
+			Expect(results[1].Build.ID).To(Equal(1))
+			Expect(results[1].Truncated).To(BeTrue())
+			Expect(results[1].Matches).To(HaveLen(1))
+			Expect(results[1].Matches[0].Line).To(Equal("match three"))
+		})
+	})
+})
+
+func logEvents(payloads ...string) *dbfakes.FakeEventSource {
+	events := []event.Envelope{}
+	for _, payload := range payloads {
+		data, err := json.Marshal(event.Log{
+			Origin:  event.Origin{ID: "some-step", Source: event.OriginSourceStdout},
+			Payload: payload,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		events = append(events, event.Envelope{
+			Data:    (*json.RawMessage)(&data),
+			Event:   event.EventTypeLog,
+			Version: "5.1",
+		})
+	}
+
+	source := new(dbfakes.FakeEventSource)
+	source.NextStub = func() (event.Envelope, error) {
+		if len(events) == 0 {
+			return event.Envelope{}, db.ErrEndOfBuildEventStream
+		}
+
+		next := events[0]
+		events = events[1:]
+		return next, nil
+	}
+
+	return source
+}
@@ -135,6 +135,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "public-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -149,6 +150,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "another-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "another"
 				}]`))
 			})
@@ -174,6 +176,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "private-pipeline",
 					"paused": false,
 					"public": false,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -188,6 +191,7 @@ var _ = Describe("Pipelines API", func() {
 					"name": "public-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "main",
 					"groups": [
 						{
@@ -202,10 +206,27 @@ var _ = Describe("Pipelines API", func() {
 					"name": "another-pipeline",
 					"paused": true,
 					"public": true,
+					"archived": false,
 					"team_name": "another"
 				}]`))
 			})
 
+			Context("when a pipeline is archived", func() {
+				BeforeEach(func() {
+					anotherPublicPipeline.ArchivedReturns(true)
+				})
+
+				It("leaves it out", func() {
+					var pipelines []atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines).To(HaveLen(2))
+					Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+					Expect(pipelines[1].Name).To(Equal("public-pipeline"))
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					dbPipelineFactory.VisiblePipelinesReturns(nil, errors.New("disaster"))
@@ -219,10 +240,17 @@ var _ = Describe("Pipelines API", func() {
 	})
 
 	Describe("GET /api/v1/teams/:team_name/pipelines", func() {
-		var response *http.Response
+		var (
+			response      *http.Response
+			pipelinesPath string
+		)
+
+		BeforeEach(func() {
+			pipelinesPath = "/api/v1/teams/main/pipelines"
+		})
 
 		JustBeforeEach(func() {
-			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/main/pipelines", nil)
+			req, err := http.NewRequest("GET", server.URL+pipelinesPath, nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			req.Header.Set("Content-Type", "application/json")
@@ -260,6 +288,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "private-pipeline",
 						"paused": false,
 						"public": false,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -274,6 +303,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -285,6 +315,37 @@ var _ = Describe("Pipelines API", func() {
 					}]`))
 			})
 
+			Context("when a pipeline is archived", func() {
+				BeforeEach(func() {
+					privatePipeline.ArchivedReturns(true)
+				})
+
+				It("leaves it out", func() {
+					var pipelines []atc.Pipeline
+					err := json.NewDecoder(response.Body).Decode(&pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines).To(HaveLen(1))
+					Expect(pipelines[0].Name).To(Equal("public-pipeline"))
+				})
+
+				Context("when archived pipelines are requested", func() {
+					BeforeEach(func() {
+						pipelinesPath = "/api/v1/teams/main/pipelines?include_archived=true"
+					})
+
+					It("includes it", func() {
+						var pipelines []atc.Pipeline
+						err := json.NewDecoder(response.Body).Decode(&pipelines)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(pipelines).To(HaveLen(2))
+						Expect(pipelines[0].Name).To(Equal("private-pipeline"))
+						Expect(pipelines[0].Archived).To(BeTrue())
+					})
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelinesReturns(nil, errors.New("disaster"))
@@ -312,6 +373,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -340,6 +402,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "public-pipeline",
 						"paused": true,
 						"public": true,
+						"archived": false,
 						"team_name": "main",
 						"groups": [
 							{
@@ -423,6 +486,7 @@ var _ = Describe("Pipelines API", func() {
 						"name": "some-specific-pipeline",
 						"paused": false,
 						"public": true,
+						"archived": false,
 						"team_name": "a-team",
 						"groups": [
 							{
@@ -800,8 +864,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -873,8 +937,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
@@ -953,8 +1017,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipelineDB", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
@@ -978,6 +1042,98 @@ var _ = Describe("Pipelines API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when the pipeline is archived", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchivedReturns(true)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not unpause the pipeline", func() {
+						Expect(dbPipeline.UnpauseCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when requester does not belong to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/archive", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/archive", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when requester belongs to the team", func() {
+				BeforeEach(func() {
+					fakeaccess.IsAuthorizedReturns(true)
+
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.PipelineReturns(dbPipeline, true, nil)
+				})
+
+				It("injects the proper pipelineDB", func() {
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
+				})
+
+				Context("when archiving the pipeline succeeds", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("archives the pipeline", func() {
+						Expect(dbPipeline.ArchiveCallCount()).To(Equal(1))
+					})
+				})
+
+				Context("when archiving the pipeline fails", func() {
+					BeforeEach(func() {
+						dbPipeline.ArchiveReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
 			})
 
 			Context("when requester does not belong to the team", func() {
@@ -1033,8 +1189,8 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
@@ -1111,8 +1267,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
@@ -1431,8 +1587,8 @@ var _ = Describe("Pipelines API", func() {
 				})
 
 				It("injects the proper pipeline", func() {
-					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					pipelineRef := fakeTeam.PipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
@@ -1649,6 +1805,105 @@ var _ = Describe("Pipelines API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/logs", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			publicJob := new(dbfakes.FakeJob)
+			publicJob.NameReturns("public-job")
+			publicJob.ConfigReturns(atc.JobConfig{Public: true})
+
+			privateJob := new(dbfakes.FakeJob)
+			privateJob.NameReturns("private-job")
+			privateJob.ConfigReturns(atc.JobConfig{Public: false})
+
+			fakePipeline.JobsReturns(db.Jobs{publicJob, privateJob}, nil)
+
+			publicBuild := new(dbfakes.FakeBuild)
+			publicBuild.IDReturns(2)
+			publicBuild.NameReturns("1")
+			publicBuild.JobNameReturns("public-job")
+			publicBuild.StatusReturns(db.BuildStatusSucceeded)
+			publicBuild.EventsReturns(fakeLogEventSource("public some-match\n"), nil)
+
+			privateBuild := new(dbfakes.FakeBuild)
+			privateBuild.IDReturns(1)
+			privateBuild.NameReturns("1")
+			privateBuild.JobNameReturns("private-job")
+			privateBuild.StatusReturns(db.BuildStatusSucceeded)
+			privateBuild.EventsReturns(fakeLogEventSource("private some-match\n"), nil)
+
+			fakePipeline.BuildsReturns([]db.Build{publicBuild, privateBuild}, db.Pagination{}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/logs?query=some-match")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		matchedLines := func() []string {
+			var results []atc.BuildLogSearchResult
+			err := json.NewDecoder(response.Body).Decode(&results)
+			Expect(err).NotTo(HaveOccurred())
+
+			lines := []string{}
+			for _, result := range results {
+				for _, match := range result.Matches {
+					lines = append(lines, match.Line)
+				}
+			}
+
+			return lines
+		}
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+			})
+
+			It("searches the builds of every job", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(matchedLines()).To(Equal([]string{"public some-match", "private some-match"}))
+			})
+
+			Context("when getting the builds fails", func() {
+				BeforeEach(func() {
+					fakePipeline.BuildsReturns(nil, db.Pagination{}, errors.New("oh no!"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized and the pipeline is public", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthorizedReturns(false)
+				fakePipeline.PublicReturns(true)
+			})
+
+			It("only searches the builds of public jobs", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(matchedLines()).To(Equal([]string{"public some-match"}))
+			})
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/builds", func() {
 		var plan atc.Plan
 		var response *http.Response
@@ -1736,8 +1991,8 @@ var _ = Describe("Pipelines API", func() {
 					})
 
 					It("injects the proper pipeline", func() {
-						pipelineName := fakeTeam.PipelineArgsForCall(0)
-						Expect(pipelineName).To(Equal("a-pipeline"))
+						pipelineRef := fakeTeam.PipelineArgsForCall(0)
+						Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 					})
 
 					It("returns 201 Created", func() {
@@ -40,6 +40,10 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.FormValue("include_archived") != "true" {
+		pipelines = unarchived(pipelines)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 
 	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
@@ -48,3 +52,14 @@ func (s *Server) ListPipelines(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusInternalServerError)
 	}
 }
+
+func unarchived(pipelines []db.Pipeline) []db.Pipeline {
+	filtered := []db.Pipeline{}
+	for _, pipeline := range pipelines {
+		if !pipeline.Archived() {
+			filtered = append(filtered, pipeline)
+		}
+	}
+
+	return filtered
+}
@@ -21,6 +21,10 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if r.FormValue("include_archived") != "true" {
+		pipelines = unarchived(pipelines)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -69,10 +70,16 @@ func (s *Server) ListPipelineBuilds(pipeline db.Pipeline) http.Handler {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 
+		authorized := accessor.GetAccessor(r).IsAuthorized(teamName)
+
 		atc := make([]atc.Build, len(builds))
 		for i := 0; i < len(builds); i++ {
 			build := builds[i]
-			atc[i] = present.Build(build)
+			if authorized {
+				atc[i] = present.DetailedBuild(build)
+			} else {
+				atc[i] = present.Build(build)
+			}
 		}
 
 		err = json.NewEncoder(w).Encode(atc)
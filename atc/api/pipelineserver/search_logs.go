@@ -0,0 +1,81 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/logsearch"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchPipelineLogs(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("search-pipeline-logs")
+
+		match, err := logsearch.MatcherFromRequest(r)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(err.Error()))
+			return
+		}
+
+		page := logsearch.PageFromRequest(r)
+
+		builds, pagination, err := pipeline.Builds(page)
+		if err != nil {
+			logger.Error("failed-to-get-pipeline-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !acc.IsAuthorized(pipeline.TeamName()) {
+			builds, err = publicJobBuilds(pipeline, builds)
+			if err != nil {
+				logger.Error("failed-to-get-jobs", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
+		results, err := s.logSearcher.Search(builds, match)
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logsearch.AddPaginationLinks(w, r, s.externalURL, pagination, results, page.Limit)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(results)
+		if err != nil {
+			logger.Error("failed-to-encode-results", err)
+		}
+	})
+}
+
+// publicJobBuilds filters out the builds of jobs whose logs are private.
+func publicJobBuilds(pipeline db.Pipeline, builds []db.Build) ([]db.Build, error) {
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return nil, err
+	}
+
+	public := map[string]bool{}
+	for _, job := range jobs {
+		public[job.Name()] = job.Config().Public
+	}
+
+	filtered := []db.Build{}
+	for _, build := range builds {
+		if public[build.JobName()] {
+			filtered = append(filtered, build)
+		}
+	}
+
+	return filtered, nil
+}
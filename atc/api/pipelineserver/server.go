@@ -3,6 +3,7 @@ package pipelineserver
 import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/api/logsearch"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
 )
@@ -14,6 +15,7 @@ type Server struct {
 	pipelineFactory db.PipelineFactory
 	engine          engine.Engine
 	externalURL     string
+	logSearcher     logsearch.Searcher
 }
 
 func NewServer(
@@ -22,6 +24,7 @@ func NewServer(
 	pipelineFactory db.PipelineFactory,
 	externalURL string,
 	engine engine.Engine,
+	logSearcher logsearch.Searcher,
 ) *Server {
 	return &Server{
 		logger:          logger,
@@ -30,5 +33,6 @@ func NewServer(
 		pipelineFactory: pipelineFactory,
 		externalURL:     externalURL,
 		engine:          engine,
+		logSearcher:     logSearcher,
 	}
 }
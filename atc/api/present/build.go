@@ -19,15 +19,25 @@ func Build(build db.Build) atc.Build {
 	}
 
 	atcBuild := atc.Build{
-		ID:           build.ID(),
-		Name:         build.Name(),
-		JobName:      build.JobName(),
-		PipelineName: build.PipelineName(),
-		TeamName:     build.TeamName(),
-		Status:       string(build.Status()),
-		APIURL:       apiURL,
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		JobName:              build.JobName(),
+		PipelineName:         build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		TeamName:             build.TeamName(),
+		Status:               string(build.Status()),
+		APIURL:               apiURL,
 	}
 
+	if build.RerunOf() != 0 {
+		atcBuild.RerunOf = &atc.RerunOfBuild{
+			ID:   build.RerunOf(),
+			Name: build.RerunOfName(),
+		}
+	}
+
+	atcBuild.TestSummary = build.TestSummary()
+
 	if !build.StartTime().IsZero() {
 		atcBuild.StartTime = build.StartTime().Unix()
 	}
@@ -42,3 +52,26 @@ func Build(build db.Build) atc.Build {
 
 	return atcBuild
 }
+
+// DetailedBuild presents the build along with the annotations its tasks
+// attached to it and the comments left on it. Like the build's logs, they may
+// be sensitive, so they should only be shown to those authorized for the
+// build's team.
+func DetailedBuild(build db.Build) atc.Build {
+	atcBuild := Build(build)
+	atcBuild.Annotations = build.Annotations()
+
+	for _, comment := range build.Comments() {
+		atcBuild.Comments = append(atcBuild.Comments, BuildComment(comment))
+	}
+
+	return atcBuild
+}
+
+func BuildComment(comment db.BuildComment) atc.BuildComment {
+	return atc.BuildComment{
+		Author:  comment.Author,
+		Comment: comment.Comment,
+		Time:    comment.Time.Unix(),
+	}
+}
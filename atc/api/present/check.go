@@ -0,0 +1,37 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Check(check db.Check, showCheckError bool) atc.Check {
+	atcCheck := atc.Check{
+		ID:          check.ID(),
+		Status:      string(check.Status()),
+		FromVersion: check.FromVersion(),
+		NewVersions: check.NewVersions(),
+	}
+
+	if !check.CreateTime().IsZero() {
+		atcCheck.CreateTime = check.CreateTime().Unix()
+	}
+
+	if !check.StartTime().IsZero() {
+		atcCheck.StartTime = check.StartTime().Unix()
+	}
+
+	if !check.EndTime().IsZero() {
+		atcCheck.EndTime = check.EndTime().Unix()
+	}
+
+	if check.CheckError() != nil && showCheckError {
+		atcCheck.CheckError = check.CheckError().Error()
+	}
+
+	if showCheckError {
+		atcCheck.Stderr = check.Stderr()
+	}
+
+	return atcCheck
+}
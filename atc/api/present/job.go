@@ -52,6 +52,7 @@ func Job(
 
 		Name:                 job.Name(),
 		PipelineName:         job.PipelineName(),
+		PipelineInstanceVars: job.PipelineInstanceVars(),
 		TeamName:             teamName,
 		DisableManualTrigger: job.Config().DisableManualTrigger,
 		Paused:               job.Paused(),
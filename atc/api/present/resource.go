@@ -21,10 +21,11 @@ func Resource(resource db.Resource, showCheckError bool, teamName string) atc.Re
 	}
 
 	atcResource := atc.Resource{
-		Name:         resource.Name(),
-		PipelineName: resource.PipelineName(),
-		TeamName:     teamName,
-		Type:         resource.Type(),
+		Name:                 resource.Name(),
+		PipelineName:         resource.PipelineName(),
+		PipelineInstanceVars: resource.PipelineInstanceVars(),
+		TeamName:             teamName,
+		Type:                 resource.Type(),
 
 		FailingToCheck:  failingToCheck,
 		CheckSetupError: checkErrString,
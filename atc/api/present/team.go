@@ -10,5 +10,7 @@ func Team(team db.Team) atc.Team {
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		DisableSecretRedaction: team.SecretRedactionDisabled(),
 	}
 }
@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -470,8 +473,8 @@ var _ = Describe("Resources API", func() {
 
 			It("injects the proper pipelineDB", func() {
 				Expect(dbTeam.PipelineCallCount()).To(Equal(1))
-				pipelineName := dbTeam.PipelineArgsForCall(0)
-				Expect(pipelineName).To(Equal("a-pipeline"))
+				pipelineRef := dbTeam.PipelineArgsForCall(0)
+				Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 			})
 
 			It("tries to scan with no version specified", func() {
@@ -1008,6 +1011,186 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/checks", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/checks", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and not authorized", func() {
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(false)
+				fakeaccess.IsAuthorizedReturns(false)
+			})
+
+			Context("and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("and the pipeline is public", func() {
+				BeforeEach(func() {
+					fakePipeline.PublicReturns(true)
+
+					fakeCheck := new(dbfakes.FakeCheck)
+					fakeCheck.IDReturns(1)
+					fakeCheck.StatusReturns(db.CheckStatusErrored)
+					fakeCheck.CreateTimeReturns(time.Unix(100, 0))
+					fakeCheck.StartTimeReturns(time.Unix(101, 0))
+					fakeCheck.EndTimeReturns(time.Unix(102, 0))
+					fakeCheck.CheckErrorReturns(errors.New("sup"))
+					fakeCheck.FromVersionReturns(atc.Version{"ref": "v1"})
+					fakeCheck.NewVersionsReturns(2)
+					fakeCheck.StderrReturns("some-stderr")
+
+					resource1 := new(dbfakes.FakeResource)
+					resource1.ChecksReturns([]db.Check{fakeCheck}, nil)
+
+					fakePipeline.ResourceReturns(resource1, true, nil)
+				})
+
+				It("returns the checks without their errors or stderr", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 1,
+							"status": "errored",
+							"create_time": 100,
+							"start_time": 101,
+							"end_time": 102,
+							"from_version": {"ref": "v1"},
+							"new_versions": 2
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			var resource1 *dbfakes.FakeResource
+
+			BeforeEach(func() {
+				fakeaccess.IsAuthenticatedReturns(true)
+				fakeaccess.IsAuthorizedReturns(true)
+
+				resource1 = new(dbfakes.FakeResource)
+			})
+
+			Context("when the resource is found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(resource1, true, nil)
+				})
+
+				It("looks it up in the database", func() {
+					Expect(fakePipeline.ResourceCallCount()).To(Equal(1))
+					Expect(fakePipeline.ResourceArgsForCall(0)).To(Equal("some-resource"))
+				})
+
+				Context("when getting the checks succeeds", func() {
+					BeforeEach(func() {
+						startedCheck := new(dbfakes.FakeCheck)
+						startedCheck.IDReturns(2)
+						startedCheck.StatusReturns(db.CheckStatusStarted)
+						startedCheck.CreateTimeReturns(time.Unix(200, 0))
+						startedCheck.StartTimeReturns(time.Unix(201, 0))
+
+						erroredCheck := new(dbfakes.FakeCheck)
+						erroredCheck.IDReturns(1)
+						erroredCheck.StatusReturns(db.CheckStatusErrored)
+						erroredCheck.CreateTimeReturns(time.Unix(100, 0))
+						erroredCheck.StartTimeReturns(time.Unix(101, 0))
+						erroredCheck.EndTimeReturns(time.Unix(102, 0))
+						erroredCheck.CheckErrorReturns(errors.New("sup"))
+						erroredCheck.FromVersionReturns(atc.Version{"ref": "v1"})
+						erroredCheck.StderrReturns("some-stderr")
+
+						resource1.ChecksReturns([]db.Check{startedCheck, erroredCheck}, nil)
+					})
+
+					It("returns 200 OK", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+					})
+
+					It("returns the checks with their errors and stderr", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 2,
+								"status": "started",
+								"create_time": 200,
+								"start_time": 201,
+								"new_versions": 0
+							},
+							{
+								"id": 1,
+								"status": "errored",
+								"create_time": 100,
+								"start_time": 101,
+								"end_time": 102,
+								"check_error": "sup",
+								"from_version": {"ref": "v1"},
+								"new_versions": 0,
+								"stderr": "some-stderr"
+							}
+						]`))
+					})
+				})
+
+				Context("when getting the checks fails", func() {
+					BeforeEach(func() {
+						resource1.ChecksReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when looking up the resource fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", func() {
 		var checkRequestBody atc.CheckRequestBody
 		var response *http.Response
@@ -1116,6 +1299,10 @@ var _ = Describe("Resources API", func() {
 			fakeResource              *dbfakes.FakeResource
 			fakeResourceConfig        *dbfakes.FakeResourceConfig
 			fakeResourceConfigVersion *dbfakes.FakeResourceConfigVersion
+
+			webhookQuery   string
+			webhookPayload string
+			webhookHeader  func([]byte) http.Header
 		)
 
 		BeforeEach(func() {
@@ -1123,6 +1310,10 @@ var _ = Describe("Resources API", func() {
 			fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
 			checkRequestBody = atc.CheckRequestBody{}
 
+			webhookQuery = "?webhook_token=fake-token"
+			webhookPayload = ""
+			webhookHeader = nil
+
 			fakeResource = new(dbfakes.FakeResource)
 			fakeResource.NameReturns("resource-name")
 			fakeResourceConfig = new(dbfakes.FakeResourceConfig)
@@ -1133,14 +1324,235 @@ var _ = Describe("Resources API", func() {
 			reqPayload, err := json.Marshal(checkRequestBody)
 			Expect(err).NotTo(HaveOccurred())
 
-			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook?webhook_token=fake-token", bytes.NewBuffer(reqPayload))
+			if webhookPayload != "" {
+				reqPayload = []byte(webhookPayload)
+			}
+
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/check/webhook"+webhookQuery, bytes.NewBuffer(reqPayload))
 			Expect(err).NotTo(HaveOccurred())
 			request.Header.Set("Content-Type", "application/json")
 
+			if webhookHeader != nil {
+				for name, values := range webhookHeader(reqPayload) {
+					request.Header.Set(name, values[0])
+				}
+			}
+
 			response, err = client.Do(request)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		Context("when the resource has a webhook", func() {
+			var webhook *atc.WebhookConfig
+
+			sign := func(secret string, payload []byte) string {
+				mac := hmac.New(sha256.New, []byte(secret))
+				mac.Write(payload)
+				return hex.EncodeToString(mac.Sum(nil))
+			}
+
+			signedWith := func(header string, secret string) func([]byte) http.Header {
+				return func(payload []byte) http.Header {
+					return http.Header{header: {"sha256=" + sign(secret, payload)}}
+				}
+			}
+
+			BeforeEach(func() {
+				fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{
+					"webhook-secret": "some-secret",
+				})
+
+				webhook = &atc.WebhookConfig{
+					Provider: atc.WebhookProviderGitHub,
+					Secret:   "((webhook-secret))",
+				}
+
+				fakeResource.WebhookReturns(webhook)
+				fakeResource.ResourceConfigIDReturns(1)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+				dbResourceConfigFactory.FindResourceConfigByIDReturns(fakeResourceConfig, true, nil)
+
+				webhookQuery = ""
+				webhookPayload = `{"ref":"refs/heads/master"}`
+			})
+
+			Context("from GitHub", func() {
+				BeforeEach(func() {
+					webhookHeader = signedWith("X-Hub-Signature-256", "some-secret")
+				})
+
+				Context("when the payload is signed with the secret", func() {
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("scans the resource", func() {
+						Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+						_, actualResourceName, _ := fakeScanner.ScanFromVersionArgsForCall(0)
+						Expect(actualResourceName).To(Equal("resource-name"))
+					})
+				})
+
+				Context("when the payload is signed with another secret", func() {
+					BeforeEach(func() {
+						webhookHeader = signedWith("X-Hub-Signature-256", "some-other-secret")
+					})
+
+					It("returns 401 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+						Consistently(fakeScanner.ScanFromVersionCallCount).Should(BeZero())
+					})
+				})
+
+				Context("when the payload is not signed", func() {
+					BeforeEach(func() {
+						webhookHeader = nil
+					})
+
+					It("returns 400 without scanning", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Consistently(fakeScanner.ScanFromVersionCallCount).Should(BeZero())
+					})
+				})
+
+				Context("when the secret cannot be evaluated", func() {
+					BeforeEach(func() {
+						fakeVariablesFactory.NewVariablesReturns(template.StaticVariables{})
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the webhook filters branches", func() {
+					BeforeEach(func() {
+						webhook.Branches = []string{"release/*", "master"}
+					})
+
+					Context("when a matching branch was pushed to", func() {
+						It("returns 200 and scans the resource", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Eventually(fakeScanner.ScanFromVersionCallCount).Should(Equal(1))
+						})
+					})
+
+					Context("when another branch was pushed to", func() {
+						BeforeEach(func() {
+							webhookPayload = `{"ref":"refs/heads/feature"}`
+						})
+
+						It("returns 204 without scanning", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							Consistently(fakeScanner.ScanFromVersionCallCount).Should(BeZero())
+						})
+					})
+
+					Context("when a tag was pushed", func() {
+						BeforeEach(func() {
+							webhookPayload = `{"ref":"refs/tags/master"}`
+						})
+
+						It("returns 204", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+						})
+					})
+
+					Context("when the payload is malformed", func() {
+						BeforeEach(func() {
+							webhookPayload = `not json`
+						})
+
+						It("returns 400", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						})
+					})
+				})
+			})
+
+			Context("from GitLab", func() {
+				BeforeEach(func() {
+					webhook.Provider = atc.WebhookProviderGitLab
+					webhookHeader = func([]byte) http.Header {
+						return http.Header{"X-Gitlab-Token": {"some-secret"}}
+					}
+				})
+
+				It("returns 200 when the token is the secret", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				Context("when the token is not the secret", func() {
+					BeforeEach(func() {
+						webhookHeader = func([]byte) http.Header {
+							return http.Header{"X-Gitlab-Token": {"bogus"}}
+						}
+					})
+
+					It("returns 401", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+			})
+
+			Context("from Bitbucket", func() {
+				BeforeEach(func() {
+					webhook.Provider = atc.WebhookProviderBitbucket
+					webhook.Branches = []string{"master"}
+					webhookHeader = signedWith("X-Hub-Signature", "some-secret")
+				})
+
+				Context("when a matching branch was pushed to", func() {
+					BeforeEach(func() {
+						webhookPayload = `{"push":{"changes":[{"new":{"type":"branch","name":"master"}}]}}`
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when another branch was pushed to", func() {
+					BeforeEach(func() {
+						webhookPayload = `{"push":{"changes":[{"new":{"type":"branch","name":"feature"}}]}}`
+					})
+
+					It("returns 204", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					})
+				})
+			})
+
+			Context("from a generic sender", func() {
+				BeforeEach(func() {
+					webhook.Provider = atc.WebhookProviderHMAC
+					webhook.Header = "X-Some-Signature"
+				})
+
+				Context("when the signature is in the configured header", func() {
+					BeforeEach(func() {
+						webhookHeader = func(payload []byte) http.Header {
+							return http.Header{"X-Some-Signature": {sign("some-secret", payload)}}
+						}
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when the signature is in the default header", func() {
+					BeforeEach(func() {
+						webhookHeader = signedWith(atc.DefaultWebhookSignatureHeader, "some-secret")
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+				})
+			})
+		})
+
 		Context("when authorized", func() {
 			BeforeEach(func() {
 				variables = template.StaticVariables{
@@ -1276,4 +1688,149 @@ var _ = Describe("Resources API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/webhook", func() {
+		var (
+			response *http.Response
+
+			payload   string
+			signature string
+		)
+
+		sign := func(secret string, payload []byte) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write(payload)
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		fakeResource := func(id int, name string) *dbfakes.FakeResource {
+			resource := new(dbfakes.FakeResource)
+			resource.IDReturns(id)
+			resource.NameReturns(name)
+			return resource
+		}
+
+		BeforeEach(func() {
+			payload = `{
+				"ref": "refs/heads/master",
+				"repository": {
+					"clone_url": "https://github.com/concourse/concourse.git",
+					"ssh_url": "git@github.com:concourse/concourse.git"
+				}
+			}`
+			signature = sign("global-secret", []byte(payload))
+
+			dbResourceFactory.ResourcesBySourceFieldReturns([]db.Resource{
+				fakeResource(1, "some-resource"),
+				fakeResource(2, "ssh-resource"),
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/webhook", bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+
+			if signature != "" {
+				request.Header.Set("X-Hub-Signature-256", signature)
+			}
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns 200", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("looks up the resources by each way of writing the repository's URLs", func() {
+			Expect(dbResourceFactory.ResourcesBySourceFieldCallCount()).To(Equal(1))
+
+			field, values := dbResourceFactory.ResourcesBySourceFieldArgsForCall(0)
+			Expect(field).To(Equal("uri"))
+			Expect(values).To(ConsistOf(
+				"https://github.com/concourse/concourse",
+				"https://github.com/concourse/concourse/",
+				"https://github.com/concourse/concourse.git",
+				"https://github.com/concourse/concourse.git/",
+				"git@github.com:concourse/concourse",
+				"git@github.com:concourse/concourse/",
+				"git@github.com:concourse/concourse.git",
+				"git@github.com:concourse/concourse.git/",
+			))
+		})
+
+		It("queues an immediate check of each resource found", func() {
+			Expect(dbCheckFactory.CreateImmediateResourceCheckCallCount()).To(Equal(2))
+			Expect(dbCheckFactory.CreateImmediateResourceCheckArgsForCall(0)).To(Equal(1))
+			Expect(dbCheckFactory.CreateImmediateResourceCheckArgsForCall(1)).To(Equal(2))
+		})
+
+		It("does not scan the resources itself", func() {
+			Consistently(fakeScannerFactory.NewResourceScannerCallCount).Should(BeZero())
+		})
+
+		Context("when no resource is of the repository", func() {
+			BeforeEach(func() {
+				dbResourceFactory.ResourcesBySourceFieldReturns(nil, nil)
+			})
+
+			It("returns 204 without queueing checks", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbCheckFactory.CreateImmediateResourceCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is signed with another secret", func() {
+			BeforeEach(func() {
+				signature = sign("some-other-secret", []byte(payload))
+			})
+
+			It("returns 401 without looking up resources", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbResourceFactory.ResourcesBySourceFieldCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the payload is not signed", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				payload = `{`
+				signature = sign("global-secret", []byte(payload))
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when getting the resources fails", func() {
+			BeforeEach(func() {
+				dbResourceFactory.ResourcesBySourceFieldReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when queueing a check fails", func() {
+			BeforeEach(func() {
+				dbCheckFactory.CreateImmediateResourceCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
 })
@@ -2,6 +2,8 @@ package resourceserver
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
@@ -12,18 +14,16 @@ import (
 )
 
 // CheckResourceWebHook defines a handler for process a check resource request via an access token.
+//
+// If the resource configures a webhook, the request is instead verified
+// against the webhook's provider, and only triggers a check if its payload
+// passes the webhook's filters. Requests which are verified but filtered out
+// are answered with 204 No Content.
 func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 	logger := s.logger.Session("check-resource-webhook")
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		resourceName := rata.Param(r, "resource_name")
-		webhookToken := r.URL.Query().Get("webhook_token")
-
-		if webhookToken == "" {
-			logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
-			w.WriteHeader(http.StatusBadRequest)
-			return
-		}
 
 		pipelineResource, found, err := dbPipeline.Resource(resourceName)
 		if err != nil {
@@ -39,37 +39,110 @@ func (s *Server) CheckResourceWebHook(dbPipeline db.Pipeline) http.Handler {
 		}
 
 		variables := s.variablesFactory.NewVariables(dbPipeline.TeamName(), dbPipeline.Name())
-		token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
-		if token != webhookToken {
-			logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook %s", webhookToken)})
-			w.WriteHeader(http.StatusUnauthorized)
-			return
-		}
 
-		go func() {
-			var fromVersion atc.Version
-			resourceConfigId := pipelineResource.ResourceConfigID()
-			resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigId)
+		webhook := pipelineResource.Webhook()
+		if webhook == nil {
+			webhookToken := r.URL.Query().Get("webhook_token")
+			if webhookToken == "" {
+				logger.Info("no-webhook-token", lager.Data{"error": "missing webhook_token"})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			token, err := creds.NewString(variables, pipelineResource.WebhookToken()).Evaluate()
 			if err != nil {
-				logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigId})
+				logger.Error("failed-to-evaluate-webhook-token", err, lager.Data{"resource-name": resourceName})
+				w.WriteHeader(http.StatusInternalServerError)
 				return
 			}
 
-			if found {
-				latestVersion, found, err := resourceConfig.LatestVersion()
+			if token != webhookToken {
+				logger.Info("invalid-token", lager.Data{"error": fmt.Sprintf("invalid token for webhook of %s", resourceName)})
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+		} else {
+			payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize+1))
+			if err != nil {
+				logger.Error("failed-to-read-payload", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if len(payload) > maxWebhookPayloadSize {
+				logger.Info("payload-too-large")
+				w.WriteHeader(http.StatusRequestEntityTooLarge)
+				return
+			}
+
+			secret, err := creds.NewString(variables, webhook.Secret).Evaluate()
+			if err != nil {
+				logger.Error("failed-to-evaluate-webhook-secret", err, lager.Data{"resource-name": resourceName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			err = verifyWebhook(*webhook, secret, r.Header, payload)
+			switch err {
+			case nil:
+			case errMissingSignature:
+				logger.Info("missing-signature", lager.Data{"provider": webhook.Provider})
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			case errInvalidSignature:
+				logger.Info("invalid-signature", lager.Data{"provider": webhook.Provider})
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			default:
+				logger.Error("failed-to-verify-webhook", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if len(webhook.Branches) > 0 {
+				branches, err := webhookBranches(webhook.Provider, payload)
 				if err != nil {
-					logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigId})
+					logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+					w.WriteHeader(http.StatusBadRequest)
 					return
 				}
-				if found {
-					fromVersion = atc.Version(latestVersion.Version())
+
+				if !matchesBranches(webhook.Branches, branches) {
+					logger.Debug("filtered-out", lager.Data{"branches": branches})
+					w.WriteHeader(http.StatusNoContent)
+					return
 				}
 			}
+		}
 
-			scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
-			scanner.ScanFromVersion(logger, resourceName, fromVersion)
-		}()
+		go s.scanFromLatestVersion(logger, dbPipeline, pipelineResource)
 
 		w.WriteHeader(http.StatusOK)
 	})
 }
+
+// scanFromLatestVersion scans the resource for versions newer than the latest
+// one of its resource config.
+func (s *Server) scanFromLatestVersion(logger lager.Logger, dbPipeline db.Pipeline, pipelineResource db.Resource) {
+	var fromVersion atc.Version
+	resourceConfigId := pipelineResource.ResourceConfigID()
+	resourceConfig, found, err := s.resourceConfigFactory.FindResourceConfigByID(resourceConfigId)
+	if err != nil {
+		logger.Error("failed-to-get-resource-config", err, lager.Data{"resource-config-id": resourceConfigId})
+		return
+	}
+
+	if found {
+		latestVersion, found, err := resourceConfig.LatestVersion()
+		if err != nil {
+			logger.Error("failed-to-get-latest-resource-version", err, lager.Data{"resource-config-id": resourceConfigId})
+			return
+		}
+		if found {
+			fromVersion = atc.Version(latestVersion.Version())
+		}
+	}
+
+	scanner := s.scannerFactory.NewResourceScanner(dbPipeline)
+	scanner.ScanFromVersion(logger, pipelineResource.Name(), fromVersion)
+}
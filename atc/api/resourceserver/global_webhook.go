@@ -0,0 +1,105 @@
+package resourceserver
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// ReceiveGlobalWebhook queues a check of every resource of an unpaused
+// pipeline whose resource config's source refers to the repository of the
+// payload, to run however recently the resource was checked. Resources
+// sharing a resource config are only checked once. It answers with 204 No
+// Content if no resource refers to the repository.
+func (s *Server) ReceiveGlobalWebhook(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("receive-global-webhook")
+
+	if s.globalWebhook.Secret == "" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	payload, err := ioutil.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize+1))
+	if err != nil {
+		logger.Error("failed-to-read-payload", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if len(payload) > maxWebhookPayloadSize {
+		logger.Info("payload-too-large")
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	webhook := atc.WebhookConfig{
+		Provider: s.globalWebhook.Provider,
+		Header:   s.globalWebhook.Header,
+	}
+
+	err = verifyWebhook(webhook, s.globalWebhook.Secret, r.Header, payload)
+	switch err {
+	case nil:
+	case errMissingSignature:
+		logger.Info("missing-signature")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	case errInvalidSignature:
+		logger.Info("invalid-signature")
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	default:
+		logger.Error("failed-to-verify-webhook", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	urls, err := webhookRepositoryURLs(webhook.Provider, payload)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	matched, err := s.resourceFactory.ResourcesBySourceField(s.globalWebhook.SourceField, repositoryURLVariants(urls))
+	if err != nil {
+		logger.Error("failed-to-get-resources", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if len(matched) == 0 {
+		logger.Debug("no-resources-matched", lager.Data{"urls": urls})
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	for _, resource := range matched {
+		_, _, err := s.checkFactory.CreateImmediateResourceCheck(resource.ID())
+		if err != nil {
+			logger.Error("failed-to-queue-check", err, lager.Data{
+				"team":     resource.TeamName(),
+				"pipeline": resource.PipelineName(),
+				"resource": resource.Name(),
+			})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// repositoryURLVariants returns the URLs as they may be written in a source,
+// i.e. undoing normalizeRepositoryURL.
+func repositoryURLVariants(urls []string) []string {
+	variants := []string{}
+	for _, url := range urls {
+		variants = append(variants, url, url+"/", url+".git", url+".git/")
+	}
+
+	return variants
+}
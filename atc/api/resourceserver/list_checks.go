@@ -0,0 +1,57 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListResourceChecks(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-resource-checks")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+		teamName := r.FormValue(":team_name")
+
+		dbResource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		dbChecks, err := dbResource.Checks()
+		if err != nil {
+			logger.Error("failed-to-get-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		showCheckError := acc.IsAuthorized(teamName)
+
+		checks := []atc.Check{}
+		for _, check := range dbChecks {
+			checks = append(checks, present.Check(check, showCheckError))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(checks)
+		if err != nil {
+			logger.Error("failed-to-encode-checks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -20,6 +20,19 @@ type Server struct {
 	variablesFactory      creds.VariablesFactory
 	resourceFactory       db.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
+	checkFactory          db.CheckFactory
+	globalWebhook         GlobalWebhook
+}
+
+// GlobalWebhook receives the payloads of one provider, e.g. of a webhook
+// configured on a whole GitHub organization, and checks every resource whose
+// source's SourceField is the URL of the payload's repository. It's disabled
+// unless a secret is configured.
+type GlobalWebhook struct {
+	Provider    string
+	Secret      string
+	Header      string
+	SourceField string
 }
 
 func NewServer(
@@ -28,6 +41,8 @@ func NewServer(
 	variablesFactory creds.VariablesFactory,
 	resourceFactory db.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	checkFactory db.CheckFactory,
+	globalWebhook GlobalWebhook,
 ) *Server {
 	return &Server{
 		logger:                logger,
@@ -35,5 +50,7 @@ func NewServer(
 		variablesFactory:      variablesFactory,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		checkFactory:          checkFactory,
+		globalWebhook:         globalWebhook,
 	}
 }
@@ -0,0 +1,236 @@
+package resourceserver
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// maxWebhookPayloadSize is the largest payload GitHub will deliver.
+const maxWebhookPayloadSize = 25 << 20
+
+var (
+	errMissingSignature = errors.New("missing signature")
+	errInvalidSignature = errors.New("invalid signature")
+)
+
+// verifyWebhook checks that the payload was sent by the webhook's provider,
+// i.e. that it was signed with the secret or, for GitLab, sent along with it.
+func verifyWebhook(webhook atc.WebhookConfig, secret string, header http.Header, payload []byte) error {
+	switch webhook.Provider {
+	case atc.WebhookProviderGitHub:
+		return verifyHMAC(secret, header.Get("X-Hub-Signature-256"), payload)
+
+	case atc.WebhookProviderBitbucket:
+		return verifyHMAC(secret, header.Get("X-Hub-Signature"), payload)
+
+	case atc.WebhookProviderHMAC:
+		name := webhook.Header
+		if name == "" {
+			name = atc.DefaultWebhookSignatureHeader
+		}
+
+		return verifyHMAC(secret, header.Get(name), payload)
+
+	case atc.WebhookProviderGitLab:
+		token := header.Get("X-Gitlab-Token")
+		if token == "" {
+			return errMissingSignature
+		}
+
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return errInvalidSignature
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unknown webhook provider '%s'", webhook.Provider)
+	}
+}
+
+func verifyHMAC(secret string, signature string, payload []byte) error {
+	if signature == "" {
+		return errMissingSignature
+	}
+
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	if !hmac.Equal(expected, mac.Sum(nil)) {
+		return errInvalidSignature
+	}
+
+	return nil
+}
+
+type refPayload struct {
+	Ref string `json:"ref"`
+}
+
+type bitbucketPayload struct {
+	// Bitbucket Cloud
+	Push struct {
+		Changes []struct {
+			New *struct {
+				Type string `json:"type"`
+				Name string `json:"name"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+
+	// Bitbucket Server
+	Changes []struct {
+		Ref struct {
+			Type      string `json:"type"`
+			DisplayID string `json:"displayId"`
+		} `json:"ref"`
+	} `json:"changes"`
+}
+
+// webhookBranches returns the branches pushed to, according to the payload.
+// Pushes of tags, and events other than pushes, are of no branch.
+func webhookBranches(provider string, payload []byte) ([]string, error) {
+	branches := []string{}
+
+	if provider == atc.WebhookProviderBitbucket {
+		var push bitbucketPayload
+		err := json.Unmarshal(payload, &push)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range push.Push.Changes {
+			if change.New != nil && change.New.Type == "branch" {
+				branches = append(branches, change.New.Name)
+			}
+		}
+
+		for _, change := range push.Changes {
+			if change.Ref.Type == "BRANCH" {
+				branches = append(branches, change.Ref.DisplayID)
+			}
+		}
+
+		return branches, nil
+	}
+
+	var push refPayload
+	err := json.Unmarshal(payload, &push)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(push.Ref, "refs/heads/") {
+		branches = append(branches, strings.TrimPrefix(push.Ref, "refs/heads/"))
+	}
+
+	return branches, nil
+}
+
+func matchesBranches(patterns []string, branches []string) bool {
+	for _, pattern := range patterns {
+		for _, branch := range branches {
+			matched, err := path.Match(pattern, branch)
+			if err == nil && matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+type repositoryPayload struct {
+	// GitHub, Bitbucket
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		GitURL   string `json:"git_url"`
+		HTMLURL  string `json:"html_url"`
+		FullName string `json:"full_name"`
+		Links    struct {
+			HTML struct {
+				Href string `json:"href"`
+			} `json:"html"`
+			Clone []struct {
+				Href string `json:"href"`
+			} `json:"clone"`
+		} `json:"links"`
+	} `json:"repository"`
+
+	// GitLab
+	Project struct {
+		GitHTTPURL string `json:"git_http_url"`
+		GitSSHURL  string `json:"git_ssh_url"`
+		WebURL     string `json:"web_url"`
+	} `json:"project"`
+
+	// generic
+	URI string `json:"uri"`
+}
+
+// webhookRepositoryURLs returns the URLs by which the repository the payload
+// is about may be known, e.g. as the uri of a git resource.
+func webhookRepositoryURLs(provider string, payload []byte) ([]string, error) {
+	var repo repositoryPayload
+	err := json.Unmarshal(payload, &repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var urls []string
+	switch provider {
+	case atc.WebhookProviderGitHub:
+		urls = []string{repo.Repository.CloneURL, repo.Repository.SSHURL, repo.Repository.GitURL, repo.Repository.HTMLURL}
+
+	case atc.WebhookProviderGitLab:
+		urls = []string{repo.Project.GitHTTPURL, repo.Project.GitSSHURL, repo.Project.WebURL}
+
+	case atc.WebhookProviderBitbucket:
+		urls = []string{repo.Repository.Links.HTML.Href}
+
+		if repo.Repository.FullName != "" {
+			urls = append(urls,
+				"https://bitbucket.org/"+repo.Repository.FullName,
+				"git@bitbucket.org:"+repo.Repository.FullName,
+			)
+		}
+
+		for _, clone := range repo.Repository.Links.Clone {
+			urls = append(urls, clone.Href)
+		}
+
+	default:
+		urls = []string{repo.URI}
+	}
+
+	nonEmpty := []string{}
+	for _, url := range urls {
+		if url != "" {
+			nonEmpty = append(nonEmpty, normalizeRepositoryURL(url))
+		}
+	}
+
+	return nonEmpty, nil
+}
+
+// normalizeRepositoryURL strips what may or may not be at the end of the URL
+// of the same repository.
+func normalizeRepositoryURL(url string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+}
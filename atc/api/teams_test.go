@@ -211,6 +211,64 @@ var _ = Describe("Teams API", func() {
 					Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
 				})
 
+				It("re-enables secret redaction", func() {
+					Expect(fakeTeam.DisableSecretRedactionCallCount()).To(Equal(1))
+					Expect(fakeTeam.DisableSecretRedactionArgsForCall(0)).To(BeFalse())
+				})
+
+				Context("when the team disables secret redaction", func() {
+					BeforeEach(func() {
+						atcTeam.DisableSecretRedaction = true
+					})
+
+					It("disables secret redaction", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.DisableSecretRedactionCallCount()).To(Equal(1))
+						Expect(fakeTeam.DisableSecretRedactionArgsForCall(0)).To(BeTrue())
+					})
+				})
+
+				Context("when updating secret redaction fails", func() {
+					BeforeEach(func() {
+						fakeTeam.DisableSecretRedactionReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when the auth refers to a custom role", func() {
+					BeforeEach(func() {
+						atcTeam.Auth["pipeline-operator"] = map[string][]string{
+							"groups": []string{"github:some-org"},
+						}
+					})
+
+					It("updates provider auth", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+						Expect(fakeTeam.UpdateProviderAuthArgsForCall(0)).To(Equal(atcTeam.Auth))
+					})
+				})
+
+				Context("when the auth refers to an unknown role", func() {
+					BeforeEach(func() {
+						atcTeam.Auth["janitor"] = map[string][]string{
+							"users": []string{"local:username"},
+						}
+					})
+
+					It("returns 400 Bad Request", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("unknown role 'janitor'")))
+					})
+
+					It("does not update provider auth", func() {
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when updating provider auth fails", func() {
 					BeforeEach(func() {
 						fakeTeam.UpdateProviderAuthReturns(errors.New("stop trying to make fetch happen"))
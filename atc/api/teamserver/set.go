@@ -34,6 +34,14 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	err = s.customRoles.ValidateTeamAuth(atcTeam.Auth)
+	if err != nil {
+		hLog.Info("invalid-team-auth", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+
 	team, found, err := s.teamFactory.FindTeam(teamName)
 	if err != nil {
 		hLog.Error("failed-to-lookup-team", err, lager.Data{"teamName": teamName})
@@ -50,6 +58,13 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		err = team.DisableSecretRedaction(atcTeam.DisableSecretRedaction)
+		if err != nil {
+			hLog.Error("failed-to-update-team-secret-redaction", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -23,6 +23,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
@@ -31,8 +32,10 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/eventstore"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/gc"
+	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/lockrunner"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/pipelines"
@@ -40,6 +43,7 @@ import (
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -105,8 +109,12 @@ type RunCommand struct {
 	GlobalResourceCheckTimeout   time.Duration `long:"global-resource-check-timeout" default:"1h" description:"Time limit on checking for new versions of resources."`
 	ResourceCheckingInterval     time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceTypeCheckingInterval time.Duration `long:"resource-type-checking-interval" default:"1m" description:"Interval on which to check for new versions of resource types."`
+	MaxConcurrentChecks          int           `long:"max-concurrent-checks" default:"32" description:"Maximum number of checks this ATC runs at a time."`
+	CheckHistoryRetention        int           `long:"check-history-retention" default:"100" description:"Number of finished checks to keep for each resource and resource type."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"least-build-containers" description:"Method by which a worker is selected during container placement."`
+	CheckRateLimits map[string]int `long:"check-rate-limit" value-name:"[TEAM/]TYPE:CHECKS_PER_MINUTE" description:"Maximum number of checks of resources and resource types of the type run per minute across all ATCs. Checks over the limit are deferred. A limit prefixed with a team's name applies to the team's checks instead. Can be specified multiple times."`
+
+	ContainerPlacementStrategy        []string      `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"least-build-containers" choice:"fewest-active-tasks" env-delim:"," description:"Method by which a worker is selected during container placement. Can be specified multiple times to chain strategies, each narrowing down the workers preferred by the previous one."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
@@ -139,27 +147,50 @@ type RunCommand struct {
 		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	GlobalWebhook struct {
+		Provider    string `long:"provider" default:"github" choice:"github" choice:"gitlab" choice:"bitbucket" choice:"hmac" description:"Provider sending pushes to the global webhook, which determines how they are verified."`
+		Secret      string `long:"secret" description:"Secret with which pushes to the global webhook are signed. The global webhook is disabled if omitted."`
+		Header      string `long:"header" description:"Header carrying the signature of pushes from the hmac provider. Defaults to X-Signature."`
+		SourceField string `long:"source-field" default:"uri" description:"Field of resource sources compared against the URL of the pushed repository."`
+	} `group:"Global Webhook" namespace:"global-webhook"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	DefaultBuildTimeout time.Duration `long:"default-build-timeout" default:"0m" description:"Time limit on builds of jobs without a build_timeout, after which they're aborted. 0 means no limit."`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
 	MaxBuildLogsToRetain     uint64 `long:"max-build-logs-to-retain" description:"Maximum build logs to retain, 0 means not specified. Will override values configured in jobs"`
 
+	MaxBuildLogSearchEvents int `long:"max-build-log-search-events" default:"100000" description:"Maximum number of build events to read when searching build logs. Searches reading more are truncated and can be resumed from the next page."`
+
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
 	DefaultMemoryLimit *string `long:"default-task-memory-limit" description:"Default maximum memory per task, 0 means unlimited"`
 
+	MaxActiveTasksPerWorker int `long:"max-active-tasks-per-worker" default:"0" description:"Maximum number of tasks running on a worker at once. Tasks wait for a worker with capacity when every worker is at the limit. 0 means unlimited."`
+
 	Syslog struct {
 		Hostname      string        `long:"syslog-hostname" description:"Client hostname with which the build logs will be sent to the syslog server." default:"atc-syslog-drainer"`
 		Address       string        `long:"syslog-address" description:"Remote syslog server address with port (Example: 0.0.0.0:514)."`
 		Transport     string        `long:"syslog-transport" description:"Transport protocol for syslog messages (Currently supporting tcp, udp & tls)."`
 		DrainInterval time.Duration `long:"syslog-drain-interval" description:"Interval over which checking is done for new build logs to send to syslog server (duration measurement units are s/m/h; eg. 30s/30m/1h)" default:"30s"`
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
+		Format        string        `long:"syslog-format" choice:"legacy" choice:"rfc5424" default:"legacy" description:"Format of the messages sent to the syslog server. The rfc5424 format includes the build metadata as structured data."`
+		AppName       string        `long:"syslog-app-name" default:"concourse" description:"APP-NAME of messages in the rfc5424 format."`
+		MsgID         string        `long:"syslog-msg-id" default:"build-log" description:"MSGID of messages in the rfc5424 format."`
+		SDID          string        `long:"syslog-sd-id" default:"concourse@32473" description:"ID of the structured data element holding the build metadata in the rfc5424 format, of the form name@private-enterprise-number."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	Tracing tracing.Config `group:"Tracing"`
+
+	BuildEventStore eventstore.Config `group:"Build Event Store"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
+
+		CustomRolesConfig flag.File `long:"custom-roles-config" description:"YAML file mapping custom role names to the API actions they are permitted to perform."`
 	} `group:"Authentication"`
 }
 
@@ -338,6 +369,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	}
 
 	radar.GlobalResourceCheckTimeout = cmd.GlobalResourceCheckTimeout
+	db.WebhookSourceField = cmd.GlobalWebhook.SourceField
 	//FIXME: These only need to run once for the entire binary. At the moment,
 	//they rely on state of the command.
 	db.SetupConnectionRetryingDriver("postgres", cmd.Postgres.ConnectionString(), retryingDriverName)
@@ -353,6 +385,10 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	if err := cmd.Tracing.Prepare(logger.Session("tracing")); err != nil {
+		return nil, err
+	}
+
 	lockConn, err := cmd.constructLockConn(retryingDriverName)
 	if err != nil {
 		return nil, err
@@ -360,12 +396,17 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	lockFactory := lock.NewLockFactory(lockConn, metric.LogLockAcquired, metric.LogLockReleased)
 
-	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "api", lockFactory)
+	eventStore, err := cmd.BuildEventStore.NewStore()
+	if err != nil {
+		return nil, err
+	}
+
+	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "api", lockFactory, eventStore)
 	if err != nil {
 		return nil, err
 	}
 
-	backendConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "backend", lockFactory)
+	backendConn, err := cmd.constructDBConn(retryingDriverName, logger, 32, "backend", lockFactory, eventStore)
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +416,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory)
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, storage, lockFactory, eventStore)
 	if err != nil {
 		return nil, err
 	}
@@ -417,6 +458,7 @@ func (cmd *RunCommand) constructMembers(
 	backendConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
+	eventStore db.EventStore,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -433,7 +475,7 @@ func (cmd *RunCommand) constructMembers(
 		return nil, err
 	}
 
-	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory)
+	backendMembers, err := cmd.constructBackendMembers(logger, backendConn, lockFactory, eventStore)
 	if err != nil {
 		return nil, err
 	}
@@ -479,10 +521,16 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
 	resourceFetcherFactory := resource.NewFetcherFactory(lockFactory, clock.NewClock(), dbResourceCacheFactory)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(dbConn, lockFactory)
+	dbCheckRateLimiter := db.NewCheckRateLimiter(
+		db.NewCheckFactory(dbConn, lockFactory),
+		dbConn,
+		db.CheckRateLimits(cmd.CheckRateLimits),
+	)
 	imageResourceFetcherFactory := image.NewImageResourceFetcherFactory(
 		resourceFetcherFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		dbCheckRateLimiter,
 		clock.NewClock(),
 	)
 
@@ -509,10 +557,13 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.BaggageclaimResponseHeaderTimeout,
 	)
 
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -526,9 +577,10 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, teamFactory, variablesFactory, defaultLimits)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
+		dbCheckRateLimiter,
 		resourceFactory,
 		dbResourceConfigFactory,
 		cmd.ResourceTypeCheckingInterval,
@@ -537,6 +589,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	)
 
 	radarScannerFactory := radar.NewScannerFactory(
+		dbCheckRateLimiter,
 		resourceFactory,
 		dbResourceConfigFactory,
 		cmd.ResourceTypeCheckingInterval,
@@ -553,7 +606,14 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod)
-	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey())
+	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
+
+	customRoles, err := cmd.customRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	accessFactory := accessor.NewAccessFactory(authHandler.PublicKey(), customRoles)
 
 	apiHandler, err := cmd.constructAPIHandler(
 		logger,
@@ -568,6 +628,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		dbResourceConfigFactory,
+		dbCheckFactory,
 		engine,
 		workerClient,
 		workerProvider,
@@ -577,6 +638,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		variablesFactory,
 		credsManagers,
 		accessFactory,
+		customRoles,
 	)
 
 	if err != nil {
@@ -660,6 +722,7 @@ func (cmd *RunCommand) constructBackendMembers(
 	logger lager.Logger,
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
+	eventStore db.EventStore,
 ) ([]grouper.Member, error) {
 
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
@@ -678,10 +741,16 @@ func (cmd *RunCommand) constructBackendMembers(
 	dbResourceCacheFactory := db.NewResourceCacheFactory(dbConn, lockFactory)
 	resourceFetcherFactory := resource.NewFetcherFactory(lockFactory, clock.NewClock(), dbResourceCacheFactory)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(dbConn, lockFactory)
+	dbCheckRateLimiter := db.NewCheckRateLimiter(
+		db.NewCheckFactory(dbConn, lockFactory),
+		dbConn,
+		db.CheckRateLimits(cmd.CheckRateLimits),
+	)
 	imageResourceFetcherFactory := image.NewImageResourceFetcherFactory(
 		resourceFetcherFactory,
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
+		dbCheckRateLimiter,
 		clock.NewClock(),
 	)
 	dbWorkerBaseResourceTypeFactory := db.NewWorkerBaseResourceTypeFactory(dbConn)
@@ -706,10 +775,13 @@ func (cmd *RunCommand) constructBackendMembers(
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
 	)
-	workerClient := cmd.constructWorkerPool(
+	workerClient, err := cmd.constructWorkerPool(
 		logger,
 		workerProvider,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	resourceFetcher := resourceFetcherFactory.FetcherFor(workerClient)
 	resourceFactory := resource.NewResourceFactory(workerClient)
@@ -722,9 +794,10 @@ func (cmd *RunCommand) constructBackendMembers(
 	if err != nil {
 		return nil, err
 	}
-	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, variablesFactory, defaultLimits)
+	engine := cmd.constructEngine(workerClient, resourceFetcher, resourceFactory, dbResourceCacheFactory, dbResourceConfigFactory, teamFactory, variablesFactory, defaultLimits)
 
 	radarSchedulerFactory := pipelines.NewRadarSchedulerFactory(
+		dbCheckRateLimiter,
 		resourceFactory,
 		dbResourceConfigFactory,
 		cmd.ResourceTypeCheckingInterval,
@@ -820,6 +893,60 @@ func (cmd *RunCommand) constructBackendMembers(
 		)},
 	}
 
+	if !cmd.Developer.Noop {
+		dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory)
+
+		members = append(members,
+			grouper.Member{
+				Name: "check-scheduler", Runner: lockrunner.NewRunner(
+					logger.Session("check-scheduler"),
+					lidar.NewScheduler(
+						dbCheckFactory,
+						cmd.ResourceCheckingInterval,
+						cmd.ResourceTypeCheckingInterval,
+						cmd.GlobalResourceCheckTimeout,
+						cmd.CheckHistoryRetention,
+					),
+					"check-scheduler",
+					lockFactory,
+					clock.NewClock(),
+					10*time.Second,
+				),
+			},
+			grouper.Member{
+				Name: "checker", Runner: lidar.Checker{
+					Logger:       logger.Session("checker"),
+					CheckFactory: dbCheckFactory,
+					ScannerFactory: radar.NewScannerFactory(
+						dbCheckRateLimiter,
+						resourceFactory,
+						dbResourceConfigFactory,
+						cmd.ResourceTypeCheckingInterval,
+						cmd.ResourceCheckingInterval,
+						cmd.ExternalURL.String(),
+						variablesFactory,
+					),
+					MaxConcurrent: cmd.MaxConcurrentChecks,
+					Interval:      time.Second,
+					Clock:         clock.NewClock(),
+				},
+			},
+		)
+	}
+
+	if eventStore != nil {
+		members = append(members, grouper.Member{
+			Name: "build-event-archiver", Runner: lockrunner.NewRunner(
+				logger.Session("build-event-archiver"),
+				gc.NewBuildEventArchiver(dbBuildFactory, 100),
+				"build-event-archiver",
+				lockFactory,
+				clock.NewClock(),
+				30*time.Second,
+			),
+		})
+	}
+
 	//Syslog Drainer Configuration
 	if syslogDrainConfigured {
 		members = append(members, grouper.Member{
@@ -831,6 +958,12 @@ func (cmd *RunCommand) constructBackendMembers(
 					cmd.Syslog.Address,
 					cmd.Syslog.Hostname,
 					cmd.Syslog.CACerts,
+					syslog.Format{
+						RFC5424: cmd.Syslog.Format == "rfc5424",
+						AppName: cmd.Syslog.AppName,
+						MsgID:   cmd.Syslog.MsgID,
+						SDID:    cmd.Syslog.SDID,
+					},
 					dbBuildFactory,
 				),
 				"syslog-drainer",
@@ -1096,6 +1229,7 @@ func (cmd *RunCommand) constructDBConn(
 	maxConn int,
 	connectionName string,
 	lockFactory lock.LockFactory,
+	eventStore db.EventStore,
 ) (db.Conn, error) {
 	dbConn, err := db.Open(logger.Session("db"), driverName, cmd.Postgres.ConnectionString(), cmd.newKey(), cmd.oldKey(), connectionName, lockFactory)
 	if err != nil {
@@ -1111,6 +1245,11 @@ func (cmd *RunCommand) constructDBConn(
 		dbConn = db.Log(logger.Session("log-conn"), dbConn)
 	}
 
+	// Keep the events of completed builds outside of Postgres
+	if eventStore != nil {
+		dbConn = db.WithEventStore(dbConn, eventStore)
+	}
+
 	// Prepare
 	dbConn.SetMaxOpenConns(maxConn)
 
@@ -1137,22 +1276,16 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 func (cmd *RunCommand) constructWorkerPool(
 	logger lager.Logger,
 	workerProvider worker.WorkerProvider,
-) worker.Client {
-
-	var strategy worker.ContainerPlacementStrategy
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "least-build-containers":
-		strategy = worker.NewLeastBuildContainersPlacementStrategy()
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+) (worker.Client, error) {
+	strategy, err := worker.NewContainerPlacementStrategy(cmd.ContainerPlacementStrategy)
+	if err != nil {
+		return nil, err
 	}
 
 	return worker.NewPool(
 		workerProvider,
 		strategy,
-	)
+	), nil
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1178,12 +1311,22 @@ func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) e
 	return nil
 }
 
+func (cmd *RunCommand) customRoles() (accessor.CustomRoles, error) {
+	path := cmd.Auth.CustomRolesConfig.Path()
+	if path == "" {
+		return accessor.CustomRoles{}, nil
+	}
+
+	return accessor.LoadCustomRoles(path)
+}
+
 func (cmd *RunCommand) constructEngine(
 	workerClient worker.Client,
 	resourceFetcher resource.Fetcher,
 	resourceFactory resource.ResourceFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	teamFactory db.TeamFactory,
 	variablesFactory creds.VariablesFactory,
 	defaultLimits atc.ContainerLimits,
 ) engine.Engine {
@@ -1193,19 +1336,21 @@ func (cmd *RunCommand) constructEngine(
 		resourceFactory,
 		resourceCacheFactory,
 		resourceConfigFactory,
+		teamFactory,
 		variablesFactory,
 		defaultLimits,
+		cmd.MaxActiveTasksPerWorker,
 	)
 
 	execV2Engine := engine.NewExecEngine(
 		gardenFactory,
-		engine.NewBuildDelegateFactory(),
+		engine.NewBuildDelegateFactory(teamFactory),
 		cmd.ExternalURL.String(),
 	)
 
 	execV1Engine := engine.NewExecV1DummyEngine()
 
-	return engine.NewDBEngine(engine.Engines{execV2Engine, execV1Engine}, cmd.PeerURLOrDefault().String())
+	return engine.NewDBEngine(engine.Engines{execV2Engine, execV1Engine}, cmd.PeerURLOrDefault().String(), cmd.DefaultBuildTimeout)
 }
 
 func (cmd *RunCommand) constructHTTPHandler(
@@ -1252,6 +1397,7 @@ func (cmd *RunCommand) constructAPIHandler(
 	gcContainerDestroyer gc.Destroyer,
 	dbBuildFactory db.BuildFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	dbCheckFactory db.CheckFactory,
 	engine engine.Engine,
 	workerClient worker.Client,
 	workerProvider worker.WorkerProvider,
@@ -1261,6 +1407,7 @@ func (cmd *RunCommand) constructAPIHandler(
 	variablesFactory creds.VariablesFactory,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
+	customRoles accessor.CustomRoles,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1295,6 +1442,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		gcContainerDestroyer,
 		dbBuildFactory,
 		resourceConfigFactory,
+		dbCheckFactory,
 
 		cmd.PeerURLOrDefault().String(),
 		buildserver.NewEventHandler,
@@ -1305,6 +1453,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		workerProvider,
 		radarSchedulerFactory,
 		radarScannerFactory,
+		resourceserver.GlobalWebhook{
+			Provider:    cmd.GlobalWebhook.Provider,
+			Secret:      cmd.GlobalWebhook.Secret,
+			Header:      cmd.GlobalWebhook.Header,
+			SourceField: cmd.GlobalWebhook.SourceField,
+		},
 
 		reconfigurableSink,
 
@@ -1316,6 +1470,8 @@ func (cmd *RunCommand) constructAPIHandler(
 		variablesFactory,
 		credsManagers,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
+		customRoles,
+		cmd.MaxBuildLogSearchEvents,
 	)
 }
 
@@ -1352,19 +1508,6 @@ func (cmd *RunCommand) constructPipelineSyncer(
 		func(pipeline db.Pipeline) ifrit.Runner {
 			variables := variablesFactory.NewVariables(pipeline.TeamName(), pipeline.Name())
 			return grouper.NewParallel(os.Interrupt, grouper.Members{
-				{
-					Name: fmt.Sprintf("radar:%d", pipeline.ID()),
-					Runner: radar.NewRunner(
-						logger.Session("radar").WithData(lager.Data{
-							"team":     pipeline.TeamName(),
-							"pipeline": pipeline.Name(),
-						}),
-						cmd.Developer.Noop,
-						radarSchedulerFactory.BuildScanRunnerFactory(pipeline, cmd.ExternalURL.String(), variables),
-						pipeline,
-						1*time.Minute,
-					),
-				},
 				{
 					Name: fmt.Sprintf("scheduler:%d", pipeline.ID()),
 					Runner: &scheduler.Runner{
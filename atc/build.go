@@ -11,17 +11,40 @@ const (
 	StatusAborted   BuildStatus = "aborted"
 )
 
+// BuildTimedOut is the reason given for aborting a build that ran for longer
+// than its job's build_timeout, or the default build timeout.
+const BuildTimedOut = "timed out"
+
 type Build struct {
-	ID           int    `json:"id"`
-	TeamName     string `json:"team_name"`
-	Name         string `json:"name"`
-	Status       string `json:"status"`
-	JobName      string `json:"job_name,omitempty"`
-	APIURL       string `json:"api_url"`
-	PipelineName string `json:"pipeline_name,omitempty"`
-	StartTime    int64  `json:"start_time,omitempty"`
-	EndTime      int64  `json:"end_time,omitempty"`
-	ReapTime     int64  `json:"reap_time,omitempty"`
+	ID                   int          `json:"id"`
+	TeamName             string       `json:"team_name"`
+	Name                 string       `json:"name"`
+	Status               string       `json:"status"`
+	JobName              string       `json:"job_name,omitempty"`
+	APIURL               string       `json:"api_url"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	StartTime            int64        `json:"start_time,omitempty"`
+	EndTime              int64        `json:"end_time,omitempty"`
+	ReapTime             int64        `json:"reap_time,omitempty"`
+
+	RerunOf *RerunOfBuild `json:"rerun_of,omitempty"`
+
+	TestSummary *TestSummary `json:"test_summary,omitempty"`
+
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Comments    []BuildComment    `json:"comments,omitempty"`
+}
+
+type RerunOfBuild struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type BuildComment struct {
+	Author  string `json:"author"`
+	Comment string `json:"comment"`
+	Time    int64  `json:"time"`
 }
 
 func (b Build) IsRunning() bool {
@@ -41,6 +64,13 @@ func (b Build) OneOff() bool {
 	return b.JobName == ""
 }
 
+func (b Build) PipelineRef() PipelineRef {
+	return PipelineRef{
+		Name:         b.PipelineName,
+		InstanceVars: b.PipelineInstanceVars,
+	}
+}
+
 type BuildPreparationStatus string
 
 const (
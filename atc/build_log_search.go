@@ -0,0 +1,26 @@
+package atc
+
+const (
+	BuildLogSearchQuery = "query"
+	BuildLogSearchRegex = "regex"
+)
+
+// BuildLogSearchResult holds the log lines of a build matching a search.
+type BuildLogSearchResult struct {
+	Build   Build           `json:"build"`
+	Matches []BuildLogMatch `json:"matches"`
+
+	// Truncated is set when the search stopped part way through the build's
+	// events because it reached the limit on how many events are scanned.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+type BuildLogMatch struct {
+	Line   string         `json:"line"`
+	Origin BuildLogOrigin `json:"origin"`
+}
+
+type BuildLogOrigin struct {
+	ID     string `json:"id,omitempty"`
+	Source string `json:"source,omitempty"`
+}
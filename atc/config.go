@@ -51,14 +51,40 @@ func (groups GroupConfigs) Lookup(name string) (GroupConfig, int, bool) {
 }
 
 type ResourceConfig struct {
-	Name         string  `yaml:"name" json:"name" mapstructure:"name"`
-	WebhookToken string  `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
-	Type         string  `yaml:"type" json:"type" mapstructure:"type"`
-	Source       Source  `yaml:"source" json:"source" mapstructure:"source"`
-	CheckEvery   string  `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
-	CheckTimeout string  `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
-	Tags         Tags    `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
-	Version      Version `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+	Name         string         `yaml:"name" json:"name" mapstructure:"name"`
+	WebhookToken string         `yaml:"webhook_token,omitempty" json:"webhook_token" mapstructure:"webhook_token"`
+	Webhook      *WebhookConfig `yaml:"webhook,omitempty" json:"webhook,omitempty" mapstructure:"webhook"`
+	Type         string         `yaml:"type" json:"type" mapstructure:"type"`
+	Source       Source         `yaml:"source" json:"source" mapstructure:"source"`
+	CheckEvery   string         `yaml:"check_every,omitempty" json:"check_every" mapstructure:"check_every"`
+	CheckTimeout string         `yaml:"check_timeout,omitempty" json:"check_timeout" mapstructure:"check_timeout"`
+	Tags         Tags           `yaml:"tags,omitempty" json:"tags" mapstructure:"tags"`
+	Version      Version        `yaml:"version,omitempty" json:"version" mapstructure:"version"`
+}
+
+const (
+	WebhookProviderGitHub    = "github"
+	WebhookProviderGitLab    = "gitlab"
+	WebhookProviderBitbucket = "bitbucket"
+	WebhookProviderHMAC      = "hmac"
+)
+
+// DefaultWebhookSignatureHeader is the header carrying the signature of an
+// hmac webhook's payload, unless configured otherwise.
+const DefaultWebhookSignatureHeader = "X-Signature"
+
+// WebhookConfig configures how the requests to a resource's webhook are
+// verified, and which of them trigger a check.
+//
+// The secret is either the key the payload is signed with using HMAC-SHA256,
+// or, for GitLab, the token sent along with it. Branches optionally limits
+// the pushes which trigger a check to those of branches matching any of the
+// given patterns.
+type WebhookConfig struct {
+	Provider string   `yaml:"provider" json:"provider" mapstructure:"provider"`
+	Secret   string   `yaml:"secret" json:"secret" mapstructure:"secret"`
+	Header   string   `yaml:"header,omitempty" json:"header,omitempty" mapstructure:"header"`
+	Branches []string `yaml:"branches,omitempty" json:"branches,omitempty" mapstructure:"branches"`
 }
 
 type ResourceType struct {
@@ -338,13 +364,21 @@ type PlanConfig struct {
 	Task string `yaml:"task,omitempty" json:"task,omitempty" mapstructure:"task"`
 	// run task privileged
 	Privileged bool `yaml:"privileged,omitempty" json:"privileged,omitempty" mapstructure:"privileged"`
-	// task config path, e.g. foo/build.yml
+	// task config path, e.g. foo/build.yml; also the pipeline config path for a SetPipeline plan
 	TaskConfigPath string `yaml:"file,omitempty" json:"file,omitempty" mapstructure:"file"`
-	// task variables, if task is specified as external file via TaskConfigPath
+	// task variables, if task is specified as external file via TaskConfigPath; also used by SetPipeline
 	TaskVars Params `yaml:"vars,omitempty" json:"vars,omitempty" mapstructure:"vars"`
 	// inlined task config
 	TaskConfig *TaskConfig `yaml:"config,omitempty" json:"config,omitempty" mapstructure:"config"`
 
+	// corresponds to a SetPipeline plan
+	// name of the pipeline to configure, e.g. ci
+	SetPipeline string `yaml:"set_pipeline,omitempty" json:"set_pipeline,omitempty" mapstructure:"set_pipeline"`
+	// files containing variables to interpolate into the pipeline config, e.g. ci/vars.yml
+	VarFiles []string `yaml:"var_files,omitempty" json:"var_files,omitempty" mapstructure:"var_files"`
+	// vars identifying the instance of the pipeline to configure; also interpolated into its config
+	InstanceVars InstanceVars `yaml:"instance_vars,omitempty" json:"instance_vars,omitempty" mapstructure:"instance_vars"`
+
 	// used by Get and Put for specifying params to the resource
 	Params Params `yaml:"params,omitempty" json:"params,omitempty" mapstructure:"params"`
 
@@ -355,6 +389,9 @@ type PlanConfig struct {
 	// used to specify an image artifact from a previous build to be used as the image for a subsequent task container
 	ImageArtifactName string `yaml:"image,omitempty" json:"image,omitempty" mapstructure:"image"`
 
+	// used by Task to point at JUnit or TAP reports, relative to the task's outputs, e.g. results/junit.xml
+	TestReports []string `yaml:"test_reports,omitempty" json:"test_reports,omitempty" mapstructure:"test_reports"`
+
 	// used by Put to specify params for the subsequent Get
 	GetParams Params `yaml:"get_params,omitempty" json:"get_params,omitempty" mapstructure:"get_params"`
 
@@ -385,6 +422,9 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `yaml:"attempts,omitempty" json:"attempts,omitempty" mapstructure:"attempts"`
 
+	// run the step once for each of the given values
+	Across *AcrossConfig `yaml:"across,omitempty" json:"across,omitempty" mapstructure:"across"`
+
 	Version *VersionConfig `yaml:"version,omitempty" json:"version,omitempty" mapstructure:"version"`
 }
 
@@ -405,6 +445,10 @@ func (config PlanConfig) Name() string {
 		return config.Task
 	}
 
+	if config.SetPipeline != "" {
+		return config.SetPipeline
+	}
+
 	return ""
 }
 
@@ -0,0 +1,56 @@
+package creds
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/mitchellh/mapstructure"
+)
+
+type List struct {
+	variablesResolver Variables
+	rawList           interface{}
+}
+
+// NewList returns a list given either as a list, or as a ((var)) whose value
+// is a list.
+func NewList(variables Variables, list interface{}) List {
+	return List{
+		variablesResolver: variables,
+		rawList:           list,
+	}
+}
+
+func (l List) Evaluate() ([]interface{}, error) {
+	var untypedInput interface{}
+
+	err := evaluate(l.variablesResolver, l.rawList, &untypedInput)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := untypedInput.([]interface{}); !ok {
+		return nil, fmt.Errorf("expected a list, got %T", untypedInput)
+	}
+
+	var metadata mapstructure.Metadata
+	var list []interface{}
+
+	msConfig := &mapstructure.DecoderConfig{
+		Metadata:         &metadata,
+		Result:           &list,
+		WeaklyTypedInput: true,
+		DecodeHook:       atc.SanitizeDecodeHook,
+	}
+
+	decoder, err := mapstructure.NewDecoder(msConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := decoder.Decode(untypedInput); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,50 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("List", func() {
+	var variables template.StaticVariables
+
+	BeforeEach(func() {
+		variables = template.StaticVariables{
+			"some-list": []interface{}{
+				"a",
+				map[interface{}]interface{}{"b": "c"},
+			},
+			"some-string": "nope",
+		}
+	})
+
+	Describe("Evaluate", func() {
+		It("resolves a list given by a var", func() {
+			list, err := creds.NewList(variables, "((some-list))").Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(Equal([]interface{}{
+				"a",
+				map[string]interface{}{"b": "c"},
+			}))
+		})
+
+		It("resolves the vars within a static list", func() {
+			list, err := creds.NewList(variables, []interface{}{"((some-string))", "d"}).Evaluate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list).To(Equal([]interface{}{"nope", "d"}))
+		})
+
+		It("fails if the var is not a list", func() {
+			_, err := creds.NewList(variables, "((some-string))").Evaluate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails if the var is missing", func() {
+			_, err := creds.NewList(variables, "((missing))").Evaluate()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package creds
+
+import "github.com/cloudfoundry/bosh-cli/director/template"
+
+type localVariables struct {
+	variables Variables
+	locals    map[string]interface{}
+}
+
+// NewLocalVariables resolves the local vars to their values, and any other
+// vars through the given variables.
+func NewLocalVariables(variables Variables, locals map[string]interface{}) Variables {
+	if len(locals) == 0 {
+		return variables
+	}
+
+	return localVariables{
+		variables: variables,
+		locals:    locals,
+	}
+}
+
+func (local localVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	if value, found := local.locals[varDef.Name]; found {
+		return value, true, nil
+	}
+
+	return local.variables.Get(varDef)
+}
+
+func (local localVariables) List() ([]template.VariableDefinition, error) {
+	defs, err := local.variables.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for name := range local.locals {
+		defs = append(defs, template.VariableDefinition{Name: name})
+	}
+
+	return defs, nil
+}
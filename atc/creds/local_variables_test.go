@@ -0,0 +1,33 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LocalVariables", func() {
+	var variables creds.Variables
+
+	BeforeEach(func() {
+		variables = creds.NewLocalVariables(
+			template.StaticVariables{"some-var": "global", "other-var": "other"},
+			map[string]interface{}{"some-var": "local"},
+		)
+	})
+
+	It("resolves local vars before any others", func() {
+		source, err := creds.NewSource(variables, atc.Source{
+			"some":  "((some-var))",
+			"other": "((other-var))",
+		}).Evaluate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(source).To(Equal(atc.Source{
+			"some":  "local",
+			"other": "other",
+		}))
+	})
+})
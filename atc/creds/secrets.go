@@ -0,0 +1,177 @@
+package creds
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+)
+
+// RedactedSecret replaces the secrets found in a build's logs.
+const RedactedSecret = "((redacted))"
+
+// minSecretLength is the length below which fetched values aren't redacted.
+// Replacing every "1" or "yes" in a build's logs would mangle them without
+// protecting anything worth protecting.
+const minSecretLength = 4
+
+// Secrets collects the values a build fetched from the credential manager, so
+// that they can be redacted from its logs. It is safe for concurrent use, as
+// a build's steps run in parallel.
+type Secrets struct {
+	lock     sync.RWMutex
+	values   map[string]bool
+	sorted   []string
+	replacer *strings.Replacer
+}
+
+func NewSecrets() *Secrets {
+	return &Secrets{
+		values: map[string]bool{},
+	}
+}
+
+// Track records the strings within the given value, which may be a string or
+// a map or list of values as returned by Variables.Get. The lines of
+// multi-line secrets such as private keys are tracked individually too, as
+// they're often printed a line at a time.
+func (secrets *Secrets) Track(value interface{}) {
+	if secrets == nil {
+		return
+	}
+
+	found := []string{}
+	collectSecrets(value, &found)
+
+	secrets.lock.Lock()
+	defer secrets.lock.Unlock()
+
+	added := false
+	for _, secret := range found {
+		if len(secret) < minSecretLength || secrets.values[secret] {
+			continue
+		}
+
+		secrets.values[secret] = true
+		secrets.sorted = append(secrets.sorted, secret)
+		added = true
+	}
+
+	if !added {
+		return
+	}
+
+	// longest first, so that a secret containing another is redacted whole
+	sort.SliceStable(secrets.sorted, func(i, j int) bool {
+		return len(secrets.sorted[i]) > len(secrets.sorted[j])
+	})
+
+	pairs := make([]string, 0, len(secrets.sorted)*2)
+	for _, secret := range secrets.sorted {
+		pairs = append(pairs, secret, RedactedSecret)
+	}
+
+	secrets.replacer = strings.NewReplacer(pairs...)
+}
+
+func collectSecrets(value interface{}, found *[]string) {
+	switch v := value.(type) {
+	case string:
+		*found = append(*found, v)
+
+		if strings.Contains(v, "\n") {
+			for _, line := range strings.Split(v, "\n") {
+				*found = append(*found, strings.TrimSpace(line))
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			collectSecrets(elem, found)
+		}
+	case map[interface{}]interface{}:
+		for _, elem := range v {
+			collectSecrets(elem, found)
+		}
+	case map[string]interface{}:
+		for _, elem := range v {
+			collectSecrets(elem, found)
+		}
+	}
+}
+
+// Redact replaces every tracked secret in the text.
+func (secrets *Secrets) Redact(text string) string {
+	if secrets == nil {
+		return text
+	}
+
+	secrets.lock.RLock()
+	defer secrets.lock.RUnlock()
+
+	if secrets.replacer == nil {
+		return text
+	}
+
+	return secrets.replacer.Replace(text)
+}
+
+// PartialSuffix returns the length of the longest end of the text that is
+// the start of a tracked secret. Writers hold that much back until more text
+// arrives, so that a secret split across writes is still redacted.
+func (secrets *Secrets) PartialSuffix(text string) int {
+	if secrets == nil {
+		return 0
+	}
+
+	secrets.lock.RLock()
+	defer secrets.lock.RUnlock()
+
+	longest := 0
+	for _, secret := range secrets.sorted {
+		max := len(secret) - 1
+		if max > len(text) {
+			max = len(text)
+		}
+
+		for length := max; length > longest; length-- {
+			if strings.HasSuffix(text, secret[:length]) {
+				longest = length
+				break
+			}
+		}
+	}
+
+	return longest
+}
+
+type trackedVariables struct {
+	variables Variables
+	secrets   *Secrets
+}
+
+// NewTrackedVariables tracks every value fetched through the variables in
+// the given secrets.
+func NewTrackedVariables(variables Variables, secrets *Secrets) Variables {
+	if secrets == nil {
+		return variables
+	}
+
+	return trackedVariables{
+		variables: variables,
+		secrets:   secrets,
+	}
+}
+
+func (tracked trackedVariables) Get(varDef template.VariableDefinition) (interface{}, bool, error) {
+	value, found, err := tracked.variables.Get(varDef)
+	if err == nil && found {
+		tracked.secrets.Track(value)
+	}
+
+	return value, found, err
+}
+
+func (tracked trackedVariables) List() ([]template.VariableDefinition, error) {
+	return tracked.variables.List()
+}
@@ -0,0 +1,98 @@
+package creds_test
+
+import (
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets", func() {
+	var secrets *creds.Secrets
+
+	BeforeEach(func() {
+		secrets = creds.NewSecrets()
+	})
+
+	Describe("Redact", func() {
+		It("leaves the text alone when nothing is tracked", func() {
+			Expect(secrets.Redact("nothing to see here")).To(Equal("nothing to see here"))
+		})
+
+		Context("with tracked secrets", func() {
+			BeforeEach(func() {
+				secrets.Track("hunter2")
+				secrets.Track(map[interface{}]interface{}{
+					"username": "admin-user",
+					"password": "hunter2-and-more",
+				})
+				secrets.Track([]interface{}{"-----BEGIN KEY-----\nc2VjcmV0LWtleQ==\n-----END KEY-----"})
+			})
+
+			It("replaces every occurrence", func() {
+				Expect(secrets.Redact("logging in as admin-user with hunter2 (hunter2)")).To(Equal(
+					"logging in as ((redacted)) with ((redacted)) (((redacted)))",
+				))
+			})
+
+			It("redacts the longest secret whole", func() {
+				Expect(secrets.Redact("password: hunter2-and-more")).To(Equal("password: ((redacted))"))
+			})
+
+			It("redacts lines of multi-line secrets on their own", func() {
+				Expect(secrets.Redact("key line: c2VjcmV0LWtleQ==")).To(Equal("key line: ((redacted))"))
+			})
+		})
+
+		It("does not redact values too short to be secrets", func() {
+			secrets.Track("yes")
+			secrets.Track(true)
+			secrets.Track(8080)
+
+			Expect(secrets.Redact("yes, listening on 8080: true")).To(Equal("yes, listening on 8080: true"))
+		})
+	})
+
+	Describe("PartialSuffix", func() {
+		BeforeEach(func() {
+			secrets.Track("hunter2")
+		})
+
+		It("returns the length of the end of the text which starts a secret", func() {
+			Expect(secrets.PartialSuffix("the password is hunt")).To(Equal(4))
+		})
+
+		It("returns zero when the text does not end with the start of a secret", func() {
+			Expect(secrets.PartialSuffix("the password is hunter2\n")).To(Equal(0))
+		})
+
+		It("does not count a whole secret", func() {
+			Expect(secrets.PartialSuffix("hunter2")).To(Equal(0))
+		})
+	})
+
+	Describe("NewTrackedVariables", func() {
+		var fakeVariables *credsfakes.FakeVariables
+
+		BeforeEach(func() {
+			fakeVariables = new(credsfakes.FakeVariables)
+			fakeVariables.GetReturns("hunter2", true, nil)
+		})
+
+		It("tracks the values fetched through the variables", func() {
+			variables := creds.NewTrackedVariables(fakeVariables, secrets)
+
+			value, found, err := variables.Get(template.VariableDefinition{Name: "password"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("hunter2"))
+
+			Expect(secrets.Redact("hunter2")).To(Equal("((redacted))"))
+		})
+
+		It("leaves the variables alone without any secrets to track them in", func() {
+			Expect(creds.NewTrackedVariables(fakeVariables, nil)).To(Equal(fakeVariables))
+		})
+	})
+})
@@ -32,6 +32,14 @@ type BuildOutput struct {
 	Version atc.Version
 }
 
+// BuildComment is a note left on a build, e.g. explaining why it was rerun or
+// aborted.
+type BuildComment struct {
+	Author  string    `json:"author"`
+	Comment string    `json:"comment"`
+	Time    time.Time `json:"time"`
+}
+
 type BuildStatus string
 
 const (
@@ -43,11 +51,12 @@ const (
 	BuildStatusErrored   BuildStatus = "errored"
 )
 
-var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, t.name, b.nonce, b.tracked_by, b.drained").
+var buildsQuery = psql.Select("b.id, b.name, b.job_id, b.team_id, b.status, b.manually_triggered, b.scheduled, b.engine, b.engine_metadata, b.public_plan, b.create_time, b.start_time, b.end_time, b.reap_time, j.name, b.pipeline_id, p.name, p.instance_vars, t.name, b.nonce, b.tracked_by, b.drained, b.annotations, b.comments, b.rerun_of, rb.name, b.test_summary").
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
 	JoinClause("LEFT OUTER JOIN pipelines p ON b.pipeline_id = p.id").
-	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id")
+	JoinClause("LEFT OUTER JOIN teams t ON b.team_id = t.id").
+	JoinClause("LEFT OUTER JOIN builds rb ON b.rerun_of = rb.id")
 
 var minMaxIdQuery = psql.Select("COALESCE(MAX(b.id), 0)", "COALESCE(MIN(b.id), 0)").
 	From("builds as b")
@@ -61,12 +70,14 @@ type Build interface {
 	JobName() string
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Engine() string
 	EngineMetadata() string
 	PublicPlan() *json.RawMessage
 	Status() BuildStatus
+	CreateTime() time.Time
 	StartTime() time.Time
 	EndTime() time.Time
 	ReapTime() time.Time
@@ -74,6 +85,10 @@ type Build interface {
 	IsManuallyTriggered() bool
 	IsScheduled() bool
 	IsRunning() bool
+	Annotations() map[string]string
+	Comments() []BuildComment
+	RerunOf() int
+	RerunOfName() string
 
 	Reload() (bool, error)
 
@@ -88,13 +103,21 @@ type Build interface {
 	Finish(BuildStatus) error
 
 	SetInterceptible(bool) error
+	Annotate(map[string]string) error
+	Comment(author string, comment string) (BuildComment, error)
+
+	TestSummary() *atc.TestSummary
+	TestResults() ([]atc.TestResult, error)
+	SaveTestResults([]atc.TestResult) error
 
 	Events(uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
+	ArchiveEvents() error
 
 	SaveOutput(lager.Logger, string, atc.Source, creds.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	UseInputs(inputs []BuildInput) error
 
+	Inputs() ([]BuildInput, error)
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(UsedResourceCache) error
 
@@ -102,6 +125,7 @@ type Build interface {
 
 	Delete() (bool, error)
 	MarkAsAborted() error
+	MarkAsTimedOut() error
 	AbortNotifier() (Notifier, error)
 	Schedule() (bool, error)
 
@@ -118,20 +142,27 @@ type build struct {
 	teamID   int
 	teamName string
 
-	pipelineID   int
-	pipelineName string
-	jobID        int
-	jobName      string
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
+	jobID                int
+	jobName              string
 
 	isManuallyTriggered bool
 
 	engine         string
 	engineMetadata string
 	publicPlan     *json.RawMessage
+	annotations    map[string]string
+	comments       []BuildComment
+	rerunOf        int
+	rerunOfName    string
+	testSummary    *atc.TestSummary
 
-	startTime time.Time
-	endTime   time.Time
-	reapTime  time.Time
+	createTime time.Time
+	startTime  time.Time
+	endTime    time.Time
+	reapTime   time.Time
 
 	trackedBy string
 
@@ -143,25 +174,33 @@ type build struct {
 var ErrBuildDisappeared = errors.New("build disappeared from db")
 var ErrBuildHasNoPipeline = errors.New("build has no pipeline")
 
-func (b *build) ID() int                      { return b.id }
-func (b *build) Name() string                 { return b.name }
-func (b *build) JobID() int                   { return b.jobID }
-func (b *build) JobName() string              { return b.jobName }
-func (b *build) PipelineID() int              { return b.pipelineID }
-func (b *build) PipelineName() string         { return b.pipelineName }
-func (b *build) TeamID() int                  { return b.teamID }
-func (b *build) TeamName() string             { return b.teamName }
-func (b *build) IsManuallyTriggered() bool    { return b.isManuallyTriggered }
-func (b *build) Engine() string               { return b.engine }
-func (b *build) EngineMetadata() string       { return b.engineMetadata }
-func (b *build) PublicPlan() *json.RawMessage { return b.publicPlan }
-func (b *build) StartTime() time.Time         { return b.startTime }
-func (b *build) EndTime() time.Time           { return b.endTime }
-func (b *build) ReapTime() time.Time          { return b.reapTime }
-func (b *build) Status() BuildStatus          { return b.status }
-func (b *build) Tracker() string              { return b.trackedBy }
-func (b *build) IsScheduled() bool            { return b.scheduled }
-func (b *build) IsDrained() bool              { return b.drained }
+func (b *build) ID() int                                { return b.id }
+func (b *build) Name() string                           { return b.name }
+func (b *build) JobID() int                             { return b.jobID }
+func (b *build) JobName() string                        { return b.jobName }
+func (b *build) PipelineID() int                        { return b.pipelineID }
+func (b *build) PipelineName() string                   { return b.pipelineName }
+func (b *build) PipelineInstanceVars() atc.InstanceVars { return b.pipelineInstanceVars }
+func (b *build) TeamID() int                            { return b.teamID }
+func (b *build) TeamName() string                       { return b.teamName }
+func (b *build) IsManuallyTriggered() bool              { return b.isManuallyTriggered }
+func (b *build) Engine() string                         { return b.engine }
+func (b *build) EngineMetadata() string                 { return b.engineMetadata }
+func (b *build) PublicPlan() *json.RawMessage           { return b.publicPlan }
+func (b *build) CreateTime() time.Time                  { return b.createTime }
+func (b *build) StartTime() time.Time                   { return b.startTime }
+func (b *build) EndTime() time.Time                     { return b.endTime }
+func (b *build) ReapTime() time.Time                    { return b.reapTime }
+func (b *build) Status() BuildStatus                    { return b.status }
+func (b *build) Tracker() string                        { return b.trackedBy }
+func (b *build) IsScheduled() bool                      { return b.scheduled }
+func (b *build) IsDrained() bool                        { return b.drained }
+
+func (b *build) Annotations() map[string]string { return b.annotations }
+func (b *build) Comments() []BuildComment       { return b.comments }
+func (b *build) RerunOf() int                   { return b.rerunOf }
+func (b *build) RerunOfName() string            { return b.rerunOfName }
+func (b *build) TestSummary() *atc.TestSummary  { return b.testSummary }
 
 func (b *build) IsRunning() bool {
 	switch b.status {
@@ -230,6 +269,168 @@ func (b *build) SetInterceptible(i bool) error {
 	return nil
 }
 
+// Annotate merges the given annotations into the build's, replacing the
+// values of any keys that were already set.
+func (b *build) Annotate(annotations map[string]string) error {
+	payload, err := json.Marshal(annotations)
+	if err != nil {
+		return err
+	}
+
+	var merged sql.NullString
+	err = psql.Update("builds").
+		Set("annotations", sq.Expr("COALESCE(annotations, '{}'::jsonb) || ?::jsonb", string(payload))).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING annotations").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&merged)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBuildDisappeared
+		}
+
+		return err
+	}
+
+	return json.Unmarshal([]byte(merged.String), &b.annotations)
+}
+
+// Comment appends a comment by the given author to the build's.
+func (b *build) Comment(author string, comment string) (BuildComment, error) {
+	buildComment := BuildComment{
+		Author:  author,
+		Comment: comment,
+		Time:    time.Now(),
+	}
+
+	payload, err := json.Marshal([]BuildComment{buildComment})
+	if err != nil {
+		return BuildComment{}, err
+	}
+
+	var comments sql.NullString
+	err = psql.Update("builds").
+		Set("comments", sq.Expr("COALESCE(comments, '[]'::jsonb) || ?::jsonb", string(payload))).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING comments").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&comments)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return BuildComment{}, ErrBuildDisappeared
+		}
+
+		return BuildComment{}, err
+	}
+
+	err = json.Unmarshal([]byte(comments.String), &b.comments)
+	if err != nil {
+		return BuildComment{}, err
+	}
+
+	return buildComment, nil
+}
+
+// maxTestResultsPerInsert keeps each insert well under postgres' limit on
+// query parameters.
+const maxTestResultsPerInsert = 1000
+
+// SaveTestResults records the results of a task's test reports and
+// recomputes the build's test summary. Builds with several tasks reporting
+// results accumulate them.
+func (b *build) SaveTestResults(results []atc.TestResult) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for start := 0; start < len(results); start += maxTestResultsPerInsert {
+		end := start + maxTestResultsPerInsert
+		if end > len(results) {
+			end = len(results)
+		}
+
+		insert := psql.Insert("build_test_results").
+			Columns("build_id", "suite", "name", "status", "duration", "message")
+
+		for _, result := range results[start:end] {
+			insert = insert.Values(b.id, result.Suite, result.Name, string(result.Status), result.Duration, result.Message)
+		}
+
+		_, err = insert.RunWith(tx).Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	var summary sql.NullString
+	err = tx.QueryRow(`
+		UPDATE builds
+		SET test_summary = (
+			SELECT json_build_object(
+				'passed', count(*) FILTER (WHERE status = 'passed'),
+				'failed', count(*) FILTER (WHERE status = 'failed'),
+				'errored', count(*) FILTER (WHERE status = 'errored'),
+				'skipped', count(*) FILTER (WHERE status = 'skipped')
+			)
+			FROM build_test_results
+			WHERE build_id = $1
+		)
+		WHERE id = $1
+		RETURNING test_summary
+	`, b.id).Scan(&summary)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBuildDisappeared
+		}
+
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal([]byte(summary.String), &b.testSummary)
+}
+
+func (b *build) TestResults() ([]atc.TestResult, error) {
+	rows, err := psql.Select("suite", "name", "status", "duration", "message").
+		From("build_test_results").
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	results := []atc.TestResult{}
+	for rows.Next() {
+		var (
+			result atc.TestResult
+			status string
+		)
+
+		err := rows.Scan(&result.Suite, &result.Name, &status, &result.Duration, &result.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		result.Status = atc.TestStatus(status)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
 func (b *build) Start(engine, metadata string, plan atc.Plan) (bool, error) {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -315,6 +516,7 @@ func (b *build) Finish(status BuildStatus) error {
 	defer Rollback(tx)
 
 	var endTime time.Time
+	var abortReason sql.NullString
 
 	err = psql.Update("builds").
 		Set("status", status).
@@ -323,18 +525,24 @@ func (b *build) Finish(status BuildStatus) error {
 		Set("engine_metadata", nil).
 		Set("nonce", nil).
 		Where(sq.Eq{"id": b.id}).
-		Suffix("RETURNING end_time").
+		Suffix("RETURNING end_time, abort_reason").
 		RunWith(tx).
 		QueryRow().
-		Scan(&endTime)
+		Scan(&endTime, &abortReason)
 	if err != nil {
 		return err
 	}
 
-	err = b.saveEvent(tx, event.Status{
+	statusEvent := event.Status{
 		Status: atc.BuildStatus(status),
 		Time:   endTime.Unix(),
-	})
+	}
+
+	if status == BuildStatusAborted {
+		statusEvent.Reason = abortReason.String
+	}
+
+	err = b.saveEvent(tx, statusEvent)
 	if err != nil {
 		return err
 	}
@@ -407,6 +615,11 @@ func (b *build) SetDrained(drained bool) error {
 }
 
 func (b *build) Delete() (bool, error) {
+	err := deleteArchivedBuildEvents(b.conn, sq.Eq{"id": b.id})
+	if err != nil {
+		return false, err
+	}
+
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
 			"id": b.id,
@@ -448,6 +661,35 @@ func (b *build) MarkAsAborted() error {
 	return b.conn.Bus().Notify(buildAbortChannel(b.id))
 }
 
+// MarkAsTimedOut aborts the build like MarkAsAborted, recording that it was
+// aborted for running longer than its timeout. A build which has already
+// finished is left as it is, since the timeout may fire just as it finishes.
+func (b *build) MarkAsTimedOut() error {
+	result, err := psql.Update("builds").
+		Set("status", string(BuildStatusAborted)).
+		Set("abort_reason", atc.BuildTimedOut).
+		Where(sq.Eq{
+			"id":     b.id,
+			"status": []BuildStatus{BuildStatusPending, BuildStatusStarted},
+		}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return nil
+	}
+
+	return b.conn.Bus().Notify(buildAbortChannel(b.id))
+}
+
 // AbortNotifier returns a Notifier that can be watched for when the build
 // is marked as aborted. Once the build is marked as aborted it will send a
 // notification to finish the build to ATC that is tracking this build.
@@ -612,17 +854,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
-	tf := NewTeamFactory(b.conn, b.lockFactory)
-	t, found, err := tf.FindTeam(b.teamName)
-	if err != nil {
-		return BuildPreparation{}, false, err
-	}
-
-	if !found {
-		return BuildPreparation{}, false, nil
-	}
-
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := b.Pipeline()
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -732,6 +964,8 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 	return buildPreparation, true, nil
 }
 
+// Events streams the events of the build through the event store, starting
+// from the event with the given index.
 func (b *build) Events(from uint) (EventSource, error) {
 	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
 		return true, nil
@@ -740,20 +974,74 @@ func (b *build) Events(from uint) (EventSource, error) {
 		return nil, err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-
 	return newBuildEventSource(
 		b.id,
-		table,
 		b.conn,
 		notifier,
 		from,
 	), nil
 }
 
+// ArchiveEvents moves the events of a completed build from Postgres to the
+// event store configured with WithEventStore.
+func (b *build) ArchiveEvents() error {
+	store, ok := b.conn.EventStore().(*archivingEventStore)
+	if !ok {
+		return ErrNoEventStore
+	}
+
+	rows, err := psql.Select("type", "version", "payload").
+		From(b.eventsTable()).
+		Where(sq.Eq{"build_id": b.id}).
+		OrderBy("event_id ASC").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	events, err := scanBuildEvents(rows)
+	if err != nil {
+		return err
+	}
+
+	err = store.archive.Put(b.id, events)
+	if err != nil {
+		return err
+	}
+
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("builds").
+		Set("events_archived", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete(b.eventsTable()).
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return b.conn.Bus().Notify(buildEventsChannel(b.id))
+}
+
 func (b *build) SaveEvent(event atc.Event) error {
 	tx, err := b.conn.Begin()
 	if err != nil {
@@ -892,6 +1180,47 @@ func (b *build) UseInputs(inputs []BuildInput) error {
 	return tx.Commit()
 }
 
+// Inputs returns every version the build used as an input. Unlike Resources,
+// it includes the versions that the build also produced as outputs.
+func (b *build) Inputs() ([]BuildInput, error) {
+	rows, err := psql.Select("inputs.name", "resources.id", "versions.version").
+		From("resource_config_versions versions, build_resource_config_version_inputs inputs, resources").
+		Where(sq.Eq{"inputs.build_id": b.id}).
+		Where(sq.NotEq{"versions.check_order": 0}).
+		Where(sq.Expr("inputs.version_md5 = versions.version_md5")).
+		Where(sq.Expr("resources.resource_config_id = versions.resource_config_id")).
+		Where(sq.Expr("resources.id = inputs.resource_id")).
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	inputs := []BuildInput{}
+	for rows.Next() {
+		var (
+			input       BuildInput
+			versionBlob string
+		)
+
+		err = rows.Scan(&input.Name, &input.ResourceID, &versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal([]byte(versionBlob), &input.Version)
+		if err != nil {
+			return nil, err
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
 func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 	inputs := []BuildInput{}
 	outputs := []BuildOutput{}
@@ -1031,16 +1360,18 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID                                                    sql.NullInt64
+		jobID, pipelineID, rerunOf                                           sql.NullInt64
 		engine, engineMetadata, jobName, pipelineName, publicPlan, trackedBy sql.NullString
-		startTime, endTime, reapTime                                         pq.NullTime
-		nonce                                                                sql.NullString
+		rerunOfName                                                          sql.NullString
+		createTime, startTime, endTime, reapTime                             pq.NullTime
+		nonce, annotations, comments, testSummary                            sql.NullString
+		pipelineInstanceVars                                                 sql.NullString
 		drained                                                              bool
 
 		status string
 	)
 
-	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &b.teamName, &nonce, &trackedBy, &drained)
+	err := row.Scan(&b.id, &b.name, &jobID, &b.teamID, &status, &b.isManuallyTriggered, &b.scheduled, &engine, &engineMetadata, &publicPlan, &createTime, &startTime, &endTime, &reapTime, &jobName, &pipelineID, &pipelineName, &pipelineInstanceVars, &b.teamName, &nonce, &trackedBy, &drained, &annotations, &comments, &rerunOf, &rerunOfName, &testSummary)
 	if err != nil {
 		return err
 	}
@@ -1050,12 +1381,23 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.jobID = int(jobID.Int64)
 	b.pipelineName = pipelineName.String
 	b.pipelineID = int(pipelineID.Int64)
+
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &b.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	b.engine = engine.String
+	b.createTime = createTime.Time
 	b.startTime = startTime.Time
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.trackedBy = trackedBy.String
 	b.drained = drained
+	b.rerunOf = int(rerunOf.Int64)
+	b.rerunOfName = rerunOfName.String
 
 	var (
 		noncense                *string
@@ -1079,6 +1421,30 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		}
 	}
 
+	b.annotations = nil
+	if annotations.Valid {
+		err = json.Unmarshal([]byte(annotations.String), &b.annotations)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.comments = nil
+	if comments.Valid {
+		err = json.Unmarshal([]byte(comments.String), &b.comments)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.testSummary = nil
+	if testSummary.Valid {
+		err = json.Unmarshal([]byte(testSummary.String), &b.testSummary)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1088,11 +1454,7 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 		return err
 	}
 
-	table := fmt.Sprintf("team_build_events_%d", b.teamID)
-	if b.pipelineID != 0 {
-		table = fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
-	}
-	_, err = psql.Insert(table).
+	_, err = psql.Insert(b.eventsTable()).
 		Columns("event_id", "build_id", "type", "version", "payload").
 		Values(sq.Expr("nextval('"+buildEventSeq(b.id)+"')"), b.id, string(event.EventType()), string(event.Version()), payload).
 		RunWith(tx).
@@ -1100,6 +1462,10 @@ func (b *build) saveEvent(tx Tx, event atc.Event) error {
 	return err
 }
 
+func (b *build) eventsTable() string {
+	return buildEventsTable(b.teamID, b.pipelineID)
+}
+
 func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
 	var buildID int
 	err := psql.Insert("builds").
@@ -1,11 +1,9 @@
 package db
 
 import (
-	"encoding/json"
 	"errors"
 	"sync"
 
-	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 )
 
@@ -21,7 +19,6 @@ type EventSource interface {
 
 func newBuildEventSource(
 	buildID int,
-	table string,
 	conn Conn,
 	notifier Notifier,
 	from uint,
@@ -30,9 +27,9 @@ func newBuildEventSource(
 
 	source := &buildEventSource{
 		buildID: buildID,
-		table:   table,
 
-		conn: conn,
+		conn:  conn,
+		store: conn.EventStore(),
 
 		notifier: notifier,
 
@@ -49,9 +46,9 @@ func newBuildEventSource(
 
 type buildEventSource struct {
 	buildID int
-	table   string
 
 	conn     Conn
+	store    EventStore
 	notifier Notifier
 
 	events chan event.Envelope
@@ -109,57 +106,26 @@ func (source *buildEventSource) collectEvents(cursor uint) {
 			return
 		}
 
-		rows, err := source.conn.Query(`
-			SELECT type, version, payload
-			FROM `+source.table+`
-			WHERE build_id = $1
-			ORDER BY event_id ASC
-			OFFSET $2
-			LIMIT $3
-		`, source.buildID, cursor, batchSize)
+		events, err := source.store.Get(source.buildID, cursor, batchSize)
 		if err != nil {
 			source.err = err
 			close(source.events)
 			return
 		}
 
-		rowsReturned := 0
-
-		for rows.Next() {
-			rowsReturned++
-
+		for _, ev := range events {
 			cursor++
 
-			var t, v, p string
-			err := rows.Scan(&t, &v, &p)
-			if err != nil {
-				_ = rows.Close()
-
-				source.err = err
-				close(source.events)
-				return
-			}
-
-			data := json.RawMessage(p)
-
-			ev := event.Envelope{
-				Data:    &data,
-				Event:   atc.EventType(t),
-				Version: atc.EventVersion(v),
-			}
-
 			select {
 			case source.events <- ev:
 			case <-source.stop:
-				_ = rows.Close()
-
 				source.err = ErrBuildEventStreamClosed
 				close(source.events)
 				return
 			}
 		}
 
-		if rowsReturned == batchSize {
+		if len(events) == batchSize {
 			// still more events
 			continue
 		}
@@ -19,6 +19,7 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetCompletedBuildsWithUnarchivedEvents(limit int) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -114,6 +115,20 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetCompletedBuildsWithUnarchivedEvents returns the oldest completed builds
+// whose events are still in Postgres and haven't been reaped.
+func (f *buildFactory) GetCompletedBuildsWithUnarchivedEvents(limit int) ([]Build, error) {
+	query := buildsQuery.Where(sq.Eq{
+		"b.completed":       true,
+		"b.events_archived": false,
+		"b.reap_time":       nil,
+	}).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
@@ -4,11 +4,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/event"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -66,6 +68,118 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Annotate", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no annotations to begin with", func() {
+			Expect(build.Annotations()).To(BeEmpty())
+		})
+
+		It("merges the annotations into the build's", func() {
+			err := build.Annotate(map[string]string{"coverage": "80%", "release": "some-url"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Annotate(map[string]string{"coverage": "87%"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.Annotations()).To(Equal(map[string]string{"coverage": "87%", "release": "some-url"}))
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.Annotations()).To(Equal(map[string]string{"coverage": "87%", "release": "some-url"}))
+		})
+	})
+
+	Describe("Comment", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no comments to begin with", func() {
+			Expect(build.Comments()).To(BeEmpty())
+		})
+
+		It("appends the comments to the build's in order", func() {
+			first, err := build.Comment("some-user", "rerunning after the outage")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(first.Author).To(Equal("some-user"))
+			Expect(first.Comment).To(Equal("rerunning after the outage"))
+			Expect(first.Time).NotTo(BeZero())
+
+			_, err = build.Comment("other-user", "aborted, flaky worker")
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			comments := build.Comments()
+			Expect(comments).To(HaveLen(2))
+			Expect(comments[0].Author).To(Equal("some-user"))
+			Expect(comments[0].Comment).To(Equal("rerunning after the outage"))
+			Expect(comments[0].Time.Unix()).To(Equal(first.Time.Unix()))
+			Expect(comments[1].Author).To(Equal("other-user"))
+			Expect(comments[1].Comment).To(Equal("aborted, flaky worker"))
+		})
+	})
+
+	Describe("SaveTestResults", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no test results to begin with", func() {
+			Expect(build.TestSummary()).To(BeNil())
+
+			results, err := build.TestResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(BeEmpty())
+		})
+
+		It("accumulates the results and summarizes them", func() {
+			err := build.SaveTestResults([]atc.TestResult{
+				{Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+				{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "off by one"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveTestResults([]atc.TestResult{
+				{Suite: "io", Name: "reads", Status: atc.TestSkipped},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(build.TestSummary()).To(Equal(&atc.TestSummary{Passed: 1, Failed: 1, Skipped: 1}))
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.TestSummary()).To(Equal(&atc.TestSummary{Passed: 1, Failed: 1, Skipped: 1}))
+
+			results, err := build.TestResults()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+				{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "off by one"},
+				{Suite: "io", Name: "reads", Status: atc.TestSkipped},
+			}))
+		})
+	})
+
 	Describe("Start", func() {
 		var build db.Build
 		var plan atc.Plan
@@ -219,6 +333,61 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("MarkAsTimedOut", func() {
+		var build db.Build
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			err := build.MarkAsTimedOut()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("updates build status", func() {
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusAborted))
+		})
+
+		It("gives the reason in the build's final event", func() {
+			err := build.Finish(db.BuildStatusAborted)
+			Expect(err).NotTo(HaveOccurred())
+
+			found, err := build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			events, err := build.Events(0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(envelope(event.Status{
+				Status: atc.StatusAborted,
+				Time:   build.EndTime().Unix(),
+				Reason: atc.BuildTimedOut,
+			})))
+		})
+
+		Context("when the build has already finished", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("leaves its status alone", func() {
+				found, err := build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(build.Status()).To(Equal(db.BuildStatusSucceeded))
+			})
+		})
+	})
+
 	Describe("Events", func() {
 		It("saves and emits status events", func() {
 			build, err := team.CreateOneOffBuild()
@@ -342,6 +511,131 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("ArchiveEvents", func() {
+		var (
+			fakeEventStore *dbfakes.FakeEventStore
+			build          db.Build
+		)
+
+		BeforeEach(func() {
+			fakeEventStore = new(dbfakes.FakeEventStore)
+
+			stored := map[int][]event.Envelope{}
+			fakeEventStore.PutStub = func(buildID int, events []event.Envelope) error {
+				stored[buildID] = events
+				return nil
+			}
+			fakeEventStore.GetStub = func(buildID int, from uint, limit int) ([]event.Envelope, error) {
+				events := stored[buildID]
+				if int(from) >= len(events) {
+					return []event.Envelope{}, nil
+				}
+
+				events = events[from:]
+				if len(events) > limit {
+					events = events[:limit]
+				}
+
+				return events, nil
+			}
+
+			storeTeam, found, err := db.NewTeamFactory(db.WithEventStore(dbConn, fakeEventStore), lockFactory).FindTeam(team.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			build, err = storeTeam.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveEvent(event.Log{Payload: "some "})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveEvent(event.Log{Payload: "log"})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("moves the events to the event store", func() {
+			err := build.ArchiveEvents()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeEventStore.PutCallCount()).To(Equal(1))
+			buildID, events := fakeEventStore.PutArgsForCall(0)
+			Expect(buildID).To(Equal(build.ID()))
+			Expect(events).To(HaveLen(3))
+			Expect(events[0]).To(Equal(envelope(event.Log{Payload: "some "})))
+
+			var count int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM team_build_events_`+strconv.Itoa(build.TeamID())+` WHERE build_id = $1`, build.ID()).Scan(&count)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("reads the events back from the event store", func() {
+			err := build.ArchiveEvents()
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := build.Events(1)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "log"})))
+
+			_, err = events.Next()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("is no longer returned as a build to archive", func() {
+			builds, err := db.NewBuildFactory(dbConn, lockFactory, 0).GetCompletedBuildsWithUnarchivedEvents(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+
+			err = build.ArchiveEvents()
+			Expect(err).NotTo(HaveOccurred())
+
+			builds, err = db.NewBuildFactory(dbConn, lockFactory, 0).GetCompletedBuildsWithUnarchivedEvents(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+
+		Context("when storing the events fails", func() {
+			BeforeEach(func() {
+				fakeEventStore.PutStub = nil
+				fakeEventStore.PutReturns(errors.New("nope"))
+			})
+
+			It("leaves the events in postgres", func() {
+				err := build.ArchiveEvents()
+				Expect(err).To(HaveOccurred())
+
+				events, err := build.Events(0)
+				Expect(err).NotTo(HaveOccurred())
+
+				defer db.Close(events)
+
+				Expect(events.Next()).To(Equal(envelope(event.Log{Payload: "some "})))
+			})
+		})
+
+		Context("when the build is deleted", func() {
+			It("deletes the events from the event store", func() {
+				err := build.ArchiveEvents()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Delete()
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeEventStore.DeleteCallCount()).To(Equal(1))
+				Expect(fakeEventStore.DeleteArgsForCall(0)).To(Equal([]int{build.ID()}))
+			})
+		})
+	})
+
 	Describe("SaveOutput", func() {
 		var pipeline db.Pipeline
 		var job db.Job
@@ -368,7 +662,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -505,7 +799,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -601,7 +895,7 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -699,7 +993,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -908,7 +1202,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), db.PipelineUnpaused)
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -990,7 +1284,7 @@ var _ = Describe("Build", func() {
 			)
 
 			BeforeEach(func() {
-				pipeline, _, err := team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -1072,7 +1366,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
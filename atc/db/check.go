@@ -0,0 +1,236 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
+)
+
+type CheckStatus string
+
+const (
+	CheckStatusPending   CheckStatus = "pending"
+	CheckStatusStarted   CheckStatus = "started"
+	CheckStatusSucceeded CheckStatus = "succeeded"
+	CheckStatusErrored   CheckStatus = "errored"
+	CheckStatusSkipped   CheckStatus = "skipped"
+)
+
+var checksQuery = psql.Select("c.id, c.pipeline_id, p.name, t.name, c.resource_id, r.name, c.resource_type_id, rt.name, c.status, c.create_time, c.start_time, c.end_time, c.check_error, c.from_version, c.new_versions, c.stderr").
+	From("checks c").
+	Join("pipelines p ON p.id = c.pipeline_id").
+	Join("teams t ON t.id = p.team_id").
+	LeftJoin("resources r ON r.id = c.resource_id").
+	LeftJoin("resource_types rt ON rt.id = c.resource_type_id")
+
+// CheckResult is what a check found, if it got as far as running the check
+// script.
+type CheckResult struct {
+	FromVersion atc.Version
+	NewVersions int
+	Stderr      string
+
+	// Skipped is set if the check script was not run, e.g. because the
+	// resource was checked within its interval or is being checked elsewhere.
+	Skipped bool
+}
+
+//go:generate counterfeiter . Check
+
+type Check interface {
+	ID() int
+	PipelineID() int
+	PipelineName() string
+	TeamName() string
+	ResourceID() int
+	ResourceName() string
+	ResourceTypeID() int
+	ResourceTypeName() string
+	Status() CheckStatus
+	CreateTime() time.Time
+	StartTime() time.Time
+	EndTime() time.Time
+	CheckError() error
+	FromVersion() atc.Version
+	NewVersions() int
+	Stderr() string
+
+	Pipeline() (Pipeline, bool, error)
+
+	Defer(time.Duration) error
+	Finish(CheckResult, error) error
+}
+
+type check struct {
+	id               int
+	pipelineID       int
+	pipelineName     string
+	teamName         string
+	resourceID       int
+	resourceName     string
+	resourceTypeID   int
+	resourceTypeName string
+	status           CheckStatus
+	createTime       time.Time
+	startTime        time.Time
+	endTime          time.Time
+	checkError       error
+	result           CheckResult
+
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func (c *check) ID() int                  { return c.id }
+func (c *check) PipelineID() int          { return c.pipelineID }
+func (c *check) PipelineName() string     { return c.pipelineName }
+func (c *check) TeamName() string         { return c.teamName }
+func (c *check) ResourceID() int          { return c.resourceID }
+func (c *check) ResourceName() string     { return c.resourceName }
+func (c *check) ResourceTypeID() int      { return c.resourceTypeID }
+func (c *check) ResourceTypeName() string { return c.resourceTypeName }
+func (c *check) Status() CheckStatus      { return c.status }
+func (c *check) CreateTime() time.Time    { return c.createTime }
+func (c *check) StartTime() time.Time     { return c.startTime }
+func (c *check) EndTime() time.Time       { return c.endTime }
+func (c *check) CheckError() error        { return c.checkError }
+func (c *check) FromVersion() atc.Version { return c.result.FromVersion }
+func (c *check) NewVersions() int         { return c.result.NewVersions }
+func (c *check) Stderr() string           { return c.result.Stderr }
+
+func (c *check) Pipeline() (Pipeline, bool, error) {
+	row := pipelinesQuery.
+		Where(sq.Eq{"p.id": c.pipelineID}).
+		RunWith(c.conn).
+		QueryRow()
+
+	pipeline := newPipeline(c.conn, c.lockFactory)
+	err := scanPipeline(pipeline, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return pipeline, true, nil
+}
+
+// Defer puts the started check back in the queue, to be started no sooner
+// than the given duration from now.
+func (c *check) Defer(delay time.Duration) error {
+	_, err := psql.Update("checks").
+		Set("status", CheckStatusPending).
+		Set("start_time", nil).
+		Set("deferred_until", sq.Expr("now() + (? || ' SECONDS')::INTERVAL", delay.Seconds())).
+		Where(sq.Eq{"id": c.id}).
+		RunWith(c.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	c.status = CheckStatusPending
+	c.startTime = time.Time{}
+
+	return nil
+}
+
+// Finish records the result of the check. A check that was skipped without
+// an error is recorded as skipped rather than succeeded.
+func (c *check) Finish(result CheckResult, cause error) error {
+	status := CheckStatusSucceeded
+	if result.Skipped {
+		status = CheckStatusSkipped
+	}
+
+	var checkError sql.NullString
+	if cause != nil {
+		status = CheckStatusErrored
+		checkError = sql.NullString{String: cause.Error(), Valid: true}
+	}
+
+	var fromVersion, stderr sql.NullString
+	if result.FromVersion != nil {
+		versionJSON, err := json.Marshal(result.FromVersion)
+		if err != nil {
+			return err
+		}
+
+		fromVersion = sql.NullString{String: string(versionJSON), Valid: true}
+	}
+
+	if result.Stderr != "" {
+		stderr = sql.NullString{String: result.Stderr, Valid: true}
+	}
+
+	var endTime pq.NullTime
+	err := psql.Update("checks").
+		Set("status", status).
+		Set("end_time", sq.Expr("now()")).
+		Set("check_error", checkError).
+		Set("from_version", fromVersion).
+		Set("new_versions", result.NewVersions).
+		Set("stderr", stderr).
+		Where(sq.Eq{"id": c.id}).
+		Suffix("RETURNING end_time").
+		RunWith(c.conn).
+		QueryRow().
+		Scan(&endTime)
+	if err != nil {
+		return err
+	}
+
+	c.status = status
+	c.endTime = endTime.Time
+	c.checkError = cause
+	c.result = result
+
+	return nil
+}
+
+func scanCheck(c *check, row scannable) error {
+	var (
+		resourceID, resourceTypeID                    sql.NullInt64
+		resourceName, resourceTypeName, status, chErr sql.NullString
+		fromVersion, stderr                           sql.NullString
+		startTime, endTime                            pq.NullTime
+	)
+
+	err := row.Scan(&c.id, &c.pipelineID, &c.pipelineName, &c.teamName, &resourceID, &resourceName, &resourceTypeID, &resourceTypeName, &status, &c.createTime, &startTime, &endTime, &chErr, &fromVersion, &c.result.NewVersions, &stderr)
+	if err != nil {
+		return err
+	}
+
+	c.resourceID = int(resourceID.Int64)
+	c.resourceName = resourceName.String
+	c.resourceTypeID = int(resourceTypeID.Int64)
+	c.resourceTypeName = resourceTypeName.String
+	c.status = CheckStatus(status.String)
+	c.result.Skipped = c.status == CheckStatusSkipped
+	c.startTime = startTime.Time
+	c.endTime = endTime.Time
+	c.result.Stderr = stderr.String
+
+	c.result.FromVersion = nil
+	if fromVersion.Valid {
+		err = json.Unmarshal([]byte(fromVersion.String), &c.result.FromVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	if chErr.Valid {
+		c.checkError = errors.New(chErr.String)
+	} else {
+		c.checkError = nil
+	}
+
+	return nil
+}
@@ -0,0 +1,269 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+//go:generate counterfeiter . CheckFactory
+
+type CheckFactory interface {
+	Check(int) (Check, bool, error)
+
+	Resources() ([]Resource, error)
+	ResourceTypes() ([]ResourceType, error)
+
+	CreateResourceCheck(resourceID int, interval time.Duration) (Check, bool, error)
+	CreateImmediateResourceCheck(resourceID int) (Check, bool, error)
+	CreateResourceTypeCheck(resourceTypeID int, interval time.Duration) (Check, bool, error)
+
+	StartCheck() (Check, bool, error)
+	TakeCheckToken(bucket string, perMinute int) (bool, time.Duration, error)
+	ExpireChecks(time.Duration) error
+	PruneChecks(retain int) error
+}
+
+type checkFactory struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+}
+
+func NewCheckFactory(conn Conn, lockFactory lock.LockFactory) CheckFactory {
+	return &checkFactory{
+		conn:        conn,
+		lockFactory: lockFactory,
+	}
+}
+
+func (f *checkFactory) Check(id int) (Check, bool, error) {
+	row := checksQuery.
+		Where(sq.Eq{"c.id": id}).
+		RunWith(f.conn).
+		QueryRow()
+
+	check := &check{conn: f.conn, lockFactory: f.lockFactory}
+	err := scanCheck(check, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return check, true, nil
+}
+
+// Resources returns the active resources of every unpaused pipeline, which
+// are the ones due a check every so often.
+func (f *checkFactory) Resources() ([]Resource, error) {
+	rows, err := resourcesQuery.
+		Where(sq.Eq{"p.paused": false}).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	resources := []Resource{}
+
+	for rows.Next() {
+		r := &resource{conn: f.conn, lockFactory: f.lockFactory}
+		err := scanResource(r, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// ResourceTypes returns the active resource types of every unpaused pipeline.
+func (f *checkFactory) ResourceTypes() ([]ResourceType, error) {
+	rows, err := resourceTypesQuery.
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Where(sq.Eq{"p.paused": false}).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	resourceTypes := []ResourceType{}
+
+	for rows.Next() {
+		t := &resourceType{conn: f.conn, lockFactory: f.lockFactory}
+		err := scanResourceType(t, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		resourceTypes = append(resourceTypes, t)
+	}
+
+	return resourceTypes, nil
+}
+
+// CreateResourceCheck queues a check of the resource, unless one is already
+// queued or running, or one finished within the interval.
+func (f *checkFactory) CreateResourceCheck(resourceID int, interval time.Duration) (Check, bool, error) {
+	return f.createCheck("resources", "resource_id", resourceID, interval)
+}
+
+// CreateImmediateResourceCheck queues a check of the resource which runs
+// however recently its resource config was checked, e.g. because a webhook
+// says there's a new version. If a check of the resource is already queued,
+// no check is created but the queued one runs in the same way.
+func (f *checkFactory) CreateImmediateResourceCheck(resourceID int) (Check, bool, error) {
+	_, err := f.conn.Exec(`
+		UPDATE resource_configs c
+		SET last_checked = 'epoch'
+		FROM resources r
+		WHERE r.id = $1
+		AND c.id = r.resource_config_id
+	`, resourceID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return f.createCheck("resources", "resource_id", resourceID, 0)
+}
+
+// CreateResourceTypeCheck queues a check of the resource type, unless one is
+// already queued or running, or one finished within the interval.
+func (f *checkFactory) CreateResourceTypeCheck(resourceTypeID int, interval time.Duration) (Check, bool, error) {
+	return f.createCheck("resource_types", "resource_type_id", resourceTypeID, interval)
+}
+
+func (f *checkFactory) createCheck(table string, column string, id int, interval time.Duration) (Check, bool, error) {
+	var checkID int
+	err := f.conn.QueryRow(`
+		INSERT INTO checks (pipeline_id, `+column+`)
+		SELECT s.pipeline_id, s.id
+		FROM `+table+` s
+		WHERE s.id = $1
+		AND NOT EXISTS (
+			SELECT 1
+			FROM checks c
+			WHERE c.`+column+` = s.id
+			AND (
+				c.status IN ('pending', 'started')
+				OR c.end_time > now() - ($2 || ' SECONDS')::INTERVAL
+			)
+		)
+		ON CONFLICT DO NOTHING
+		RETURNING id
+	`, id, interval.Seconds()).Scan(&checkID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return f.Check(checkID)
+}
+
+// StartCheck claims the oldest pending check which isn't deferred. Concurrent
+// callers, on this ATC or any other, never claim the same check.
+func (f *checkFactory) StartCheck() (Check, bool, error) {
+	var checkID int
+	err := f.conn.QueryRow(`
+		UPDATE checks
+		SET status = 'started', start_time = now()
+		WHERE id = (
+			SELECT id
+			FROM checks
+			WHERE status = 'pending'
+			AND (deferred_until IS NULL OR deferred_until <= now())
+			ORDER BY create_time ASC, id ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id
+	`).Scan(&checkID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return f.Check(checkID)
+}
+
+// TakeCheckToken takes a token from the bucket, which holds at most perMinute
+// tokens and is refilled at perMinute tokens a minute. Buckets are shared by
+// all ATCs. If the bucket is empty, it returns how long until it holds a token
+// again.
+func (f *checkFactory) TakeCheckToken(bucket string, perMinute int) (bool, time.Duration, error) {
+	var tokens float64
+	err := f.conn.QueryRow(`
+		INSERT INTO check_rate_limits AS b (bucket, tokens)
+		VALUES ($1, $2::float8 - 1)
+		ON CONFLICT (bucket) DO UPDATE SET
+			tokens = LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.update_time) * $2::float8 / 60) - 1,
+			update_time = now()
+		WHERE LEAST($2::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.update_time) * $2::float8 / 60) >= 1
+		RETURNING tokens
+	`, bucket, perMinute).Scan(&tokens)
+	if err == nil {
+		return true, 0, nil
+	}
+
+	if err != sql.ErrNoRows {
+		return false, 0, err
+	}
+
+	var wait float64
+	err = f.conn.QueryRow(`
+		SELECT (1 - LEAST($2::float8, tokens + EXTRACT(EPOCH FROM now() - update_time) * $2::float8 / 60)) * 60 / $2::float8
+		FROM check_rate_limits
+		WHERE bucket = $1
+	`, bucket, perMinute).Scan(&wait)
+	if err != nil {
+		return false, 0, err
+	}
+
+	return false, time.Duration(wait * float64(time.Second)), nil
+}
+
+// ExpireChecks errors the checks that were started longer ago than the given
+// duration, e.g. by an ATC that went away mid-check, so that their resources
+// get queued again.
+func (f *checkFactory) ExpireChecks(startedFor time.Duration) error {
+	_, err := f.conn.Exec(`
+		UPDATE checks
+		SET status = 'errored', end_time = now(), check_error = 'check expired'
+		WHERE status = 'started'
+		AND start_time < now() - ($1 || ' SECONDS')::INTERVAL
+	`, startedFor.Seconds())
+	return err
+}
+
+// PruneChecks deletes all but the given number of most recent finished checks
+// of each resource and resource type.
+func (f *checkFactory) PruneChecks(retain int) error {
+	_, err := f.conn.Exec(`
+		DELETE FROM checks c
+		USING (
+			SELECT id, row_number() OVER (
+				PARTITION BY resource_id, resource_type_id
+				ORDER BY id DESC
+			) AS n
+			FROM checks
+			WHERE status IN ('succeeded', 'errored')
+		) AS finished
+		WHERE c.id = finished.id
+		AND finished.n > $1
+	`, retain)
+	return err
+}
@@ -0,0 +1,372 @@
+package db_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckFactory", func() {
+	var checkFactory db.CheckFactory
+
+	BeforeEach(func() {
+		checkFactory = db.NewCheckFactory(dbConn, lockFactory)
+	})
+
+	Describe("Resources", func() {
+		It("returns the resources of unpaused pipelines", func() {
+			resources, err := checkFactory.Resources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].ID()).To(Equal(defaultResource.ID()))
+		})
+
+		Context("when the pipeline is paused", func() {
+			BeforeEach(func() {
+				Expect(defaultPipeline.Pause()).To(Succeed())
+			})
+
+			It("does not return its resources", func() {
+				resources, err := checkFactory.Resources()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resources).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("ResourceTypes", func() {
+		It("returns the resource types of unpaused pipelines", func() {
+			resourceTypes, err := checkFactory.ResourceTypes()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceTypes).To(HaveLen(1))
+			Expect(resourceTypes[0].ID()).To(Equal(defaultResourceType.ID()))
+		})
+	})
+
+	Describe("CreateResourceCheck", func() {
+		It("queues a pending check of the resource", func() {
+			check, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(check.Status()).To(Equal(db.CheckStatusPending))
+			Expect(check.PipelineID()).To(Equal(defaultPipeline.ID()))
+			Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+			Expect(check.ResourceName()).To(Equal("some-resource"))
+			Expect(check.ResourceTypeID()).To(BeZero())
+			Expect(check.CreateTime()).NotTo(BeZero())
+		})
+
+		Context("when a check of the resource is already queued", func() {
+			BeforeEach(func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("does not queue another", func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+
+		Context("when a check of the resource finished within the interval", func() {
+			BeforeEach(func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				check, found, err := checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.Finish(db.CheckResult{}, nil)).To(Succeed())
+			})
+
+			It("does not queue another", func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+
+			It("queues another once the interval has elapsed", func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("CreateImmediateResourceCheck", func() {
+		var resourceConfig db.ResourceConfig
+
+		BeforeEach(func() {
+			var err error
+			resourceConfig, err = defaultResource.SetResourceConfig(logger, atc.Source{"some": "source"}, creds.VersionedResourceTypes{})
+			Expect(err).NotTo(HaveOccurred())
+
+			lock, acquired, err := resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+			Expect(lock.Release()).To(Succeed())
+		})
+
+		It("queues a pending check of the resource", func() {
+			check, created, err := checkFactory.CreateImmediateResourceCheck(defaultResource.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(check.Status()).To(Equal(db.CheckStatusPending))
+			Expect(check.ResourceID()).To(Equal(defaultResource.ID()))
+		})
+
+		It("makes the resource config due a check within its interval", func() {
+			_, _, err := checkFactory.CreateImmediateResourceCheck(defaultResource.ID())
+			Expect(err).NotTo(HaveOccurred())
+
+			lock, acquired, err := resourceConfig.AcquireResourceConfigCheckingLockWithIntervalCheck(logger, time.Hour, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acquired).To(BeTrue())
+			Expect(lock.Release()).To(Succeed())
+		})
+
+		Context("when a check of the resource is already queued", func() {
+			BeforeEach(func() {
+				_, created, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeTrue())
+			})
+
+			It("does not queue another", func() {
+				_, created, err := checkFactory.CreateImmediateResourceCheck(defaultResource.ID())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("CreateResourceTypeCheck", func() {
+		It("queues a pending check of the resource type", func() {
+			check, created, err := checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(check.ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+			Expect(check.ResourceTypeName()).To(Equal("some-type"))
+			Expect(check.ResourceID()).To(BeZero())
+		})
+	})
+
+	Describe("StartCheck", func() {
+		Context("when there are no queued checks", func() {
+			It("does not find one", func() {
+				_, found, err := checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when there are queued checks", func() {
+			var firstCheck db.Check
+
+			BeforeEach(func() {
+				var err error
+				firstCheck, _, err = checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = checkFactory.CreateResourceTypeCheck(defaultResourceType.ID(), time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("starts the oldest one first, and each only once", func() {
+				check, found, err := checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.ID()).To(Equal(firstCheck.ID()))
+				Expect(check.Status()).To(Equal(db.CheckStatusStarted))
+				Expect(check.StartTime()).NotTo(BeZero())
+
+				check, found, err = checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(check.ResourceTypeID()).To(Equal(defaultResourceType.ID()))
+
+				_, found, err = checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Finish", func() {
+		var check db.Check
+
+		BeforeEach(func() {
+			_, _, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			check, _, err = checkFactory.StartCheck()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the error", func() {
+			Expect(check.Finish(db.CheckResult{}, errors.New("nope"))).To(Succeed())
+
+			reloaded, found, err := checkFactory.Check(check.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.Status()).To(Equal(db.CheckStatusErrored))
+			Expect(reloaded.CheckError()).To(MatchError("nope"))
+			Expect(reloaded.EndTime()).NotTo(BeZero())
+		})
+
+		It("records what the check found", func() {
+			Expect(check.Finish(db.CheckResult{
+				FromVersion: atc.Version{"ref": "v1"},
+				NewVersions: 2,
+				Stderr:      "some-stderr",
+			}, nil)).To(Succeed())
+
+			reloaded, found, err := checkFactory.Check(check.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.FromVersion()).To(Equal(atc.Version{"ref": "v1"}))
+			Expect(reloaded.NewVersions()).To(Equal(2))
+			Expect(reloaded.Stderr()).To(Equal("some-stderr"))
+		})
+
+		It("records a skipped check as skipped", func() {
+			Expect(check.Finish(db.CheckResult{Skipped: true}, nil)).To(Succeed())
+
+			reloaded, found, err := checkFactory.Check(check.ID())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.Status()).To(Equal(db.CheckStatusSkipped))
+			Expect(reloaded.NewVersions()).To(BeZero())
+		})
+
+		It("is listed with the resource's checks", func() {
+			Expect(check.Finish(db.CheckResult{}, nil)).To(Succeed())
+
+			checks, err := defaultResource.Checks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(1))
+			Expect(checks[0].Status()).To(Equal(db.CheckStatusSucceeded))
+			Expect(checks[0].CheckError()).To(BeNil())
+		})
+	})
+
+	Describe("Defer", func() {
+		var check db.Check
+
+		BeforeEach(func() {
+			_, _, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			check, _, err = checkFactory.StartCheck()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("queues the check again", func() {
+			Expect(check.Defer(0)).To(Succeed())
+			Expect(check.Status()).To(Equal(db.CheckStatusPending))
+
+			started, found, err := checkFactory.StartCheck()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(started.ID()).To(Equal(check.ID()))
+			Expect(started.CreateTime()).To(Equal(check.CreateTime()))
+		})
+
+		It("is not started again until the delay has elapsed", func() {
+			Expect(check.Defer(time.Hour)).To(Succeed())
+
+			_, found, err := checkFactory.StartCheck()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("TakeCheckToken", func() {
+		It("takes up to the limit of tokens from each bucket", func() {
+			for i := 0; i < 2; i++ {
+				taken, _, err := checkFactory.TakeCheckToken("some-type", 2)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taken).To(BeTrue())
+			}
+
+			taken, wait, err := checkFactory.TakeCheckToken("some-type", 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeFalse())
+			Expect(wait).To(BeNumerically("~", 30*time.Second, time.Second))
+
+			taken, _, err = checkFactory.TakeCheckToken("main/some-type", 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeTrue())
+		})
+
+		Context("when the limit is raised", func() {
+			BeforeEach(func() {
+				taken, _, err := checkFactory.TakeCheckToken("some-type", 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taken).To(BeTrue())
+			})
+
+			It("refills the bucket at the new rate", func() {
+				taken, wait, err := checkFactory.TakeCheckToken("some-type", 60)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taken).To(BeFalse())
+				Expect(wait).To(BeNumerically("<=", time.Second))
+			})
+		})
+	})
+
+	Describe("PruneChecks", func() {
+		BeforeEach(func() {
+			for i := 0; i < 3; i++ {
+				_, _, err := checkFactory.CreateResourceCheck(defaultResource.ID(), 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				check, _, err := checkFactory.StartCheck()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(check.Finish(db.CheckResult{NewVersions: i}, nil)).To(Succeed())
+			}
+		})
+
+		It("keeps only the most recent finished checks of each resource", func() {
+			Expect(checkFactory.PruneChecks(2)).To(Succeed())
+
+			checks, err := defaultResource.Checks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks).To(HaveLen(2))
+			Expect(checks[0].NewVersions()).To(Equal(2))
+			Expect(checks[1].NewVersions()).To(Equal(1))
+		})
+	})
+
+	Describe("ExpireChecks", func() {
+		BeforeEach(func() {
+			_, _, err := checkFactory.CreateResourceCheck(defaultResource.ID(), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = checkFactory.StartCheck()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("errors checks started longer ago than the given duration", func() {
+			Expect(checkFactory.ExpireChecks(time.Hour)).To(Succeed())
+
+			checks, err := defaultResource.Checks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks[0].Status()).To(Equal(db.CheckStatusStarted))
+
+			Expect(checkFactory.ExpireChecks(0)).To(Succeed())
+
+			checks, err = defaultResource.Checks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checks[0].Status()).To(Equal(db.CheckStatusErrored))
+		})
+	})
+})
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	sq "github.com/Masterminds/squirrel"
+)
+
+// CheckRateLimits are how many checks of each resource type may run per
+// minute, across all ATCs. A limit keyed by the type's name is shared by the
+// checks of every team, while one keyed by TEAM/TYPE applies to the checks of
+// that team in its place. A limit of 0 means no limit.
+type CheckRateLimits map[string]int
+
+//go:generate counterfeiter . CheckRateLimiter
+
+// CheckRateLimiter limits how often the checks of each resource type run.
+// Every check takes a token from it right before running its check script,
+// whether the check was queued, requested through the API or a webhook, or
+// is of an image resource.
+type CheckRateLimiter interface {
+	// Take takes a token for a check of the given type by the team. If the
+	// type is over its limit, it returns how long until a token is available.
+	Take(teamID int, resourceType string) (bool, time.Duration, error)
+}
+
+// WaitForCheckToken takes a token for a check of the given type by the team,
+// waiting for one for as long as the limiter says, until the context is done.
+// waiting is called with the delay before each wait.
+func WaitForCheckToken(
+	ctx context.Context,
+	clock clock.Clock,
+	limiter CheckRateLimiter,
+	teamID int,
+	resourceType string,
+	waiting func(time.Duration),
+) error {
+	for {
+		taken, delay, err := limiter.Take(teamID, resourceType)
+		if err != nil {
+			return err
+		}
+
+		if taken {
+			return nil
+		}
+
+		waiting(delay)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(delay):
+		}
+	}
+}
+
+type checkRateLimiter struct {
+	conn         Conn
+	checkFactory CheckFactory
+	limits       CheckRateLimits
+}
+
+func NewCheckRateLimiter(checkFactory CheckFactory, conn Conn, limits CheckRateLimits) CheckRateLimiter {
+	return &checkRateLimiter{
+		conn:         conn,
+		checkFactory: checkFactory,
+		limits:       limits,
+	}
+}
+
+func (limiter *checkRateLimiter) Take(teamID int, resourceType string) (bool, time.Duration, error) {
+	bucket, perMinute, err := limiter.limit(teamID, resourceType)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if perMinute <= 0 {
+		return true, 0, nil
+	}
+
+	return limiter.checkFactory.TakeCheckToken(bucket, perMinute)
+}
+
+// limit returns the bucket a check of the given type by the team takes its
+// token from, and the limit of the bucket. The team's name is only looked up
+// if some team has a limit of its own for the type.
+func (limiter *checkRateLimiter) limit(teamID int, resourceType string) (string, int, error) {
+	teamLimited := false
+	for bucket := range limiter.limits {
+		if strings.HasSuffix(bucket, "/"+resourceType) {
+			teamLimited = true
+			break
+		}
+	}
+
+	if teamLimited {
+		var teamName string
+		err := psql.Select("name").
+			From("teams").
+			Where(sq.Eq{"id": teamID}).
+			RunWith(limiter.conn).
+			QueryRow().
+			Scan(&teamName)
+		if err != nil {
+			return "", 0, err
+		}
+
+		bucket := teamName + "/" + resourceType
+		if perMinute, found := limiter.limits[bucket]; found {
+			return bucket, perMinute, nil
+		}
+	}
+
+	return resourceType, limiter.limits[resourceType], nil
+}
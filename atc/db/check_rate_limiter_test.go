@@ -0,0 +1,70 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CheckRateLimiter", func() {
+	var (
+		limits  db.CheckRateLimits
+		limiter db.CheckRateLimiter
+	)
+
+	JustBeforeEach(func() {
+		limiter = db.NewCheckRateLimiter(db.NewCheckFactory(dbConn, lockFactory), dbConn, limits)
+	})
+
+	Context("when the type has no limit", func() {
+		BeforeEach(func() {
+			limits = db.CheckRateLimits{"other-type": 1}
+		})
+
+		It("always takes a token", func() {
+			for i := 0; i < 3; i++ {
+				taken, _, err := limiter.Take(defaultTeam.ID(), "some-type")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taken).To(BeTrue())
+			}
+		})
+	})
+
+	Context("when the type has a limit", func() {
+		BeforeEach(func() {
+			limits = db.CheckRateLimits{"some-type": 1}
+		})
+
+		It("takes up to the limit of tokens", func() {
+			taken, _, err := limiter.Take(defaultTeam.ID(), "some-type")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeTrue())
+
+			taken, wait, err := limiter.Take(defaultTeam.ID(), "some-type")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(taken).To(BeFalse())
+			Expect(wait).To(BeNumerically("~", time.Minute, time.Second))
+		})
+
+		Context("when the team has its own limit", func() {
+			BeforeEach(func() {
+				limits = db.CheckRateLimits{"some-type": 1, "default-team/some-type": 2}
+			})
+
+			It("takes tokens from the team's bucket instead", func() {
+				for i := 0; i < 2; i++ {
+					taken, _, err := limiter.Take(defaultTeam.ID(), "some-type")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(taken).To(BeTrue())
+				}
+
+				taken, _, err := limiter.Take(defaultTeam.ID(), "some-type")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(taken).To(BeFalse())
+			})
+		})
+	})
+})
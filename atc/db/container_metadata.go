@@ -18,6 +18,7 @@ type ContainerMetadata struct {
 	PipelineName string
 	JobName      string
 	BuildName    string
+	TeamName     string
 }
 
 type ContainerType string
@@ -91,6 +92,10 @@ func (metadata ContainerMetadata) SQLMap() map[string]interface{} {
 		m["meta_build_name"] = metadata.BuildName
 	}
 
+	if metadata.TeamName != "" {
+		m["meta_team_name"] = metadata.TeamName
+	}
+
 	return m
 }
 
@@ -106,6 +111,7 @@ var containerMetadataColumns = []string{
 	"meta_pipeline_name",
 	"meta_job_name",
 	"meta_build_name",
+	"meta_team_name",
 }
 
 func (metadata *ContainerMetadata) ScanTargets() []interface{} {
@@ -121,5 +127,6 @@ func (metadata *ContainerMetadata) ScanTargets() []interface{} {
 		&metadata.PipelineName,
 		&metadata.JobName,
 		&metadata.BuildName,
+		&metadata.TeamName,
 	}
 }
@@ -42,6 +42,71 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AnnotateStub        func(map[string]string) error
+	annotateMutex       sync.RWMutex
+	annotateArgsForCall []struct {
+		arg1 map[string]string
+	}
+	annotateReturns struct {
+		result1 error
+	}
+	annotateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AnnotationsStub        func() map[string]string
+	annotationsMutex       sync.RWMutex
+	annotationsArgsForCall []struct {
+	}
+	annotationsReturns struct {
+		result1 map[string]string
+	}
+	annotationsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
+	ArchiveEventsStub        func() error
+	archiveEventsMutex       sync.RWMutex
+	archiveEventsArgsForCall []struct {
+	}
+	archiveEventsReturns struct {
+		result1 error
+	}
+	archiveEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CommentStub        func(string, string) (db.BuildComment, error)
+	commentMutex       sync.RWMutex
+	commentArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	commentReturns struct {
+		result1 db.BuildComment
+		result2 error
+	}
+	commentReturnsOnCall map[int]struct {
+		result1 db.BuildComment
+		result2 error
+	}
+	CommentsStub        func() []db.BuildComment
+	commentsMutex       sync.RWMutex
+	commentsArgsForCall []struct {
+	}
+	commentsReturns struct {
+		result1 []db.BuildComment
+	}
+	commentsReturnsOnCall map[int]struct {
+		result1 []db.BuildComment
+	}
+	CreateTimeStub        func() time.Time
+	createTimeMutex       sync.RWMutex
+	createTimeArgsForCall []struct {
+	}
+	createTimeReturns struct {
+		result1 time.Time
+	}
+	createTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -129,6 +194,18 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputsStub        func() ([]db.BuildInput, error)
+	inputsMutex       sync.RWMutex
+	inputsArgsForCall []struct {
+	}
+	inputsReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	inputsReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
 	InterceptibleStub        func() (bool, error)
 	interceptibleMutex       sync.RWMutex
 	interceptibleArgsForCall []struct {
@@ -211,6 +288,16 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkAsTimedOutStub        func() error
+	markAsTimedOutMutex       sync.RWMutex
+	markAsTimedOutArgsForCall []struct {
+	}
+	markAsTimedOutReturns struct {
+		result1 error
+	}
+	markAsTimedOutReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -245,6 +332,16 @@ type FakeBuild struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -301,6 +398,26 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	RerunOfStub        func() int
+	rerunOfMutex       sync.RWMutex
+	rerunOfArgsForCall []struct {
+	}
+	rerunOfReturns struct {
+		result1 int
+	}
+	rerunOfReturnsOnCall map[int]struct {
+		result1 int
+	}
+	RerunOfNameStub        func() string
+	rerunOfNameMutex       sync.RWMutex
+	rerunOfNameArgsForCall []struct {
+	}
+	rerunOfNameReturns struct {
+		result1 string
+	}
+	rerunOfNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -355,6 +472,17 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SaveTestResultsStub        func([]atc.TestResult) error
+	saveTestResultsMutex       sync.RWMutex
+	saveTestResultsArgsForCall []struct {
+		arg1 []atc.TestResult
+	}
+	saveTestResultsReturns struct {
+		result1 error
+	}
+	saveTestResultsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ScheduleStub        func() (bool, error)
 	scheduleMutex       sync.RWMutex
 	scheduleArgsForCall []struct {
@@ -444,6 +572,28 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TestResultsStub        func() ([]atc.TestResult, error)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+	}
+	testResultsReturns struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	testResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 error
+	}
+	TestSummaryStub        func() *atc.TestSummary
+	testSummaryMutex       sync.RWMutex
+	testSummaryArgsForCall []struct {
+	}
+	testSummaryReturns struct {
+		result1 *atc.TestSummary
+	}
+	testSummaryReturnsOnCall map[int]struct {
+		result1 *atc.TestSummary
+	}
 	TrackedByStub        func(string) error
 	trackedByMutex       sync.RWMutex
 	trackedByArgsForCall []struct {
@@ -602,6 +752,338 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Annotate(arg1 map[string]string) error {
+	fake.annotateMutex.Lock()
+	ret, specificReturn := fake.annotateReturnsOnCall[len(fake.annotateArgsForCall)]
+	fake.annotateArgsForCall = append(fake.annotateArgsForCall, struct {
+		arg1 map[string]string
+	}{arg1})
+	fake.recordInvocation("Annotate", []interface{}{arg1})
+	fake.annotateMutex.Unlock()
+	if fake.AnnotateStub != nil {
+		return fake.AnnotateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.annotateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AnnotateCallCount() int {
+	fake.annotateMutex.RLock()
+	defer fake.annotateMutex.RUnlock()
+	return len(fake.annotateArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotateCalls(stub func(map[string]string) error) {
+	fake.annotateMutex.Lock()
+	defer fake.annotateMutex.Unlock()
+	fake.AnnotateStub = stub
+}
+
+func (fake *FakeBuild) AnnotateArgsForCall(i int) map[string]string {
+	fake.annotateMutex.RLock()
+	defer fake.annotateMutex.RUnlock()
+	argsForCall := fake.annotateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) AnnotateReturns(result1 error) {
+	fake.annotateMutex.Lock()
+	defer fake.annotateMutex.Unlock()
+	fake.AnnotateStub = nil
+	fake.annotateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) AnnotateReturnsOnCall(i int, result1 error) {
+	fake.annotateMutex.Lock()
+	defer fake.annotateMutex.Unlock()
+	fake.AnnotateStub = nil
+	if fake.annotateReturnsOnCall == nil {
+		fake.annotateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.annotateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) Annotations() map[string]string {
+	fake.annotationsMutex.Lock()
+	ret, specificReturn := fake.annotationsReturnsOnCall[len(fake.annotationsArgsForCall)]
+	fake.annotationsArgsForCall = append(fake.annotationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Annotations", []interface{}{})
+	fake.annotationsMutex.Unlock()
+	if fake.AnnotationsStub != nil {
+		return fake.AnnotationsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.annotationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) AnnotationsCallCount() int {
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	return len(fake.annotationsArgsForCall)
+}
+
+func (fake *FakeBuild) AnnotationsCalls(stub func() map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = stub
+}
+
+func (fake *FakeBuild) AnnotationsReturns(result1 map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	fake.annotationsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeBuild) AnnotationsReturnsOnCall(i int, result1 map[string]string) {
+	fake.annotationsMutex.Lock()
+	defer fake.annotationsMutex.Unlock()
+	fake.AnnotationsStub = nil
+	if fake.annotationsReturnsOnCall == nil {
+		fake.annotationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.annotationsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeBuild) ArchiveEvents() error {
+	fake.archiveEventsMutex.Lock()
+	ret, specificReturn := fake.archiveEventsReturnsOnCall[len(fake.archiveEventsArgsForCall)]
+	fake.archiveEventsArgsForCall = append(fake.archiveEventsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ArchiveEvents", []interface{}{})
+	fake.archiveEventsMutex.Unlock()
+	if fake.ArchiveEventsStub != nil {
+		return fake.ArchiveEventsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.archiveEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ArchiveEventsCallCount() int {
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	return len(fake.archiveEventsArgsForCall)
+}
+
+func (fake *FakeBuild) ArchiveEventsCalls(stub func() error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = stub
+}
+
+func (fake *FakeBuild) ArchiveEventsReturns(result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	fake.archiveEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ArchiveEventsReturnsOnCall(i int, result1 error) {
+	fake.archiveEventsMutex.Lock()
+	defer fake.archiveEventsMutex.Unlock()
+	fake.ArchiveEventsStub = nil
+	if fake.archiveEventsReturnsOnCall == nil {
+		fake.archiveEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.archiveEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) Comment(arg1 string, arg2 string) (db.BuildComment, error) {
+	fake.commentMutex.Lock()
+	ret, specificReturn := fake.commentReturnsOnCall[len(fake.commentArgsForCall)]
+	fake.commentArgsForCall = append(fake.commentArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Comment", []interface{}{arg1, arg2})
+	fake.commentMutex.Unlock()
+	if fake.CommentStub != nil {
+		return fake.CommentStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.commentReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) CommentCallCount() int {
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	return len(fake.commentArgsForCall)
+}
+
+func (fake *FakeBuild) CommentCalls(stub func(string, string) (db.BuildComment, error)) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = stub
+}
+
+func (fake *FakeBuild) CommentArgsForCall(i int) (string, string) {
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	argsForCall := fake.commentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) CommentReturns(result1 db.BuildComment, result2 error) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = nil
+	fake.commentReturns = struct {
+		result1 db.BuildComment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) CommentReturnsOnCall(i int, result1 db.BuildComment, result2 error) {
+	fake.commentMutex.Lock()
+	defer fake.commentMutex.Unlock()
+	fake.CommentStub = nil
+	if fake.commentReturnsOnCall == nil {
+		fake.commentReturnsOnCall = make(map[int]struct {
+			result1 db.BuildComment
+			result2 error
+		})
+	}
+	fake.commentReturnsOnCall[i] = struct {
+		result1 db.BuildComment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) Comments() []db.BuildComment {
+	fake.commentsMutex.Lock()
+	ret, specificReturn := fake.commentsReturnsOnCall[len(fake.commentsArgsForCall)]
+	fake.commentsArgsForCall = append(fake.commentsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Comments", []interface{}{})
+	fake.commentsMutex.Unlock()
+	if fake.CommentsStub != nil {
+		return fake.CommentsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.commentsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CommentsCallCount() int {
+	fake.commentsMutex.RLock()
+	defer fake.commentsMutex.RUnlock()
+	return len(fake.commentsArgsForCall)
+}
+
+func (fake *FakeBuild) CommentsCalls(stub func() []db.BuildComment) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = stub
+}
+
+func (fake *FakeBuild) CommentsReturns(result1 []db.BuildComment) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = nil
+	fake.commentsReturns = struct {
+		result1 []db.BuildComment
+	}{result1}
+}
+
+func (fake *FakeBuild) CommentsReturnsOnCall(i int, result1 []db.BuildComment) {
+	fake.commentsMutex.Lock()
+	defer fake.commentsMutex.Unlock()
+	fake.CommentsStub = nil
+	if fake.commentsReturnsOnCall == nil {
+		fake.commentsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildComment
+		})
+	}
+	fake.commentsReturnsOnCall[i] = struct {
+		result1 []db.BuildComment
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTime() time.Time {
+	fake.createTimeMutex.Lock()
+	ret, specificReturn := fake.createTimeReturnsOnCall[len(fake.createTimeArgsForCall)]
+	fake.createTimeArgsForCall = append(fake.createTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CreateTime", []interface{}{})
+	fake.createTimeMutex.Unlock()
+	if fake.CreateTimeStub != nil {
+		return fake.CreateTimeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createTimeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) CreateTimeCallCount() int {
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
+	return len(fake.createTimeArgsForCall)
+}
+
+func (fake *FakeBuild) CreateTimeCalls(stub func() time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = stub
+}
+
+func (fake *FakeBuild) CreateTimeReturns(result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	fake.createTimeReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) CreateTimeReturnsOnCall(i int, result1 time.Time) {
+	fake.createTimeMutex.Lock()
+	defer fake.createTimeMutex.Unlock()
+	fake.CreateTimeStub = nil
+	if fake.createTimeReturnsOnCall == nil {
+		fake.createTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.createTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1048,6 +1530,61 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) Inputs() ([]db.BuildInput, error) {
+	fake.inputsMutex.Lock()
+	ret, specificReturn := fake.inputsReturnsOnCall[len(fake.inputsArgsForCall)]
+	fake.inputsArgsForCall = append(fake.inputsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Inputs", []interface{}{})
+	fake.inputsMutex.Unlock()
+	if fake.InputsStub != nil {
+		return fake.InputsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.inputsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) InputsCallCount() int {
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
+	return len(fake.inputsArgsForCall)
+}
+
+func (fake *FakeBuild) InputsCalls(stub func() ([]db.BuildInput, error)) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = stub
+}
+
+func (fake *FakeBuild) InputsReturns(result1 []db.BuildInput, result2 error) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = nil
+	fake.inputsReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) InputsReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.inputsMutex.Lock()
+	defer fake.inputsMutex.Unlock()
+	fake.InputsStub = nil
+	if fake.inputsReturnsOnCall == nil {
+		fake.inputsReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.inputsReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Interceptible() (bool, error) {
 	fake.interceptibleMutex.Lock()
 	ret, specificReturn := fake.interceptibleReturnsOnCall[len(fake.interceptibleArgsForCall)]
@@ -1467,6 +2004,58 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MarkAsTimedOut() error {
+	fake.markAsTimedOutMutex.Lock()
+	ret, specificReturn := fake.markAsTimedOutReturnsOnCall[len(fake.markAsTimedOutArgsForCall)]
+	fake.markAsTimedOutArgsForCall = append(fake.markAsTimedOutArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MarkAsTimedOut", []interface{}{})
+	fake.markAsTimedOutMutex.Unlock()
+	if fake.MarkAsTimedOutStub != nil {
+		return fake.MarkAsTimedOutStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markAsTimedOutReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MarkAsTimedOutCallCount() int {
+	fake.markAsTimedOutMutex.RLock()
+	defer fake.markAsTimedOutMutex.RUnlock()
+	return len(fake.markAsTimedOutArgsForCall)
+}
+
+func (fake *FakeBuild) MarkAsTimedOutCalls(stub func() error) {
+	fake.markAsTimedOutMutex.Lock()
+	defer fake.markAsTimedOutMutex.Unlock()
+	fake.MarkAsTimedOutStub = stub
+}
+
+func (fake *FakeBuild) MarkAsTimedOutReturns(result1 error) {
+	fake.markAsTimedOutMutex.Lock()
+	defer fake.markAsTimedOutMutex.Unlock()
+	fake.MarkAsTimedOutStub = nil
+	fake.markAsTimedOutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) MarkAsTimedOutReturnsOnCall(i int, result1 error) {
+	fake.markAsTimedOutMutex.Lock()
+	defer fake.markAsTimedOutMutex.Unlock()
+	fake.MarkAsTimedOutStub = nil
+	if fake.markAsTimedOutReturnsOnCall == nil {
+		fake.markAsTimedOutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markAsTimedOutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1629,6 +2218,58 @@ func (fake *FakeBuild) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeBuild) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -1898,6 +2539,110 @@ func (fake *FakeBuild) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) RerunOf() int {
+	fake.rerunOfMutex.Lock()
+	ret, specificReturn := fake.rerunOfReturnsOnCall[len(fake.rerunOfArgsForCall)]
+	fake.rerunOfArgsForCall = append(fake.rerunOfArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOf", []interface{}{})
+	fake.rerunOfMutex.Unlock()
+	if fake.RerunOfStub != nil {
+		return fake.RerunOfStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfCallCount() int {
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	return len(fake.rerunOfArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfCalls(stub func() int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = stub
+}
+
+func (fake *FakeBuild) RerunOfReturns(result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	fake.rerunOfReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfReturnsOnCall(i int, result1 int) {
+	fake.rerunOfMutex.Lock()
+	defer fake.rerunOfMutex.Unlock()
+	fake.RerunOfStub = nil
+	if fake.rerunOfReturnsOnCall == nil {
+		fake.rerunOfReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.rerunOfReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfName() string {
+	fake.rerunOfNameMutex.Lock()
+	ret, specificReturn := fake.rerunOfNameReturnsOnCall[len(fake.rerunOfNameArgsForCall)]
+	fake.rerunOfNameArgsForCall = append(fake.rerunOfNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RerunOfName", []interface{}{})
+	fake.rerunOfNameMutex.Unlock()
+	if fake.RerunOfNameStub != nil {
+		return fake.RerunOfNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rerunOfNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) RerunOfNameCallCount() int {
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
+	return len(fake.rerunOfNameArgsForCall)
+}
+
+func (fake *FakeBuild) RerunOfNameCalls(stub func() string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = stub
+}
+
+func (fake *FakeBuild) RerunOfNameReturns(result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	fake.rerunOfNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeBuild) RerunOfNameReturnsOnCall(i int, result1 string) {
+	fake.rerunOfNameMutex.Lock()
+	defer fake.rerunOfNameMutex.Unlock()
+	fake.RerunOfNameStub = nil
+	if fake.rerunOfNameReturnsOnCall == nil {
+		fake.rerunOfNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.rerunOfNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -2143,6 +2888,71 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SaveTestResults(arg1 []atc.TestResult) error {
+	var arg1Copy []atc.TestResult
+	if arg1 != nil {
+		arg1Copy = make([]atc.TestResult, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.saveTestResultsMutex.Lock()
+	ret, specificReturn := fake.saveTestResultsReturnsOnCall[len(fake.saveTestResultsArgsForCall)]
+	fake.saveTestResultsArgsForCall = append(fake.saveTestResultsArgsForCall, struct {
+		arg1 []atc.TestResult
+	}{arg1Copy})
+	fake.recordInvocation("SaveTestResults", []interface{}{arg1Copy})
+	fake.saveTestResultsMutex.Unlock()
+	if fake.SaveTestResultsStub != nil {
+		return fake.SaveTestResultsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveTestResultsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveTestResultsCallCount() int {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	return len(fake.saveTestResultsArgsForCall)
+}
+
+func (fake *FakeBuild) SaveTestResultsCalls(stub func([]atc.TestResult) error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = stub
+}
+
+func (fake *FakeBuild) SaveTestResultsArgsForCall(i int) []atc.TestResult {
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
+	argsForCall := fake.saveTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveTestResultsReturns(result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	fake.saveTestResultsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveTestResultsReturnsOnCall(i int, result1 error) {
+	fake.saveTestResultsMutex.Lock()
+	defer fake.saveTestResultsMutex.Unlock()
+	fake.SaveTestResultsStub = nil
+	if fake.saveTestResultsReturnsOnCall == nil {
+		fake.saveTestResultsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTestResultsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schedule() (bool, error) {
 	fake.scheduleMutex.Lock()
 	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
@@ -2591,6 +3401,113 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TestResults() ([]atc.TestResult, error) {
+	fake.testResultsMutex.Lock()
+	ret, specificReturn := fake.testResultsReturnsOnCall[len(fake.testResultsArgsForCall)]
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TestResults", []interface{}{})
+	fake.testResultsMutex.Unlock()
+	if fake.TestResultsStub != nil {
+		return fake.TestResultsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.testResultsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeBuild) TestResultsCalls(stub func() ([]atc.TestResult, error)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeBuild) TestResultsReturns(result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	fake.testResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 error) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = nil
+	if fake.testResultsReturnsOnCall == nil {
+		fake.testResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 error
+		})
+	}
+	fake.testResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) TestSummary() *atc.TestSummary {
+	fake.testSummaryMutex.Lock()
+	ret, specificReturn := fake.testSummaryReturnsOnCall[len(fake.testSummaryArgsForCall)]
+	fake.testSummaryArgsForCall = append(fake.testSummaryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TestSummary", []interface{}{})
+	fake.testSummaryMutex.Unlock()
+	if fake.TestSummaryStub != nil {
+		return fake.TestSummaryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.testSummaryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TestSummaryCallCount() int {
+	fake.testSummaryMutex.RLock()
+	defer fake.testSummaryMutex.RUnlock()
+	return len(fake.testSummaryArgsForCall)
+}
+
+func (fake *FakeBuild) TestSummaryCalls(stub func() *atc.TestSummary) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = stub
+}
+
+func (fake *FakeBuild) TestSummaryReturns(result1 *atc.TestSummary) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = nil
+	fake.testSummaryReturns = struct {
+		result1 *atc.TestSummary
+	}{result1}
+}
+
+func (fake *FakeBuild) TestSummaryReturnsOnCall(i int, result1 *atc.TestSummary) {
+	fake.testSummaryMutex.Lock()
+	defer fake.testSummaryMutex.Unlock()
+	fake.TestSummaryStub = nil
+	if fake.testSummaryReturnsOnCall == nil {
+		fake.testSummaryReturnsOnCall = make(map[int]struct {
+			result1 *atc.TestSummary
+		})
+	}
+	fake.testSummaryReturnsOnCall[i] = struct {
+		result1 *atc.TestSummary
+	}{result1}
+}
+
 func (fake *FakeBuild) TrackedBy(arg1 string) error {
 	fake.trackedByMutex.Lock()
 	ret, specificReturn := fake.trackedByReturnsOnCall[len(fake.trackedByArgsForCall)]
@@ -2775,6 +3692,18 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.annotateMutex.RLock()
+	defer fake.annotateMutex.RUnlock()
+	fake.annotationsMutex.RLock()
+	defer fake.annotationsMutex.RUnlock()
+	fake.archiveEventsMutex.RLock()
+	defer fake.archiveEventsMutex.RUnlock()
+	fake.commentMutex.RLock()
+	defer fake.commentMutex.RUnlock()
+	fake.commentsMutex.RLock()
+	defer fake.commentsMutex.RUnlock()
+	fake.createTimeMutex.RLock()
+	defer fake.createTimeMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -2791,6 +3720,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.finishWithErrorMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputsMutex.RLock()
+	defer fake.inputsMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
 	defer fake.interceptibleMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
@@ -2807,12 +3738,16 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobNameMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.markAsTimedOutMutex.RLock()
+	defer fake.markAsTimedOutMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.preparationMutex.RLock()
@@ -2823,6 +3758,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.reapTimeMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunOfMutex.RLock()
+	defer fake.rerunOfMutex.RUnlock()
+	fake.rerunOfNameMutex.RLock()
+	defer fake.rerunOfNameMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
 	fake.saveEventMutex.RLock()
@@ -2831,6 +3770,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveImageResourceVersionMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
 	defer fake.saveOutputMutex.RUnlock()
+	fake.saveTestResultsMutex.RLock()
+	defer fake.saveTestResultsMutex.RUnlock()
 	fake.scheduleMutex.RLock()
 	defer fake.scheduleMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
@@ -2847,6 +3788,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	fake.testSummaryMutex.RLock()
+	defer fake.testSummaryMutex.RUnlock()
 	fake.trackedByMutex.RLock()
 	defer fake.trackedByMutex.RUnlock()
 	fake.trackerMutex.RLock()
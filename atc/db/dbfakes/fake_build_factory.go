@@ -35,6 +35,19 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetCompletedBuildsWithUnarchivedEventsStub        func(int) ([]db.Build, error)
+	getCompletedBuildsWithUnarchivedEventsMutex       sync.RWMutex
+	getCompletedBuildsWithUnarchivedEventsArgsForCall []struct {
+		arg1 int
+	}
+	getCompletedBuildsWithUnarchivedEventsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getCompletedBuildsWithUnarchivedEventsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	GetDrainableBuildsStub        func() ([]db.Build, error)
 	getDrainableBuildsMutex       sync.RWMutex
 	getDrainableBuildsArgsForCall []struct {
@@ -229,6 +242,69 @@ func (fake *FakeBuildFactory) GetAllStartedBuildsReturnsOnCall(i int, result1 []
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEvents(arg1 int) ([]db.Build, error) {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.Lock()
+	ret, specificReturn := fake.getCompletedBuildsWithUnarchivedEventsReturnsOnCall[len(fake.getCompletedBuildsWithUnarchivedEventsArgsForCall)]
+	fake.getCompletedBuildsWithUnarchivedEventsArgsForCall = append(fake.getCompletedBuildsWithUnarchivedEventsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("GetCompletedBuildsWithUnarchivedEvents", []interface{}{arg1})
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.Unlock()
+	if fake.GetCompletedBuildsWithUnarchivedEventsStub != nil {
+		return fake.GetCompletedBuildsWithUnarchivedEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getCompletedBuildsWithUnarchivedEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEventsCallCount() int {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.RLock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.RUnlock()
+	return len(fake.getCompletedBuildsWithUnarchivedEventsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEventsCalls(stub func(int) ([]db.Build, error)) {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.Lock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.Unlock()
+	fake.GetCompletedBuildsWithUnarchivedEventsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEventsArgsForCall(i int) int {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.RLock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.RUnlock()
+	argsForCall := fake.getCompletedBuildsWithUnarchivedEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEventsReturns(result1 []db.Build, result2 error) {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.Lock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.Unlock()
+	fake.GetCompletedBuildsWithUnarchivedEventsStub = nil
+	fake.getCompletedBuildsWithUnarchivedEventsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetCompletedBuildsWithUnarchivedEventsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.Lock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.Unlock()
+	fake.GetCompletedBuildsWithUnarchivedEventsStub = nil
+	if fake.getCompletedBuildsWithUnarchivedEventsReturnsOnCall == nil {
+		fake.getCompletedBuildsWithUnarchivedEventsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getCompletedBuildsWithUnarchivedEventsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) GetDrainableBuilds() ([]db.Build, error) {
 	fake.getDrainableBuildsMutex.Lock()
 	ret, specificReturn := fake.getDrainableBuildsReturnsOnCall[len(fake.getDrainableBuildsArgsForCall)]
@@ -553,6 +629,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.getAllStartedBuildsMutex.RLock()
 	defer fake.getAllStartedBuildsMutex.RUnlock()
+	fake.getCompletedBuildsWithUnarchivedEventsMutex.RLock()
+	defer fake.getCompletedBuildsWithUnarchivedEventsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
@@ -3,6 +3,7 @@ package dbfakes
 
 import (
 	sync "sync"
+	time "time"
 
 	atc "github.com/concourse/concourse/atc"
 	db "github.com/concourse/concourse/atc/db"
@@ -135,6 +136,19 @@ type FakeJob struct {
 	firstLoggedBuildIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	FlakyTestsStub        func(int) ([]atc.FlakyTest, error)
+	flakyTestsMutex       sync.RWMutex
+	flakyTestsArgsForCall []struct {
+		arg1 int
+	}
+	flakyTestsReturns struct {
+		result1 []atc.FlakyTest
+		result2 error
+	}
+	flakyTestsReturnsOnCall map[int]struct {
+		result1 []atc.FlakyTest
+		result2 error
+	}
 	GetIndependentBuildInputsStub        func() ([]db.BuildInput, error)
 	getIndependentBuildInputsMutex       sync.RWMutex
 	getIndependentBuildInputsArgsForCall []struct {
@@ -221,6 +235,20 @@ type FakeJob struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NextBuildInputsDeterminedTimeStub        func() (time.Time, bool, error)
+	nextBuildInputsDeterminedTimeMutex       sync.RWMutex
+	nextBuildInputsDeterminedTimeArgsForCall []struct {
+	}
+	nextBuildInputsDeterminedTimeReturns struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}
+	nextBuildInputsDeterminedTimeReturnsOnCall map[int]struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}
 	PauseStub        func() error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
@@ -251,6 +279,16 @@ type FakeJob struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -273,6 +311,19 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	RerunBuildStub        func(db.Build) (db.Build, error)
+	rerunBuildMutex       sync.RWMutex
+	rerunBuildArgsForCall []struct {
+		arg1 db.Build
+	}
+	rerunBuildReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	rerunBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	SaveIndependentInputMappingStub        func(algorithm.InputMapping) error
 	saveIndependentInputMappingMutex       sync.RWMutex
 	saveIndependentInputMappingArgsForCall []struct {
@@ -944,6 +995,69 @@ func (fake *FakeJob) FirstLoggedBuildIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) FlakyTests(arg1 int) ([]atc.FlakyTest, error) {
+	fake.flakyTestsMutex.Lock()
+	ret, specificReturn := fake.flakyTestsReturnsOnCall[len(fake.flakyTestsArgsForCall)]
+	fake.flakyTestsArgsForCall = append(fake.flakyTestsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("FlakyTests", []interface{}{arg1})
+	fake.flakyTestsMutex.Unlock()
+	if fake.FlakyTestsStub != nil {
+		return fake.FlakyTestsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.flakyTestsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) FlakyTestsCallCount() int {
+	fake.flakyTestsMutex.RLock()
+	defer fake.flakyTestsMutex.RUnlock()
+	return len(fake.flakyTestsArgsForCall)
+}
+
+func (fake *FakeJob) FlakyTestsCalls(stub func(int) ([]atc.FlakyTest, error)) {
+	fake.flakyTestsMutex.Lock()
+	defer fake.flakyTestsMutex.Unlock()
+	fake.FlakyTestsStub = stub
+}
+
+func (fake *FakeJob) FlakyTestsArgsForCall(i int) int {
+	fake.flakyTestsMutex.RLock()
+	defer fake.flakyTestsMutex.RUnlock()
+	argsForCall := fake.flakyTestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) FlakyTestsReturns(result1 []atc.FlakyTest, result2 error) {
+	fake.flakyTestsMutex.Lock()
+	defer fake.flakyTestsMutex.Unlock()
+	fake.FlakyTestsStub = nil
+	fake.flakyTestsReturns = struct {
+		result1 []atc.FlakyTest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) FlakyTestsReturnsOnCall(i int, result1 []atc.FlakyTest, result2 error) {
+	fake.flakyTestsMutex.Lock()
+	defer fake.flakyTestsMutex.Unlock()
+	fake.FlakyTestsStub = nil
+	if fake.flakyTestsReturnsOnCall == nil {
+		fake.flakyTestsReturnsOnCall = make(map[int]struct {
+			result1 []atc.FlakyTest
+			result2 error
+		})
+	}
+	fake.flakyTestsReturnsOnCall[i] = struct {
+		result1 []atc.FlakyTest
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) GetIndependentBuildInputs() ([]db.BuildInput, error) {
 	fake.getIndependentBuildInputsMutex.Lock()
 	ret, specificReturn := fake.getIndependentBuildInputsReturnsOnCall[len(fake.getIndependentBuildInputsArgsForCall)]
@@ -1355,6 +1469,64 @@ func (fake *FakeJob) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) NextBuildInputsDeterminedTime() (time.Time, bool, error) {
+	fake.nextBuildInputsDeterminedTimeMutex.Lock()
+	ret, specificReturn := fake.nextBuildInputsDeterminedTimeReturnsOnCall[len(fake.nextBuildInputsDeterminedTimeArgsForCall)]
+	fake.nextBuildInputsDeterminedTimeArgsForCall = append(fake.nextBuildInputsDeterminedTimeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("NextBuildInputsDeterminedTime", []interface{}{})
+	fake.nextBuildInputsDeterminedTimeMutex.Unlock()
+	if fake.NextBuildInputsDeterminedTimeStub != nil {
+		return fake.NextBuildInputsDeterminedTimeStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.nextBuildInputsDeterminedTimeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) NextBuildInputsDeterminedTimeCallCount() int {
+	fake.nextBuildInputsDeterminedTimeMutex.RLock()
+	defer fake.nextBuildInputsDeterminedTimeMutex.RUnlock()
+	return len(fake.nextBuildInputsDeterminedTimeArgsForCall)
+}
+
+func (fake *FakeJob) NextBuildInputsDeterminedTimeCalls(stub func() (time.Time, bool, error)) {
+	fake.nextBuildInputsDeterminedTimeMutex.Lock()
+	defer fake.nextBuildInputsDeterminedTimeMutex.Unlock()
+	fake.NextBuildInputsDeterminedTimeStub = stub
+}
+
+func (fake *FakeJob) NextBuildInputsDeterminedTimeReturns(result1 time.Time, result2 bool, result3 error) {
+	fake.nextBuildInputsDeterminedTimeMutex.Lock()
+	defer fake.nextBuildInputsDeterminedTimeMutex.Unlock()
+	fake.NextBuildInputsDeterminedTimeStub = nil
+	fake.nextBuildInputsDeterminedTimeReturns = struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) NextBuildInputsDeterminedTimeReturnsOnCall(i int, result1 time.Time, result2 bool, result3 error) {
+	fake.nextBuildInputsDeterminedTimeMutex.Lock()
+	defer fake.nextBuildInputsDeterminedTimeMutex.Unlock()
+	fake.NextBuildInputsDeterminedTimeStub = nil
+	if fake.nextBuildInputsDeterminedTimeReturnsOnCall == nil {
+		fake.nextBuildInputsDeterminedTimeReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+			result2 bool
+			result3 error
+		})
+	}
+	fake.nextBuildInputsDeterminedTimeReturnsOnCall[i] = struct {
+		result1 time.Time
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) Pause() error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
@@ -1511,6 +1683,58 @@ func (fake *FakeJob) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeJob) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -1618,6 +1842,69 @@ func (fake *FakeJob) ReloadReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeJob) RerunBuild(arg1 db.Build) (db.Build, error) {
+	fake.rerunBuildMutex.Lock()
+	ret, specificReturn := fake.rerunBuildReturnsOnCall[len(fake.rerunBuildArgsForCall)]
+	fake.rerunBuildArgsForCall = append(fake.rerunBuildArgsForCall, struct {
+		arg1 db.Build
+	}{arg1})
+	fake.recordInvocation("RerunBuild", []interface{}{arg1})
+	fake.rerunBuildMutex.Unlock()
+	if fake.RerunBuildStub != nil {
+		return fake.RerunBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.rerunBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) RerunBuildCallCount() int {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	return len(fake.rerunBuildArgsForCall)
+}
+
+func (fake *FakeJob) RerunBuildCalls(stub func(db.Build) (db.Build, error)) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = stub
+}
+
+func (fake *FakeJob) RerunBuildArgsForCall(i int) db.Build {
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
+	argsForCall := fake.rerunBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) RerunBuildReturns(result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	fake.rerunBuildReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) RerunBuildReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.rerunBuildMutex.Lock()
+	defer fake.rerunBuildMutex.Unlock()
+	fake.RerunBuildStub = nil
+	if fake.rerunBuildReturnsOnCall == nil {
+		fake.rerunBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.rerunBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SaveIndependentInputMapping(arg1 algorithm.InputMapping) error {
 	fake.saveIndependentInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveIndependentInputMappingReturnsOnCall[len(fake.saveIndependentInputMappingArgsForCall)]
@@ -2089,6 +2376,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.finishedAndNextBuildMutex.RUnlock()
 	fake.firstLoggedBuildIDMutex.RLock()
 	defer fake.firstLoggedBuildIDMutex.RUnlock()
+	fake.flakyTestsMutex.RLock()
+	defer fake.flakyTestsMutex.RUnlock()
 	fake.getIndependentBuildInputsMutex.RLock()
 	defer fake.getIndependentBuildInputsMutex.RUnlock()
 	fake.getNextBuildInputsMutex.RLock()
@@ -2103,16 +2392,22 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.iDMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.nextBuildInputsDeterminedTimeMutex.RLock()
+	defer fake.nextBuildInputsDeterminedTimeMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.rerunBuildMutex.RLock()
+	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveIndependentInputMappingMutex.RLock()
 	defer fake.saveIndependentInputMappingMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
@@ -52,6 +52,18 @@ type FakeResource struct {
 	checkTimeoutReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ChecksStub        func() ([]db.Check, error)
+	checksMutex       sync.RWMutex
+	checksArgsForCall []struct {
+	}
+	checksReturns struct {
+		result1 []db.Check
+		result2 error
+	}
+	checksReturnsOnCall map[int]struct {
+		result1 []db.Check
+		result2 error
+	}
 	ConfigPinnedVersionStub        func() atc.Version
 	configPinnedVersionMutex       sync.RWMutex
 	configPinnedVersionArgsForCall []struct {
@@ -145,6 +157,16 @@ type FakeResource struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -295,6 +317,16 @@ type FakeResource struct {
 		result3 bool
 		result4 error
 	}
+	WebhookStub        func() *atc.WebhookConfig
+	webhookMutex       sync.RWMutex
+	webhookArgsForCall []struct {
+	}
+	webhookReturns struct {
+		result1 *atc.WebhookConfig
+	}
+	webhookReturnsOnCall map[int]struct {
+		result1 *atc.WebhookConfig
+	}
 	WebhookTokenStub        func() string
 	webhookTokenMutex       sync.RWMutex
 	webhookTokenArgsForCall []struct {
@@ -517,6 +549,61 @@ func (fake *FakeResource) CheckTimeoutReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResource) Checks() ([]db.Check, error) {
+	fake.checksMutex.Lock()
+	ret, specificReturn := fake.checksReturnsOnCall[len(fake.checksArgsForCall)]
+	fake.checksArgsForCall = append(fake.checksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Checks", []interface{}{})
+	fake.checksMutex.Unlock()
+	if fake.ChecksStub != nil {
+		return fake.ChecksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) ChecksCallCount() int {
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
+	return len(fake.checksArgsForCall)
+}
+
+func (fake *FakeResource) ChecksCalls(stub func() ([]db.Check, error)) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = stub
+}
+
+func (fake *FakeResource) ChecksReturns(result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	fake.checksReturns = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) ChecksReturnsOnCall(i int, result1 []db.Check, result2 error) {
+	fake.checksMutex.Lock()
+	defer fake.checksMutex.Unlock()
+	fake.ChecksStub = nil
+	if fake.checksReturnsOnCall == nil {
+		fake.checksReturnsOnCall = make(map[int]struct {
+			result1 []db.Check
+			result2 error
+		})
+	}
+	fake.checksReturnsOnCall[i] = struct {
+		result1 []db.Check
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ConfigPinnedVersion() atc.Version {
 	fake.configPinnedVersionMutex.Lock()
 	ret, specificReturn := fake.configPinnedVersionReturnsOnCall[len(fake.configPinnedVersionArgsForCall)]
@@ -1009,6 +1096,58 @@ func (fake *FakeResource) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeResource) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeResource) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeResource) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeResource) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeResource) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -1740,6 +1879,58 @@ func (fake *FakeResource) VersionsReturnsOnCall(i int, result1 []atc.ResourceVer
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeResource) Webhook() *atc.WebhookConfig {
+	fake.webhookMutex.Lock()
+	ret, specificReturn := fake.webhookReturnsOnCall[len(fake.webhookArgsForCall)]
+	fake.webhookArgsForCall = append(fake.webhookArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Webhook", []interface{}{})
+	fake.webhookMutex.Unlock()
+	if fake.WebhookStub != nil {
+		return fake.WebhookStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.webhookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResource) WebhookCallCount() int {
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
+	return len(fake.webhookArgsForCall)
+}
+
+func (fake *FakeResource) WebhookCalls(stub func() *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = stub
+}
+
+func (fake *FakeResource) WebhookReturns(result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	fake.webhookReturns = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
+func (fake *FakeResource) WebhookReturnsOnCall(i int, result1 *atc.WebhookConfig) {
+	fake.webhookMutex.Lock()
+	defer fake.webhookMutex.Unlock()
+	fake.WebhookStub = nil
+	if fake.webhookReturnsOnCall == nil {
+		fake.webhookReturnsOnCall = make(map[int]struct {
+			result1 *atc.WebhookConfig
+		})
+	}
+	fake.webhookReturnsOnCall[i] = struct {
+		result1 *atc.WebhookConfig
+	}{result1}
+}
+
 func (fake *FakeResource) WebhookToken() string {
 	fake.webhookTokenMutex.Lock()
 	ret, specificReturn := fake.webhookTokenReturnsOnCall[len(fake.webhookTokenArgsForCall)]
@@ -1803,6 +1994,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.checkEveryMutex.RUnlock()
 	fake.checkTimeoutMutex.RLock()
 	defer fake.checkTimeoutMutex.RUnlock()
+	fake.checksMutex.RLock()
+	defer fake.checksMutex.RUnlock()
 	fake.configPinnedVersionMutex.RLock()
 	defer fake.configPinnedVersionMutex.RUnlock()
 	fake.currentPinnedVersionMutex.RLock()
@@ -1821,6 +2014,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.pinVersionMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -1847,6 +2042,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.unpinVersionMutex.RUnlock()
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
+	fake.webhookMutex.RLock()
+	defer fake.webhookMutex.RUnlock()
 	fake.webhookTokenMutex.RLock()
 	defer fake.webhookTokenMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
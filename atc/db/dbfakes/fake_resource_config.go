@@ -39,6 +39,16 @@ type FakeResourceConfig struct {
 	checkErrorReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ClearLastCheckedStub        func() error
+	clearLastCheckedMutex       sync.RWMutex
+	clearLastCheckedArgsForCall []struct {
+	}
+	clearLastCheckedReturns struct {
+		result1 error
+	}
+	clearLastCheckedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreatedByBaseResourceTypeStub        func() *db.UsedBaseResourceType
 	createdByBaseResourceTypeMutex       sync.RWMutex
 	createdByBaseResourceTypeArgsForCall []struct {
@@ -268,6 +278,58 @@ func (fake *FakeResourceConfig) CheckErrorReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResourceConfig) ClearLastChecked() error {
+	fake.clearLastCheckedMutex.Lock()
+	ret, specificReturn := fake.clearLastCheckedReturnsOnCall[len(fake.clearLastCheckedArgsForCall)]
+	fake.clearLastCheckedArgsForCall = append(fake.clearLastCheckedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClearLastChecked", []interface{}{})
+	fake.clearLastCheckedMutex.Unlock()
+	if fake.ClearLastCheckedStub != nil {
+		return fake.ClearLastCheckedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clearLastCheckedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceConfig) ClearLastCheckedCallCount() int {
+	fake.clearLastCheckedMutex.RLock()
+	defer fake.clearLastCheckedMutex.RUnlock()
+	return len(fake.clearLastCheckedArgsForCall)
+}
+
+func (fake *FakeResourceConfig) ClearLastCheckedCalls(stub func() error) {
+	fake.clearLastCheckedMutex.Lock()
+	defer fake.clearLastCheckedMutex.Unlock()
+	fake.ClearLastCheckedStub = stub
+}
+
+func (fake *FakeResourceConfig) ClearLastCheckedReturns(result1 error) {
+	fake.clearLastCheckedMutex.Lock()
+	defer fake.clearLastCheckedMutex.Unlock()
+	fake.ClearLastCheckedStub = nil
+	fake.clearLastCheckedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceConfig) ClearLastCheckedReturnsOnCall(i int, result1 error) {
+	fake.clearLastCheckedMutex.Lock()
+	defer fake.clearLastCheckedMutex.Unlock()
+	fake.ClearLastCheckedStub = nil
+	if fake.clearLastCheckedReturnsOnCall == nil {
+		fake.clearLastCheckedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearLastCheckedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceConfig) CreatedByBaseResourceType() *db.UsedBaseResourceType {
 	fake.createdByBaseResourceTypeMutex.Lock()
 	ret, specificReturn := fake.createdByBaseResourceTypeReturnsOnCall[len(fake.createdByBaseResourceTypeArgsForCall)]
@@ -796,6 +858,8 @@ func (fake *FakeResourceConfig) Invocations() map[string][][]interface{} {
 	defer fake.acquireResourceConfigCheckingLockWithIntervalCheckMutex.RUnlock()
 	fake.checkErrorMutex.RLock()
 	defer fake.checkErrorMutex.RUnlock()
+	fake.clearLastCheckedMutex.RLock()
+	defer fake.clearLastCheckedMutex.RUnlock()
 	fake.createdByBaseResourceTypeMutex.RLock()
 	defer fake.createdByBaseResourceTypeMutex.RUnlock()
 	fake.createdByResourceCacheMutex.RLock()
@@ -8,6 +8,20 @@ import (
 )
 
 type FakeResourceFactory struct {
+	ResourcesBySourceFieldStub        func(string, []string) ([]db.Resource, error)
+	resourcesBySourceFieldMutex       sync.RWMutex
+	resourcesBySourceFieldArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	resourcesBySourceFieldReturns struct {
+		result1 []db.Resource
+		result2 error
+	}
+	resourcesBySourceFieldReturnsOnCall map[int]struct {
+		result1 []db.Resource
+		result2 error
+	}
 	VisibleResourcesStub        func([]string) ([]db.Resource, error)
 	visibleResourcesMutex       sync.RWMutex
 	visibleResourcesArgsForCall []struct {
@@ -25,6 +39,75 @@ type FakeResourceFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResourceFactory) ResourcesBySourceField(arg1 string, arg2 []string) ([]db.Resource, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.resourcesBySourceFieldMutex.Lock()
+	ret, specificReturn := fake.resourcesBySourceFieldReturnsOnCall[len(fake.resourcesBySourceFieldArgsForCall)]
+	fake.resourcesBySourceFieldArgsForCall = append(fake.resourcesBySourceFieldArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("ResourcesBySourceField", []interface{}{arg1, arg2Copy})
+	fake.resourcesBySourceFieldMutex.Unlock()
+	if fake.ResourcesBySourceFieldStub != nil {
+		return fake.ResourcesBySourceFieldStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.resourcesBySourceFieldReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceFactory) ResourcesBySourceFieldCallCount() int {
+	fake.resourcesBySourceFieldMutex.RLock()
+	defer fake.resourcesBySourceFieldMutex.RUnlock()
+	return len(fake.resourcesBySourceFieldArgsForCall)
+}
+
+func (fake *FakeResourceFactory) ResourcesBySourceFieldCalls(stub func(string, []string) ([]db.Resource, error)) {
+	fake.resourcesBySourceFieldMutex.Lock()
+	defer fake.resourcesBySourceFieldMutex.Unlock()
+	fake.ResourcesBySourceFieldStub = stub
+}
+
+func (fake *FakeResourceFactory) ResourcesBySourceFieldArgsForCall(i int) (string, []string) {
+	fake.resourcesBySourceFieldMutex.RLock()
+	defer fake.resourcesBySourceFieldMutex.RUnlock()
+	argsForCall := fake.resourcesBySourceFieldArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceFactory) ResourcesBySourceFieldReturns(result1 []db.Resource, result2 error) {
+	fake.resourcesBySourceFieldMutex.Lock()
+	defer fake.resourcesBySourceFieldMutex.Unlock()
+	fake.ResourcesBySourceFieldStub = nil
+	fake.resourcesBySourceFieldReturns = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceFactory) ResourcesBySourceFieldReturnsOnCall(i int, result1 []db.Resource, result2 error) {
+	fake.resourcesBySourceFieldMutex.Lock()
+	defer fake.resourcesBySourceFieldMutex.Unlock()
+	fake.ResourcesBySourceFieldStub = nil
+	if fake.resourcesBySourceFieldReturnsOnCall == nil {
+		fake.resourcesBySourceFieldReturnsOnCall = make(map[int]struct {
+			result1 []db.Resource
+			result2 error
+		})
+	}
+	fake.resourcesBySourceFieldReturnsOnCall[i] = struct {
+		result1 []db.Resource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceFactory) VisibleResources(arg1 []string) ([]db.Resource, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -96,6 +179,8 @@ func (fake *FakeResourceFactory) VisibleResourcesReturnsOnCall(i int, result1 []
 func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.resourcesBySourceFieldMutex.RLock()
+	defer fake.resourcesBySourceFieldMutex.RUnlock()
 	fake.visibleResourcesMutex.RLock()
 	defer fake.visibleResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
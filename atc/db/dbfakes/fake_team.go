@@ -97,11 +97,22 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.VariablesFactory) ([]db.Container, map[int]time.Time, error)
+	DisableSecretRedactionStub        func(bool) error
+	disableSecretRedactionMutex       sync.RWMutex
+	disableSecretRedactionArgsForCall []struct {
+		arg1 bool
+	}
+	disableSecretRedactionReturns struct {
+		result1 error
+	}
+	disableSecretRedactionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.VariablesFactory
 	}
@@ -231,10 +242,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -296,10 +307,10 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
@@ -328,6 +339,16 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SecretRedactionDisabledStub        func() bool
+	secretRedactionDisabledMutex       sync.RWMutex
+	secretRedactionDisabledArgsForCall []struct {
+	}
+	secretRedactionDisabledReturns struct {
+		result1 bool
+	}
+	secretRedactionDisabledReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -773,12 +794,72 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.VariablesFactory) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) DisableSecretRedaction(arg1 bool) error {
+	fake.disableSecretRedactionMutex.Lock()
+	ret, specificReturn := fake.disableSecretRedactionReturnsOnCall[len(fake.disableSecretRedactionArgsForCall)]
+	fake.disableSecretRedactionArgsForCall = append(fake.disableSecretRedactionArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("DisableSecretRedaction", []interface{}{arg1})
+	fake.disableSecretRedactionMutex.Unlock()
+	if fake.DisableSecretRedactionStub != nil {
+		return fake.DisableSecretRedactionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.disableSecretRedactionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) DisableSecretRedactionCallCount() int {
+	fake.disableSecretRedactionMutex.RLock()
+	defer fake.disableSecretRedactionMutex.RUnlock()
+	return len(fake.disableSecretRedactionArgsForCall)
+}
+
+func (fake *FakeTeam) DisableSecretRedactionCalls(stub func(bool) error) {
+	fake.disableSecretRedactionMutex.Lock()
+	defer fake.disableSecretRedactionMutex.Unlock()
+	fake.DisableSecretRedactionStub = stub
+}
+
+func (fake *FakeTeam) DisableSecretRedactionArgsForCall(i int) bool {
+	fake.disableSecretRedactionMutex.RLock()
+	defer fake.disableSecretRedactionMutex.RUnlock()
+	argsForCall := fake.disableSecretRedactionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DisableSecretRedactionReturns(result1 error) {
+	fake.disableSecretRedactionMutex.Lock()
+	defer fake.disableSecretRedactionMutex.Unlock()
+	fake.DisableSecretRedactionStub = nil
+	fake.disableSecretRedactionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) DisableSecretRedactionReturnsOnCall(i int, result1 error) {
+	fake.disableSecretRedactionMutex.Lock()
+	defer fake.disableSecretRedactionMutex.Unlock()
+	fake.DisableSecretRedactionStub = nil
+	if fake.disableSecretRedactionReturnsOnCall == nil {
+		fake.disableSecretRedactionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableSecretRedactionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.VariablesFactory) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.VariablesFactory
 	}{arg1, arg2, arg3, arg4})
@@ -800,13 +881,13 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, string, string, creds.VariablesFactory) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.VariablesFactory) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1399,11 +1480,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1423,13 +1504,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1701,11 +1782,11 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 db.PipelinePausedState) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 db.PipelinePausedState
@@ -1728,13 +1809,13 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, db.PipelinePausedState) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
@@ -1834,6 +1915,58 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SecretRedactionDisabled() bool {
+	fake.secretRedactionDisabledMutex.Lock()
+	ret, specificReturn := fake.secretRedactionDisabledReturnsOnCall[len(fake.secretRedactionDisabledArgsForCall)]
+	fake.secretRedactionDisabledArgsForCall = append(fake.secretRedactionDisabledArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SecretRedactionDisabled", []interface{}{})
+	fake.secretRedactionDisabledMutex.Unlock()
+	if fake.SecretRedactionDisabledStub != nil {
+		return fake.SecretRedactionDisabledStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretRedactionDisabledReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SecretRedactionDisabledCallCount() int {
+	fake.secretRedactionDisabledMutex.RLock()
+	defer fake.secretRedactionDisabledMutex.RUnlock()
+	return len(fake.secretRedactionDisabledArgsForCall)
+}
+
+func (fake *FakeTeam) SecretRedactionDisabledCalls(stub func() bool) {
+	fake.secretRedactionDisabledMutex.Lock()
+	defer fake.secretRedactionDisabledMutex.Unlock()
+	fake.SecretRedactionDisabledStub = stub
+}
+
+func (fake *FakeTeam) SecretRedactionDisabledReturns(result1 bool) {
+	fake.secretRedactionDisabledMutex.Lock()
+	defer fake.secretRedactionDisabledMutex.Unlock()
+	fake.SecretRedactionDisabledStub = nil
+	fake.secretRedactionDisabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeTeam) SecretRedactionDisabledReturnsOnCall(i int, result1 bool) {
+	fake.secretRedactionDisabledMutex.Lock()
+	defer fake.secretRedactionDisabledMutex.Unlock()
+	fake.SecretRedactionDisabledStub = nil
+	if fake.secretRedactionDisabledReturnsOnCall == nil {
+		fake.secretRedactionDisabledReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.secretRedactionDisabledReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2021,6 +2154,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.disableSecretRedactionMutex.RLock()
+	defer fake.disableSecretRedactionMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2055,6 +2190,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.secretRedactionDisabledMutex.RLock()
+	defer fake.secretRedactionDisabledMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	fake.visiblePipelinesMutex.RLock()
@@ -56,6 +56,18 @@ type FakeWorkerLifecycle struct {
 		result1 []string
 		result2 error
 	}
+	ReconcileActiveTasksStub        func() ([]string, error)
+	reconcileActiveTasksMutex       sync.RWMutex
+	reconcileActiveTasksArgsForCall []struct {
+	}
+	reconcileActiveTasksReturns struct {
+		result1 []string
+		result2 error
+	}
+	reconcileActiveTasksReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	StallUnresponsiveWorkersStub        func() ([]string, error)
 	stallUnresponsiveWorkersMutex       sync.RWMutex
 	stallUnresponsiveWorkersArgsForCall []struct {
@@ -292,6 +304,61 @@ func (fake *FakeWorkerLifecycle) LandFinishedLandingWorkersReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerLifecycle) ReconcileActiveTasks() ([]string, error) {
+	fake.reconcileActiveTasksMutex.Lock()
+	ret, specificReturn := fake.reconcileActiveTasksReturnsOnCall[len(fake.reconcileActiveTasksArgsForCall)]
+	fake.reconcileActiveTasksArgsForCall = append(fake.reconcileActiveTasksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReconcileActiveTasks", []interface{}{})
+	fake.reconcileActiveTasksMutex.Unlock()
+	if fake.ReconcileActiveTasksStub != nil {
+		return fake.ReconcileActiveTasksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.reconcileActiveTasksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) ReconcileActiveTasksCallCount() int {
+	fake.reconcileActiveTasksMutex.RLock()
+	defer fake.reconcileActiveTasksMutex.RUnlock()
+	return len(fake.reconcileActiveTasksArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) ReconcileActiveTasksCalls(stub func() ([]string, error)) {
+	fake.reconcileActiveTasksMutex.Lock()
+	defer fake.reconcileActiveTasksMutex.Unlock()
+	fake.ReconcileActiveTasksStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) ReconcileActiveTasksReturns(result1 []string, result2 error) {
+	fake.reconcileActiveTasksMutex.Lock()
+	defer fake.reconcileActiveTasksMutex.Unlock()
+	fake.ReconcileActiveTasksStub = nil
+	fake.reconcileActiveTasksReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) ReconcileActiveTasksReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.reconcileActiveTasksMutex.Lock()
+	defer fake.reconcileActiveTasksMutex.Unlock()
+	fake.ReconcileActiveTasksStub = nil
+	if fake.reconcileActiveTasksReturnsOnCall == nil {
+		fake.reconcileActiveTasksReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.reconcileActiveTasksReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 	fake.stallUnresponsiveWorkersMutex.Lock()
 	ret, specificReturn := fake.stallUnresponsiveWorkersReturnsOnCall[len(fake.stallUnresponsiveWorkersArgsForCall)]
@@ -358,6 +425,8 @@ func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	defer fake.getWorkerStateByNameMutex.RUnlock()
 	fake.landFinishedLandingWorkersMutex.RLock()
 	defer fake.landFinishedLandingWorkersMutex.RUnlock()
+	fake.reconcileActiveTasksMutex.RLock()
+	defer fake.reconcileActiveTasksMutex.RUnlock()
 	fake.stallUnresponsiveWorkersMutex.RLock()
 	defer fake.stallUnresponsiveWorkersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var ErrNoEventStore = errors.New("no event store configured")
+
+//go:generate counterfeiter . EventStore
+
+// EventStore holds the events of builds.
+//
+// Events are addressed by their index within the build: the first event saved
+// for a build has index 0, the next 1, and so on without gaps. This is the
+// cursor given to Build.Events and the ID of each event sent to clients, so
+// that a client can resume the stream from any store. It is not the event_id
+// column of the Postgres event tables, which is only used for ordering as its
+// sequence may skip values.
+//
+// Postgres is the default store. Other stores only ever hold the events of
+// completed builds; see WithEventStore.
+type EventStore interface {
+	// Put stores the complete events of a build, replacing any it held.
+	Put(buildID int, events []event.Envelope) error
+
+	// Get returns at most limit events of the build, starting from the event
+	// with the given index.
+	Get(buildID int, from uint, limit int) ([]event.Envelope, error)
+
+	// Delete removes all events of the builds.
+	Delete(buildIDs []int) error
+}
+
+type eventStoreConn struct {
+	Conn
+
+	store EventStore
+}
+
+// WithEventStore returns a connection whose builds move their events to the
+// given store once they complete.
+//
+// While a build is running its events are always written to Postgres so that
+// they can be streamed from any web node. Once the build finishes they are
+// archived to the given store by ArchiveEvents and read back from it from
+// then on.
+func WithEventStore(conn Conn, store EventStore) Conn {
+	return &eventStoreConn{
+		Conn:  conn,
+		store: store,
+	}
+}
+
+func (conn *eventStoreConn) EventStore() EventStore {
+	return &archivingEventStore{
+		conn:     conn.Conn,
+		postgres: NewPostgresEventStore(conn.Conn),
+		archive:  conn.store,
+	}
+}
+
+// archivingEventStore reads the events of a build from Postgres until they
+// have been archived to another store.
+type archivingEventStore struct {
+	conn Conn
+
+	postgres EventStore
+	archive  EventStore
+}
+
+func (store *archivingEventStore) Put(buildID int, events []event.Envelope) error {
+	return store.archive.Put(buildID, events)
+}
+
+func (store *archivingEventStore) Get(buildID int, from uint, limit int) ([]event.Envelope, error) {
+	archived, err := store.archived(buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if archived {
+		return store.archive.Get(buildID, from, limit)
+	}
+
+	events, err := store.postgres.Get(buildID, from, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(events) == limit {
+		return events, nil
+	}
+
+	// the events may have been archived while they were being read, in which
+	// case the rest are in the archive
+	archived, err = store.archived(buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !archived {
+		return events, nil
+	}
+
+	rest, err := store.archive.Get(buildID, from+uint(len(events)), limit-len(events))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(events, rest...), nil
+}
+
+func (store *archivingEventStore) Delete(buildIDs []int) error {
+	err := store.postgres.Delete(buildIDs)
+	if err != nil {
+		return err
+	}
+
+	return store.archive.Delete(buildIDs)
+}
+
+func (store *archivingEventStore) archived(buildID int) (bool, error) {
+	var archived bool
+	err := psql.Select("events_archived").
+		From("builds").
+		Where(sq.Eq{"id": buildID}).
+		RunWith(store.conn).
+		QueryRow().
+		Scan(&archived)
+	return archived, err
+}
+
+// deleteArchivedBuildEvents removes the events of the builds matching the
+// condition from the event store they have been archived to, if any.
+func deleteArchivedBuildEvents(conn Conn, condition sq.Sqlizer) error {
+	store, ok := conn.EventStore().(*archivingEventStore)
+	if !ok {
+		return nil
+	}
+
+	rows, err := psql.Select("id").
+		From("builds").
+		Where(condition).
+		Where(sq.Eq{"events_archived": true}).
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	buildIDs := []int{}
+	for rows.Next() {
+		var buildID int
+		err = rows.Scan(&buildID)
+		if err != nil {
+			return err
+		}
+
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	return store.archive.Delete(buildIDs)
+}
@@ -4,11 +4,13 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/lib/pq"
 )
 
 //go:generate counterfeiter . Job
@@ -20,6 +22,7 @@ type Job interface {
 	FirstLoggedBuildID() int
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	Config() atc.JobConfig
@@ -31,6 +34,7 @@ type Job interface {
 	Unpause() error
 
 	CreateBuild() (Build, error)
+	RerunBuild(Build) (Build, error)
 	Builds(page Page) ([]Build, Pagination, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 	Build(name string) (Build, bool, error)
@@ -41,6 +45,7 @@ type Job interface {
 
 	GetIndependentBuildInputs() ([]BuildInput, error)
 	GetNextBuildInputs() ([]BuildInput, bool, error)
+	NextBuildInputsDeterminedTime() (time.Time, bool, error)
 	SaveNextInputMapping(inputMapping algorithm.InputMapping) error
 	SaveIndependentInputMapping(inputMapping algorithm.InputMapping) error
 	DeleteNextInputMapping() error
@@ -50,9 +55,11 @@ type Job interface {
 	GetNextPendingBuildBySerialGroup(serialGroups []string) (Build, bool, error)
 
 	ClearTaskCache(string, string) (int64, error)
+
+	FlakyTests(builds int) ([]atc.FlakyTest, error)
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "array_to_json(j.tags)").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -68,16 +75,17 @@ func (e FirstLoggedBuildIDDecreasedError) Error() string {
 }
 
 type job struct {
-	id                 int
-	name               string
-	paused             bool
-	firstLoggedBuildID int
-	pipelineID         int
-	pipelineName       string
-	teamID             int
-	teamName           string
-	config             atc.JobConfig
-	tags               []string
+	id                   int
+	name                 string
+	paused               bool
+	firstLoggedBuildID   int
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
+	teamID               int
+	teamName             string
+	config               atc.JobConfig
+	tags                 []string
 
 	conn        Conn
 	lockFactory lock.LockFactory
@@ -95,16 +103,17 @@ func (jobs Jobs) Configs() atc.JobConfigs {
 	return configs
 }
 
-func (j *job) ID() int                 { return j.id }
-func (j *job) Name() string            { return j.name }
-func (j *job) Paused() bool            { return j.paused }
-func (j *job) FirstLoggedBuildID() int { return j.firstLoggedBuildID }
-func (j *job) PipelineID() int         { return j.pipelineID }
-func (j *job) PipelineName() string    { return j.pipelineName }
-func (j *job) TeamID() int             { return j.teamID }
-func (j *job) TeamName() string        { return j.teamName }
-func (j *job) Config() atc.JobConfig   { return j.config }
-func (j *job) Tags() []string          { return j.tags }
+func (j *job) ID() int                                { return j.id }
+func (j *job) Name() string                           { return j.name }
+func (j *job) Paused() bool                           { return j.paused }
+func (j *job) FirstLoggedBuildID() int                { return j.firstLoggedBuildID }
+func (j *job) PipelineID() int                        { return j.pipelineID }
+func (j *job) PipelineName() string                   { return j.pipelineName }
+func (j *job) PipelineInstanceVars() atc.InstanceVars { return j.pipelineInstanceVars }
+func (j *job) TeamID() int                            { return j.teamID }
+func (j *job) TeamName() string                       { return j.teamName }
+func (j *job) Config() atc.JobConfig                  { return j.config }
+func (j *job) Tags() []string                         { return j.tags }
 
 func (j *job) Reload() (bool, error) {
 	row := jobsQuery.Where(sq.Eq{"j.id": j.id}).
@@ -368,6 +377,31 @@ func (j *job) GetNextBuildInputs() ([]BuildInput, bool, error) {
 	return buildInputs, true, err
 }
 
+// NextBuildInputsDeterminedTime returns when the job's next build inputs were
+// first determined, i.e. since when a pending build could have been started.
+// It returns false if the inputs are not determined.
+func (j *job) NextBuildInputsDeterminedTime() (time.Time, bool, error) {
+	var determinedTime pq.NullTime
+	err := psql.Select("inputs_determined_time").
+		From("jobs").
+		Where(sq.Eq{
+			"id":                j.id,
+			"inputs_determined": true,
+		}).
+		RunWith(j.conn).
+		QueryRow().
+		Scan(&determinedTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return time.Time{}, false, nil
+		}
+
+		return time.Time{}, false, err
+	}
+
+	return determinedTime.Time, determinedTime.Valid, nil
+}
+
 func (j *job) DeleteNextInputMapping() error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -378,6 +412,7 @@ func (j *job) DeleteNextInputMapping() error {
 
 	_, err = psql.Update("jobs").
 		Set("inputs_determined", false).
+		Set("inputs_determined_time", nil).
 		Where(sq.Eq{
 			"name":        j.name,
 			"pipeline_id": j.pipelineID,
@@ -415,7 +450,7 @@ func (j *job) EnsurePendingBuildExists() error {
 		INSERT INTO builds (name, job_id, pipeline_id, team_id, status)
 		SELECT $1, $2, $3, $4, 'pending'
 		WHERE NOT EXISTS
-			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending')
+			(SELECT id FROM builds WHERE job_id = $2 AND status = 'pending' AND rerun_of IS NULL)
 		RETURNING id
 	`, buildName, j.id, j.pipelineID, j.teamID)
 	if err != nil {
@@ -497,6 +532,11 @@ func (j *job) CreateBuild() (Build, error) {
 
 	defer Rollback(tx)
 
+	err = checkPipelineNotArchived(tx, j.pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
 	buildName, err := j.getNewBuildName(tx)
 	if err != nil {
 		return nil, err
@@ -528,6 +568,70 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
+// RerunBuild creates a new build of the job which uses exactly the same input
+// versions as the given build, rather than those determined by the job's next
+// input mapping. The inputs are copied to the new build right away, so the
+// given build must already have been scheduled.
+func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	err = checkPipelineNotArchived(tx, j.pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	buildName, err := j.getNewBuildName(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	build := &build{conn: j.conn, lockFactory: j.lockFactory}
+	err = createBuild(tx, build, map[string]interface{}{
+		"name":               buildName,
+		"job_id":             j.id,
+		"pipeline_id":        j.pipelineID,
+		"team_id":            j.teamID,
+		"status":             BuildStatusPending,
+		"manually_triggered": true,
+		"rerun_of":           buildToRerun.ID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO build_resource_config_version_inputs (build_id, resource_id, version_md5, name)
+		SELECT $1, resource_id, version_md5, name
+		FROM build_resource_config_version_inputs
+		WHERE build_id = $2
+	`, build.ID(), buildToRerun.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	err = bumpCacheIndex(tx, j.pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = updateNextBuildForJob(tx, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
 func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -563,6 +667,49 @@ func (j *job) ClearTaskCache(stepName string, cachePath string) (int64, error) {
 	return rowsDeleted, tx.Commit()
 }
 
+// FlakyTests finds the tests that both passed and failed across the job's
+// most recent builds, most frequently failing first. Errored tests count as
+// failures.
+func (j *job) FlakyTests(builds int) ([]atc.FlakyTest, error) {
+	rows, err := j.conn.Query(`
+		WITH recent AS (
+			SELECT id, name
+			FROM builds
+			WHERE job_id = $1
+			ORDER BY id DESC
+			LIMIT $2
+		)
+		SELECT r.suite, r.name,
+			count(*) FILTER (WHERE r.status = 'passed') AS passed,
+			count(*) FILTER (WHERE r.status IN ('failed', 'errored')) AS failed,
+			(array_agg(b.name ORDER BY b.id DESC) FILTER (WHERE r.status IN ('failed', 'errored')))[1]
+		FROM build_test_results r
+		JOIN recent b ON b.id = r.build_id
+		GROUP BY r.suite, r.name
+		HAVING count(*) FILTER (WHERE r.status = 'passed') > 0
+			AND count(*) FILTER (WHERE r.status IN ('failed', 'errored')) > 0
+		ORDER BY failed DESC, r.suite, r.name
+	`, j.id, builds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	flakyTests := []atc.FlakyTest{}
+	for rows.Next() {
+		var flakyTest atc.FlakyTest
+		err := rows.Scan(&flakyTest.Suite, &flakyTest.Name, &flakyTest.Passed, &flakyTest.Failed, &flakyTest.LastFailedBuild)
+		if err != nil {
+			return nil, err
+		}
+
+		flakyTests = append(flakyTests, flakyTest)
+	}
+
+	return flakyTests, nil
+}
+
 func (j *job) updateSerialGroups(serialGroups []string) error {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -688,6 +835,7 @@ func (j *job) saveJobInputMapping(table string, inputMapping algorithm.InputMapp
 	if table == "next_build_inputs" {
 		_, err = psql.Update("jobs").
 			Set("inputs_determined", true).
+			Set("inputs_determined_time", sq.Expr("now()")).
 			Where(sq.Eq{"id": j.id}).
 			Where(sq.Expr("NOT inputs_determined")).
 			RunWith(tx).
@@ -798,17 +946,25 @@ func (j *job) finishedBuild() (Build, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		configBlob []byte
-		nonce      sql.NullString
-		tagsBlob   []byte
-		tags       []string
+		configBlob           []byte
+		nonce                sql.NullString
+		pipelineInstanceVars sql.NullString
+		tagsBlob             []byte
+		tags                 []string
 	)
 
-	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, &tagsBlob)
+	err := row.Scan(&j.id, &j.name, &configBlob, &j.paused, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, &tagsBlob)
 	if err != nil {
 		return err
 	}
 
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &j.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	es := j.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -24,7 +24,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -93,6 +93,54 @@ var _ = Describe("Job", func() {
 		Expect(found).To(BeTrue())
 	})
 
+	Describe("PipelineInstanceVars", func() {
+		It("is empty when the pipeline has no instance vars", func() {
+			Expect(job.PipelineInstanceVars()).To(BeEmpty())
+		})
+
+		Context("when the pipeline is an instance", func() {
+			var instance db.Pipeline
+
+			BeforeEach(func() {
+				var err error
+				instance, _, err = team.SavePipeline(atc.PipelineRef{
+					Name:         "fake-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{Name: "some-job"},
+					},
+					Resources: atc.ResourceConfigs{
+						{Name: "some-resource", Type: "some-type"},
+					},
+				}, db.ConfigVersion(0), db.PipelineUnpaused)
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				job, found, err = instance.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("returns the instance vars of the job's pipeline", func() {
+				Expect(job.PipelineInstanceVars()).To(Equal(atc.InstanceVars{"branch": "feature"}))
+			})
+
+			It("returns them for the job's builds", func() {
+				build, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(build.PipelineInstanceVars()).To(Equal(atc.InstanceVars{"branch": "feature"}))
+			})
+
+			It("returns them for the pipeline's resources", func() {
+				resource, found, err := instance.Resource("some-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(resource.PipelineInstanceVars()).To(Equal(atc.InstanceVars{"branch": "feature"}))
+			})
+		})
+	})
+
 	Describe("Pause and Unpause", func() {
 		It("starts out as unpaused", func() {
 			Expect(job.Paused()).To(BeFalse())
@@ -128,7 +176,7 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
@@ -358,7 +406,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), db.PipelineUnpaused)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -486,6 +534,143 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("FlakyTests", func() {
+		BeforeEach(func() {
+			outcomes := [][]atc.TestResult{
+				{
+					{Suite: "math", Name: "adds", Status: atc.TestPassed},
+					{Suite: "math", Name: "subtracts", Status: atc.TestPassed},
+				},
+				{
+					{Suite: "math", Name: "adds", Status: atc.TestPassed},
+					{Suite: "math", Name: "subtracts", Status: atc.TestFailed},
+				},
+				{
+					{Suite: "math", Name: "adds", Status: atc.TestPassed},
+					{Suite: "math", Name: "subtracts", Status: atc.TestErrored},
+				},
+			}
+
+			for _, results := range outcomes {
+				build, err := job.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.SaveTestResults(results)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("returns the tests that both passed and failed", func() {
+			flakyTests, err := job.FlakyTests(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(flakyTests).To(Equal([]atc.FlakyTest{
+				{Suite: "math", Name: "subtracts", Passed: 1, Failed: 2, LastFailedBuild: "3"},
+			}))
+		})
+
+		It("only considers the given number of recent builds", func() {
+			flakyTests, err := job.FlakyTests(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(flakyTests).To(BeEmpty())
+		})
+	})
+
+	Describe("RerunBuild", func() {
+		var (
+			resource      db.Resource
+			originalBuild db.Build
+		)
+
+		BeforeEach(func() {
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "some-type",
+			}
+			_, err = brt.FindOrCreate(setupTx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			var found bool
+			resource, found, err = pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfig, err := resource.SetResourceConfig(logger, atc.Source{}, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfig.SaveVersions([]atc.Version{{"version": "v1"}, {"version": "v2"}})
+			Expect(err).ToNot(HaveOccurred())
+
+			originalBuild, err = job.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = originalBuild.UseInputs([]db.BuildInput{
+				{
+					Name:       "some-input",
+					ResourceID: resource.ID(),
+					Version:    atc.Version{"version": "v1"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a pending build of the job linked to the original", func() {
+			rerunBuild, err := job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(rerunBuild.ID()).ToNot(Equal(originalBuild.ID()))
+			Expect(rerunBuild.Name()).ToNot(Equal(originalBuild.Name()))
+			Expect(rerunBuild.JobID()).To(Equal(job.ID()))
+			Expect(rerunBuild.Status()).To(Equal(db.BuildStatusPending))
+			Expect(rerunBuild.IsManuallyTriggered()).To(BeTrue())
+			Expect(rerunBuild.RerunOf()).To(Equal(originalBuild.ID()))
+
+			found, err := originalBuild.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(originalBuild.RerunOf()).To(BeZero())
+		})
+
+		It("uses the original build's inputs", func() {
+			rerunBuild, err := job.RerunBuild(originalBuild)
+			Expect(err).ToNot(HaveOccurred())
+
+			inputs, err := rerunBuild.Inputs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inputs).To(ConsistOf(db.BuildInput{
+				Name:       "some-input",
+				ResourceID: resource.ID(),
+				Version:    atc.Version{"version": "v1"},
+			}))
+		})
+
+		Context("when the pipeline is archived", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Archive()).To(Succeed())
+			})
+
+			It("returns ErrPipelineArchived", func() {
+				_, err := job.RerunBuild(originalBuild)
+				Expect(err).To(Equal(db.ErrPipelineArchived))
+			})
+		})
+	})
+
+	Describe("CreateBuild", func() {
+		Context("when the pipeline is archived", func() {
+			BeforeEach(func() {
+				Expect(pipeline.Archive()).To(Succeed())
+			})
+
+			It("returns ErrPipelineArchived", func() {
+				_, err := job.CreateBuild()
+				Expect(err).To(Equal(db.ErrPipelineArchived))
+			})
+		})
+	})
+
 	Describe("GetRunningBuildsBySerialGroup", func() {
 		Describe("same job", func() {
 			var startedBuild, scheduledBuild db.Build
@@ -756,7 +941,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -935,7 +1120,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, db.PipelineUnpaused)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1063,6 +1248,42 @@ var _ = Describe("Job", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
+
+		It("records when the inputs were first determined", func() {
+			_, found, err := job.NextBuildInputsDeterminedTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			err = job.SaveNextInputMapping(algorithm.InputMapping{})
+			Expect(err).NotTo(HaveOccurred())
+
+			determinedTime, found, err := job.NextBuildInputsDeterminedTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(determinedTime).To(BeTemporally("~", time.Now(), time.Minute))
+
+			By("keeping the time when the inputs change")
+			err = job.SaveNextInputMapping(algorithm.InputMapping{
+				"some-input": algorithm.InputVersion{
+					VersionID:  versions[0].ID,
+					ResourceID: resource.ID(),
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			redeterminedTime, found, err := job.NextBuildInputsDeterminedTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(redeterminedTime).To(Equal(determinedTime))
+
+			By("clearing the time when the input mapping is deleted")
+			err = job.DeleteNextInputMapping()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, found, err = job.NextBuildInputsDeterminedTime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
 	})
 
 	Describe("a build is created for a job", func() {
@@ -1087,7 +1308,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), db.PipelineUnpaused)
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1282,6 +1503,30 @@ var _ = Describe("Job", func() {
 				Expect(builds2).To(HaveLen(0))
 			})
 		})
+
+		Context("when only a pending rerun exists", func() {
+			BeforeEach(func() {
+				build1, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = job.RerunBuild(build1)
+				Expect(err).NotTo(HaveOccurred())
+
+				started, err := build1.Start("some-engine", `{"some":"metadata"}`, atc.Plan{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+
+			It("creates a build", func() {
+				err := job.EnsurePendingBuildExists()
+				Expect(err).NotTo(HaveOccurred())
+
+				pendingBuilds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pendingBuilds).To(HaveLen(2))
+				Expect(pendingBuilds[1].RerunOf()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("Clear worker task cache", func() {
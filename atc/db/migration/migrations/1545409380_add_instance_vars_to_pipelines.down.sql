@@ -0,0 +1,9 @@
+BEGIN;
+  DELETE FROM pipelines WHERE instance_vars IS NOT NULL;
+
+  DROP INDEX pipelines_name_team_id_instance_vars_uniq;
+
+  ALTER TABLE pipelines ADD CONSTRAINT pipelines_name_team_id UNIQUE (name, team_id);
+
+  ALTER TABLE pipelines DROP COLUMN instance_vars;
+COMMIT;
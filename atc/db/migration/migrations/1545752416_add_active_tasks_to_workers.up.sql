@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN active_tasks integer NOT NULL DEFAULT 0;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE builds DROP COLUMN create_time;
+
+  ALTER TABLE containers DROP COLUMN meta_team_name;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN create_time timestamp with time zone;
+  ALTER TABLE builds ALTER COLUMN create_time SET DEFAULT now();
+
+  ALTER TABLE containers ADD COLUMN meta_team_name text DEFAULT ''::text NOT NULL;
+COMMIT;
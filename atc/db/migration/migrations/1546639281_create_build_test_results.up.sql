@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE build_test_results (
+      "id" bigserial PRIMARY KEY,
+      "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+      "suite" text NOT NULL,
+      "name" text NOT NULL,
+      "status" text NOT NULL,
+      "duration" double precision NOT NULL DEFAULT 0,
+      "message" text NOT NULL DEFAULT ''
+  );
+
+  CREATE INDEX build_test_results_build_id_idx ON build_test_results (build_id);
+
+  ALTER TABLE builds ADD COLUMN test_summary jsonb;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE teams ADD COLUMN disable_secret_redaction boolean NOT NULL DEFAULT false;
+COMMIT;
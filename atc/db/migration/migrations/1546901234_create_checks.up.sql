@@ -0,0 +1,22 @@
+BEGIN;
+  CREATE TABLE checks (
+    id bigserial PRIMARY KEY,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    resource_id integer REFERENCES resources (id) ON DELETE CASCADE,
+    resource_type_id integer REFERENCES resource_types (id) ON DELETE CASCADE,
+    status text NOT NULL DEFAULT 'pending',
+    create_time timestamp with time zone NOT NULL DEFAULT now(),
+    start_time timestamp with time zone,
+    end_time timestamp with time zone,
+    check_error text,
+    CHECK ((resource_id IS NULL) <> (resource_type_id IS NULL))
+  );
+
+  CREATE INDEX checks_pending_idx ON checks (create_time) WHERE status = 'pending';
+  CREATE INDEX checks_resource_id_idx ON checks (resource_id);
+  CREATE INDEX checks_resource_type_id_idx ON checks (resource_type_id);
+
+  -- at most one check per resource (type) is ever queued or running
+  CREATE UNIQUE INDEX checks_resource_id_unfinished_idx ON checks (resource_id) WHERE status IN ('pending', 'started');
+  CREATE UNIQUE INDEX checks_resource_type_id_unfinished_idx ON checks (resource_type_id) WHERE status IN ('pending', 'started');
+COMMIT;
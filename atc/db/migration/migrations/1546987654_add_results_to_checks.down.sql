@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE checks
+    DROP COLUMN from_version,
+    DROP COLUMN new_versions,
+    DROP COLUMN stderr;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE checks
+    ADD COLUMN from_version jsonb,
+    ADD COLUMN new_versions integer NOT NULL DEFAULT 0,
+    ADD COLUMN stderr text;
+COMMIT;
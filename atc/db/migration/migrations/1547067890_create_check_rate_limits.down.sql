@@ -0,0 +1,6 @@
+BEGIN;
+  DROP TABLE check_rate_limits;
+
+  ALTER TABLE checks
+    DROP COLUMN deferred_until;
+COMMIT;
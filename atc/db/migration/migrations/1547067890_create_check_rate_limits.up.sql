@@ -0,0 +1,10 @@
+BEGIN;
+  ALTER TABLE checks
+    ADD COLUMN deferred_until timestamp with time zone;
+
+  CREATE TABLE check_rate_limits (
+    bucket text PRIMARY KEY,
+    tokens double precision NOT NULL,
+    update_time timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
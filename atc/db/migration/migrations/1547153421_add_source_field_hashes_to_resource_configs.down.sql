@@ -0,0 +1,6 @@
+BEGIN;
+  DROP INDEX resource_configs_source_field_hashes_idx;
+
+  ALTER TABLE resource_configs
+    DROP COLUMN source_field_hashes;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE resource_configs
+    ADD COLUMN source_field_hashes jsonb;
+
+  CREATE INDEX resource_configs_source_field_hashes_idx ON resource_configs USING gin (source_field_hashes jsonb_path_ops);
+COMMIT;
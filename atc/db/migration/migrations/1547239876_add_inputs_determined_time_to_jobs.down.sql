@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN inputs_determined_time;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN inputs_determined_time timestamp with time zone;
+COMMIT;
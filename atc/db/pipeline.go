@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -16,6 +17,8 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
+var ErrPipelineArchived = errors.New("pipeline is archived")
+
 type ErrResourceNotFound struct {
 	Name string
 }
@@ -40,6 +43,9 @@ type Pipeline interface {
 	ConfigVersion() ConfigVersion
 	Public() bool
 	Paused() bool
+	Archived() bool
+	InstanceVars() atc.InstanceVars
+	Ref() atc.PipelineRef
 
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
@@ -76,6 +82,8 @@ type Pipeline interface {
 	Pause() error
 	Unpause() error
 
+	Archive() error
+
 	Destroy() error
 	Rename(string) error
 }
@@ -89,6 +97,8 @@ type pipeline struct {
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
+	archived      bool
+	instanceVars  atc.InstanceVars
 
 	cacheIndex int
 	versionsDB *algorithm.VersionsDB
@@ -110,7 +120,9 @@ var pipelinesQuery = psql.Select(`
 		p.team_id,
 		t.name,
 		p.paused,
-		p.public
+		p.public,
+		p.archived,
+		p.instance_vars
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -152,6 +164,16 @@ func (p *pipeline) Groups() atc.GroupConfigs     { return p.groups }
 func (p *pipeline) ConfigVersion() ConfigVersion { return p.configVersion }
 func (p *pipeline) Public() bool                 { return p.public }
 func (p *pipeline) Paused() bool                 { return p.paused }
+func (p *pipeline) Archived() bool               { return p.archived }
+
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+
+func (p *pipeline) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         p.name,
+		InstanceVars: p.instanceVars,
+	}
+}
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -241,6 +263,11 @@ func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 
 	defer Rollback(tx)
 
+	err = checkPipelineNotArchived(tx, p.id)
+	if err != nil {
+		return nil, err
+	}
+
 	buildName, jobID, err := getNewBuildNameForJob(tx, jobName, p.id)
 	if err != nil {
 		return nil, err
@@ -603,6 +630,56 @@ func (p *pipeline) Pause() error {
 	return err
 }
 
+// Archive pauses the pipeline and marks it as archived. The pipeline's builds
+// are kept, but like a paused pipeline its resources are no longer checked and
+// the resource configs of its resources and resource types become eligible
+// for garbage collection. No builds can be triggered manually. Saving a new
+// config for the pipeline will un-archive it, leaving it paused.
+func (p *pipeline) Archive() error {
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Update("pipelines").
+		Set("paused", true).
+		Set("archived", true).
+		Where(sq.Eq{
+			"id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resources").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("resource_types").
+		Set("resource_config_id", nil).
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
 func (p *pipeline) Unpause() error {
 	_, err := psql.Update("pipelines").
 		Set("paused", false).
@@ -652,7 +729,12 @@ func (p *pipeline) Rename(name string) error {
 }
 
 func (p *pipeline) Destroy() error {
-	_, err := psql.Delete("pipelines").
+	err := deleteArchivedBuildEvents(p.conn, sq.Eq{"pipeline_id": p.id})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("pipelines").
 		Where(sq.Eq{
 			"id": p.id,
 		}).
@@ -863,6 +945,11 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		indexStrings[i] = "$" + strconv.Itoa(i+1)
 	}
 
+	err := deleteArchivedBuildEvents(p.conn, sq.Eq{"id": buildIDs})
+	if err != nil {
+		return err
+	}
+
 	tx, err := p.conn.Begin()
 	if err != nil {
 		return err
@@ -1035,6 +1122,29 @@ func bumpCacheIndex(tx Tx, pipelineID int) error {
 	return nil
 }
 
+// checkPipelineNotArchived returns ErrPipelineArchived if the pipeline is
+// archived. The pipeline's row stays locked until the transaction ends, so it
+// cannot be archived concurrently.
+func checkPipelineNotArchived(tx Tx, pipelineID int) error {
+	var archived bool
+	err := psql.Select("archived").
+		From("pipelines").
+		Where(sq.Eq{"id": pipelineID}).
+		Suffix("FOR SHARE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&archived)
+	if err != nil {
+		return err
+	}
+
+	if archived {
+		return ErrPipelineArchived
+	}
+
+	return nil
+}
+
 func getNewBuildNameForJob(tx Tx, jobName string, pipelineID int) (string, int, error) {
 	var buildName string
 	var jobID int
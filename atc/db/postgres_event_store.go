@@ -0,0 +1,160 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+type postgresEventStore struct {
+	conn Conn
+}
+
+// NewPostgresEventStore returns the default event store, which keeps the
+// events of each build in the event table of its pipeline, or of its team for
+// one-off builds.
+func NewPostgresEventStore(conn Conn) EventStore {
+	return &postgresEventStore{conn: conn}
+}
+
+func (store *postgresEventStore) Put(buildID int, events []event.Envelope) error {
+	table, err := store.table(buildID)
+	if err != nil {
+		return err
+	}
+
+	tx, err := store.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete(table).
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	for i, ev := range events {
+		var payload []byte
+		if ev.Data != nil {
+			payload = *ev.Data
+		}
+
+		_, err = psql.Insert(table).
+			Columns("event_id", "build_id", "type", "version", "payload").
+			Values(i, buildID, string(ev.Event), string(ev.Version), string(payload)).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return store.conn.Bus().Notify(buildEventsChannel(buildID))
+}
+
+func (store *postgresEventStore) Get(buildID int, from uint, limit int) ([]event.Envelope, error) {
+	table, err := store.table(buildID)
+	if err != nil {
+		return nil, err
+	}
+
+	// event_id only orders the events; it may have gaps, so the events are
+	// offset by their index instead
+	rows, err := psql.Select("type", "version", "payload").
+		From(table).
+		Where(sq.Eq{"build_id": buildID}).
+		OrderBy("event_id ASC").
+		Offset(uint64(from)).
+		Limit(uint64(limit)).
+		RunWith(store.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanBuildEvents(rows)
+}
+
+func (store *postgresEventStore) Delete(buildIDs []int) error {
+	for _, buildID := range buildIDs {
+		table, err := store.table(buildID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+
+			return err
+		}
+
+		_, err = psql.Delete(table).
+			Where(sq.Eq{"build_id": buildID}).
+			RunWith(store.conn).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *postgresEventStore) table(buildID int) (string, error) {
+	var teamID int
+	var pipelineID sql.NullInt64
+	err := psql.Select("team_id", "pipeline_id").
+		From("builds").
+		Where(sq.Eq{"id": buildID}).
+		RunWith(store.conn).
+		QueryRow().
+		Scan(&teamID, &pipelineID)
+	if err != nil {
+		return "", err
+	}
+
+	return buildEventsTable(teamID, int(pipelineID.Int64)), nil
+}
+
+func buildEventsTable(teamID int, pipelineID int) string {
+	if pipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", pipelineID)
+	}
+
+	return fmt.Sprintf("team_build_events_%d", teamID)
+}
+
+func scanBuildEvents(rows *sql.Rows) ([]event.Envelope, error) {
+	defer Close(rows)
+
+	events := []event.Envelope{}
+	for rows.Next() {
+		var t, v, p string
+		err := rows.Scan(&t, &v, &p)
+		if err != nil {
+			return nil, err
+		}
+
+		data := json.RawMessage(p)
+
+		events = append(events, event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType(t),
+			Version: atc.EventVersion(v),
+		})
+	}
+
+	return events, rows.Err()
+}
@@ -0,0 +1,78 @@
+package db_test
+
+import (
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PostgresEventStore", func() {
+	var (
+		store db.EventStore
+		build db.Build
+	)
+
+	logEnvelope := func(payload string) event.Envelope {
+		data, err := json.Marshal(event.Log{Payload: payload})
+		Expect(err).NotTo(HaveOccurred())
+
+		raw := json.RawMessage(data)
+		return event.Envelope{
+			Data:    &raw,
+			Event:   event.Log{}.EventType(),
+			Version: event.Log{}.Version(),
+		}
+	}
+
+	BeforeEach(func() {
+		store = db.NewPostgresEventStore(dbConn)
+
+		var err error
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(build.SaveEvent(event.Log{Payload: "one"})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "two"})).To(Succeed())
+		Expect(build.SaveEvent(event.Log{Payload: "three"})).To(Succeed())
+	})
+
+	It("is the default store of a connection", func() {
+		Expect(dbConn.EventStore()).To(Equal(store))
+	})
+
+	It("returns the saved events of a build", func() {
+		events, err := store.Get(build.ID(), 0, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(Equal([]event.Envelope{
+			logEnvelope("one"),
+			logEnvelope("two"),
+			logEnvelope("three"),
+		}))
+	})
+
+	It("returns the events from the given index, up to the limit", func() {
+		events, err := store.Get(build.ID(), 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(Equal([]event.Envelope{logEnvelope("two")}))
+	})
+
+	It("replaces the events of a build when putting them", func() {
+		Expect(store.Put(build.ID(), []event.Envelope{logEnvelope("replaced")})).To(Succeed())
+
+		events, err := store.Get(build.ID(), 0, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(Equal([]event.Envelope{logEnvelope("replaced")}))
+	})
+
+	It("deletes the events of builds", func() {
+		Expect(store.Delete([]int{build.ID()})).To(Succeed())
+
+		events, err := store.Get(build.ID(), 0, 10)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(events).To(BeEmpty())
+	})
+})
@@ -23,6 +23,7 @@ type Resource interface {
 	Name() string
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
 	TeamName() string
 	Type() string
 	Source() atc.Source
@@ -32,6 +33,7 @@ type Resource interface {
 	Tags() atc.Tags
 	CheckError() error
 	WebhookToken() string
+	Webhook() *atc.WebhookConfig
 	ConfigPinnedVersion() atc.Version
 	APIPinnedVersion() atc.Version
 	ResourceConfigCheckError() error
@@ -41,6 +43,7 @@ type Resource interface {
 
 	ResourceConfigVersionID(atc.Version) (int, bool, error)
 	Versions(page Page) ([]atc.ResourceVersion, Pagination, bool, error)
+	Checks() ([]Check, error)
 
 	EnableVersion(rcvID int) error
 	DisableVersion(rcvID int) error
@@ -54,7 +57,7 @@ type Resource interface {
 	Reload() (bool, error)
 }
 
-var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, c.last_checked, r.pipeline_id, r.nonce, r.resource_config_id, p.name, t.name, c.check_error, r.api_pinned_version").
+var resourcesQuery = psql.Select("r.id, r.name, r.config, r.check_error, c.last_checked, r.pipeline_id, r.nonce, r.resource_config_id, p.name, p.instance_vars, t.name, c.check_error, r.api_pinned_version").
 	From("resources r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
@@ -66,6 +69,7 @@ type resource struct {
 	name                     string
 	pipelineID               int
 	pipelineName             string
+	pipelineInstanceVars     atc.InstanceVars
 	teamName                 string
 	type_                    string
 	source                   atc.Source
@@ -75,6 +79,7 @@ type resource struct {
 	tags                     atc.Tags
 	checkError               error
 	webhookToken             string
+	webhook                  *atc.WebhookConfig
 	configPinnedVersion      atc.Version
 	apiPinnedVersion         atc.Version
 	resourceConfigCheckError error
@@ -111,6 +116,7 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 		configs = append(configs, atc.ResourceConfig{
 			Name:         r.Name(),
 			WebhookToken: r.WebhookToken(),
+			Webhook:      r.Webhook(),
 			Type:         r.Type(),
 			Source:       r.Source(),
 			CheckEvery:   r.CheckEvery(),
@@ -122,23 +128,25 @@ func (resources Resources) Configs() atc.ResourceConfigs {
 	return configs
 }
 
-func (r *resource) ID() int                          { return r.id }
-func (r *resource) Name() string                     { return r.name }
-func (r *resource) PipelineID() int                  { return r.pipelineID }
-func (r *resource) PipelineName() string             { return r.pipelineName }
-func (r *resource) TeamName() string                 { return r.teamName }
-func (r *resource) Type() string                     { return r.type_ }
-func (r *resource) Source() atc.Source               { return r.source }
-func (r *resource) CheckEvery() string               { return r.checkEvery }
-func (r *resource) CheckTimeout() string             { return r.checkTimeout }
-func (r *resource) LastChecked() time.Time           { return r.lastChecked }
-func (r *resource) Tags() atc.Tags                   { return r.tags }
-func (r *resource) CheckError() error                { return r.checkError }
-func (r *resource) WebhookToken() string             { return r.webhookToken }
-func (r *resource) ConfigPinnedVersion() atc.Version { return r.configPinnedVersion }
-func (r *resource) APIPinnedVersion() atc.Version    { return r.apiPinnedVersion }
-func (r *resource) ResourceConfigCheckError() error  { return r.resourceConfigCheckError }
-func (r *resource) ResourceConfigID() int            { return r.resourceConfigID }
+func (r *resource) ID() int                                { return r.id }
+func (r *resource) Name() string                           { return r.name }
+func (r *resource) PipelineID() int                        { return r.pipelineID }
+func (r *resource) PipelineName() string                   { return r.pipelineName }
+func (r *resource) PipelineInstanceVars() atc.InstanceVars { return r.pipelineInstanceVars }
+func (r *resource) TeamName() string                       { return r.teamName }
+func (r *resource) Type() string                           { return r.type_ }
+func (r *resource) Source() atc.Source                     { return r.source }
+func (r *resource) CheckEvery() string                     { return r.checkEvery }
+func (r *resource) CheckTimeout() string                   { return r.checkTimeout }
+func (r *resource) LastChecked() time.Time                 { return r.lastChecked }
+func (r *resource) Tags() atc.Tags                         { return r.tags }
+func (r *resource) CheckError() error                      { return r.checkError }
+func (r *resource) WebhookToken() string                   { return r.webhookToken }
+func (r *resource) Webhook() *atc.WebhookConfig            { return r.webhook }
+func (r *resource) ConfigPinnedVersion() atc.Version       { return r.configPinnedVersion }
+func (r *resource) APIPinnedVersion() atc.Version          { return r.apiPinnedVersion }
+func (r *resource) ResourceConfigCheckError() error        { return r.resourceConfigCheckError }
+func (r *resource) ResourceConfigID() int                  { return r.resourceConfigID }
 
 func (r *resource) Reload() (bool, error) {
 	row := resourcesQuery.Where(sq.Eq{"r.id": r.id}).
@@ -156,6 +164,34 @@ func (r *resource) Reload() (bool, error) {
 	return true, nil
 }
 
+// Checks returns the resource's checks, most recent first.
+func (r *resource) Checks() ([]Check, error) {
+	rows, err := checksQuery.
+		Where(sq.Eq{"c.resource_id": r.id}).
+		OrderBy("c.id DESC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	checks := []Check{}
+
+	for rows.Next() {
+		c := &check{conn: r.conn, lockFactory: r.lockFactory}
+		err := scanCheck(c, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		checks = append(checks, c)
+	}
+
+	return checks, nil
+}
+
 func (r *resource) SetResourceConfig(logger lager.Logger, source atc.Source, resourceTypes creds.VersionedResourceTypes) (ResourceConfig, error) {
 	resourceConfigDescriptor, err := constructResourceConfigDescriptor(r.type_, source, resourceTypes)
 	if err != nil {
@@ -506,14 +542,22 @@ func scanResource(r *resource, row scannable) error {
 	var (
 		configBlob                                          []byte
 		checkErr, rcCheckErr, nonce, rcID, apiPinnedVersion sql.NullString
+		pipelineInstanceVars                                sql.NullString
 		lastChecked                                         pq.NullTime
 	)
 
-	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &lastChecked, &r.pipelineID, &nonce, &rcID, &r.pipelineName, &r.teamName, &rcCheckErr, &apiPinnedVersion)
+	err := row.Scan(&r.id, &r.name, &configBlob, &checkErr, &lastChecked, &r.pipelineID, &nonce, &rcID, &r.pipelineName, &pipelineInstanceVars, &r.teamName, &rcCheckErr, &apiPinnedVersion)
 	if err != nil {
 		return err
 	}
 
+	if pipelineInstanceVars.Valid {
+		err = json.Unmarshal([]byte(pipelineInstanceVars.String), &r.pipelineInstanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	r.lastChecked = lastChecked.Time
 
 	es := r.conn.EncryptionStrategy()
@@ -540,6 +584,7 @@ func scanResource(r *resource, row scannable) error {
 	r.checkTimeout = config.CheckTimeout
 	r.tags = config.Tags
 	r.webhookToken = config.WebhookToken
+	r.webhook = config.Webhook
 	r.configPinnedVersion = config.Version
 
 	if apiPinnedVersion.Valid {
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -18,6 +19,10 @@ var ErrResourceConfigAlreadyExists = errors.New("resource config already exists"
 var ErrResourceConfigDisappeared = errors.New("resource config disappeared")
 var ErrResourceConfigParentDisappeared = errors.New("resource config parent disappeared")
 
+// WebhookSourceField is the field of resource sources by which the global
+// webhook looks up resource configs.
+var WebhookSourceField = "uri"
+
 // ResourceConfig represents a resource type and config source.
 //
 // Resources in a pipeline, resource types in a pipeline, and `image_resource`
@@ -49,6 +54,7 @@ type ResourceConfig interface {
 		interval time.Duration,
 		immediate bool,
 	) (lock.Lock, bool, error)
+	ClearLastChecked() error
 
 	SaveUncheckedVersion(version atc.Version, metadata ResourceConfigMetadataFields) (bool, error)
 	SaveVersions(versions []atc.Version) error
@@ -123,6 +129,17 @@ func (r *resourceConfig) AcquireResourceConfigCheckingLockWithIntervalCheck(
 	return lock, true, nil
 }
 
+// ClearLastChecked makes the resource config due a check again, for when the
+// check that acquired its checking lock was given up on before running.
+func (r *resourceConfig) ClearLastChecked() error {
+	_, err := psql.Update("resource_configs").
+		Set("last_checked", "epoch").
+		Where(sq.Eq{"id": r.id}).
+		RunWith(r.conn).
+		Exec()
+	return err
+}
+
 func (r *resourceConfig) LatestVersion() (ResourceConfigVersion, bool, error) {
 	rcv := &resourceConfigVersion{
 		conn:           r.conn,
@@ -394,14 +411,21 @@ func (r *ResourceConfigDescriptor) findOrCreate(logger lager.Logger, tx Tx, lock
 	if !found {
 		hash := mapHash(r.Source)
 
-		err := psql.Insert("resource_configs").
+		fieldHashes, err := json.Marshal(sourceFieldHashes(r.Source))
+		if err != nil {
+			return nil, err
+		}
+
+		err = psql.Insert("resource_configs").
 			Columns(
 				parentColumnName,
 				"source_hash",
+				"source_field_hashes",
 			).
 			Values(
 				parentID,
 				hash,
+				string(fieldHashes),
 			).
 			Suffix(`
 				ON CONFLICT (`+parentColumnName+`, source_hash) DO UPDATE SET
@@ -423,6 +447,22 @@ func (r *ResourceConfigDescriptor) findOrCreate(logger lager.Logger, tx Tx, lock
 	return rc, nil
 }
 
+// sourceFieldHashes hashes the value of the source's WebhookSourceField, so
+// that resource configs can be looked up by e.g. a repository's URL. No other
+// fields are hashed, as they may hold credentials.
+func sourceFieldHashes(source atc.Source) map[string]string {
+	hashes := map[string]string{}
+	if value, ok := source[WebhookSourceField].(string); ok {
+		hashes[WebhookSourceField] = sourceFieldHash(value)
+	}
+
+	return hashes
+}
+
+func sourceFieldHash(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
+
 func (r *ResourceConfigDescriptor) find(tx Tx, lockFactory lock.LockFactory, conn Conn) (ResourceConfig, bool, error) {
 	rc := &resourceConfig{
 		lockFactory: lockFactory,
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"encoding/json"
+
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc/db/lock"
 )
@@ -9,6 +11,7 @@ import (
 
 type ResourceFactory interface {
 	VisibleResources([]string) ([]Resource, error)
+	ResourcesBySourceField(field string, values []string) ([]Resource, error)
 }
 
 type resourceFactory struct {
@@ -55,3 +58,60 @@ func (r *resourceFactory) VisibleResources(teamNames []string) ([]Resource, erro
 
 	return resources, nil
 }
+
+// ResourcesBySourceField returns the active resources of unpaused pipelines
+// whose resource config's source has the field set to one of the values, one
+// resource for each resource config. Resources which have not been checked yet
+// have no resource config, and are not returned.
+func (r *resourceFactory) ResourcesBySourceField(field string, values []string) ([]Resource, error) {
+	matches := sq.Or{}
+	for _, value := range values {
+		hashes, err := json.Marshal(map[string]string{field: sourceFieldHash(value)})
+		if err != nil {
+			return nil, err
+		}
+
+		matches = append(matches, sq.Expr("c.source_field_hashes @> ?", string(hashes)))
+	}
+
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	rows, err := resourcesQuery.
+		Where(sq.Eq{
+			"p.paused":   false,
+			"p.archived": false,
+		}).
+		Where(matches).
+		OrderBy("r.id ASC").
+		RunWith(r.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var resources []Resource
+	configs := map[int]bool{}
+
+	for rows.Next() {
+		resource := &resource{conn: r.conn, lockFactory: r.lockFactory}
+
+		err := scanResource(resource, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		if configs[resource.ResourceConfigID()] {
+			continue
+		}
+
+		configs[resource.ResourceConfigID()] = true
+
+		resources = append(resources, resource)
+	}
+
+	return resources, nil
+}
@@ -2,6 +2,7 @@ package db_test
 
 import (
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -19,7 +20,7 @@ var _ = Describe("Resource Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Resources: atc.ResourceConfigs{
 					{Name: "public-pipeline-resource"},
 				},
@@ -27,7 +28,7 @@ var _ = Describe("Resource Factory", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Resources: atc.ResourceConfigs{
 					{Name: "private-pipeline-resource"},
 				},
@@ -52,4 +53,70 @@ var _ = Describe("Resource Factory", func() {
 			Expect(visibleResources[1].TeamName()).To(Equal("other-team"))
 		})
 	})
+
+	Describe("ResourcesBySourceField", func() {
+		setResourceConfig := func(pipeline db.Pipeline, name string, source atc.Source) {
+			resource, found, err := pipeline.Resource(name)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			_, err = resource.SetResourceConfig(logger, source, creds.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			repoSource := atc.Source{"uri": "https://example.com/repo.git", "password": "secret"}
+			setResourceConfig(defaultPipeline, "some-resource", repoSource)
+
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).NotTo(HaveOccurred())
+
+			otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "same-config-resource", Type: "some-base-resource-type"},
+					{Name: "other-branch-resource", Type: "some-base-resource-type"},
+					{Name: "other-repo-resource", Type: "some-base-resource-type"},
+					{Name: "unchecked-resource", Type: "some-base-resource-type"},
+				},
+			}, db.ConfigVersion(0), db.PipelineUnpaused)
+			Expect(err).ToNot(HaveOccurred())
+
+			setResourceConfig(otherPipeline, "same-config-resource", repoSource)
+			setResourceConfig(otherPipeline, "other-branch-resource", atc.Source{"uri": "https://example.com/repo.git", "branch": "other"})
+			setResourceConfig(otherPipeline, "other-repo-resource", atc.Source{"uri": "https://example.com/other.git"})
+
+			pausedPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "paused-pipeline"}, atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "paused-resource", Type: "some-base-resource-type"},
+				},
+			}, db.ConfigVersion(0), db.PipelinePaused)
+			Expect(err).ToNot(HaveOccurred())
+
+			setResourceConfig(pausedPipeline, "paused-resource", atc.Source{"uri": "https://example.com/repo.git", "branch": "paused"})
+		})
+
+		It("returns a resource of unpaused pipelines for each resource config with the field set to one of the values", func() {
+			resources, err := resourceFactory.ResourcesBySourceField("uri", []string{"https://example.com/repo", "https://example.com/repo.git"})
+			Expect(err).ToNot(HaveOccurred())
+
+			names := []string{}
+			for _, resource := range resources {
+				names = append(names, resource.Name())
+			}
+
+			Expect(names).To(Equal([]string{"some-resource", "other-branch-resource"}))
+		})
+
+		It("does not match on other fields", func() {
+			resources, err := resourceFactory.ResourcesBySourceField("branch", []string{"https://example.com/repo.git"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+		})
+
+		It("does not record the values of other fields", func() {
+			resources, err := resourceFactory.ResourcesBySourceField("password", []string{"secret"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(BeEmpty())
+		})
+	})
 })
@@ -17,6 +17,7 @@ import (
 )
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
+var ErrTeamDisappeared = errors.New("team disappeared from db")
 
 //go:generate counterfeiter . Team
 
@@ -31,13 +32,13 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		pausedState PipelinePausedState,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	VisiblePipelines() ([]Pipeline, error)
@@ -55,12 +56,15 @@ type Team interface {
 	IsCheckContainer(string) (bool, error)
 	IsContainerWithinTeam(string, bool) (bool, error)
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.VariablesFactory) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.VariablesFactory) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	SecretRedactionDisabled() bool
+	DisableSecretRedaction(bool) error
 }
 
 type team struct {
@@ -72,6 +76,8 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	secretRedactionDisabled bool
 }
 
 func (t *team) ID() int      { return t.id }
@@ -80,8 +86,42 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+// SecretRedactionDisabled is whether the team opted out of redacting the
+// credentials fetched for its builds from their logs, e.g. to debug a
+// credential manager integration.
+func (t *team) SecretRedactionDisabled() bool { return t.secretRedactionDisabled }
+
+func (t *team) DisableSecretRedaction(disabled bool) error {
+	result, err := psql.Update("teams").
+		Set("disable_secret_redaction", disabled).
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return ErrTeamDisappeared
+	}
+
+	t.secretRedactionDisabled = disabled
+
+	return nil
+}
+
 func (t *team) Delete() error {
-	_, err := psql.Delete("teams").
+	err := deleteArchivedBuildEvents(t.conn, sq.Eq{"team_id": t.id})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("teams").
 		Where(sq.Eq{
 			"name": t.name,
 		}).
@@ -289,7 +329,7 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	pausedState PipelinePausedState,
@@ -299,6 +339,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	instanceVars, err := instanceVarsEq("instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	jobGroups := make(map[string][]string)
 	for _, group := range config.Groups {
 		for _, job := range group.Jobs {
@@ -316,12 +361,16 @@ func (t *team) SavePipeline(
 
 	defer Rollback(tx)
 
-	err = tx.QueryRow(`
-		SELECT COUNT(1)
-		FROM pipelines
-		WHERE name = $1
-	  AND team_id = $2
-	`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("COUNT(1)").
+		From("pipelines").
+		Where(sq.Eq{
+			"name":    pipelineRef.Name,
+			"team_id": t.id,
+		}).
+		Where(instanceVars).
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -332,14 +381,26 @@ func (t *team) SavePipeline(
 			pausedState = PipelinePaused
 		}
 
+		var instanceVarsPayload interface{}
+		if len(pipelineRef.InstanceVars) != 0 {
+			payload, err := json.Marshal(pipelineRef.InstanceVars)
+			if err != nil {
+				return nil, false, err
+			}
+
+			instanceVarsPayload = string(payload)
+		}
+
+		// instances of a pipeline are ordered alongside the existing ones
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":     pipelineName,
-				"groups":   groupsPayload,
-				"version":  sq.Expr("nextval('config_version_seq')"),
-				"ordering": sq.Expr("currval('pipelines_id_seq')"),
-				"paused":   pausedState.Bool(),
-				"team_id":  t.id,
+				"name":          pipelineRef.Name,
+				"instance_vars": instanceVarsPayload,
+				"groups":        groupsPayload,
+				"version":       sq.Expr("nextval('config_version_seq')"),
+				"ordering":      sq.Expr("COALESCE((SELECT MIN(ordering) FROM pipelines WHERE name = ? AND team_id = ?), currval('pipelines_id_seq'))", pipelineRef.Name, t.id),
+				"paused":        pausedState.Bool(),
+				"team_id":       t.id,
 			}).
 			Suffix("RETURNING id").
 			RunWith(tx).
@@ -353,11 +414,13 @@ func (t *team) SavePipeline(
 		update := psql.Update("pipelines").
 			Set("groups", groupsPayload).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
+			Set("archived", false).
 			Where(sq.Eq{
-				"name":    pipelineName,
+				"name":    pipelineRef.Name,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(instanceVars).
 			Suffix("RETURNING id")
 
 		if pausedState != PipelineNoChange {
@@ -477,16 +540,22 @@ func (t *team) SavePipeline(
 	return pipeline, created, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVars, err := instanceVarsEq("p.instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVars).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -505,7 +574,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -526,7 +595,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -544,7 +613,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err := pipelinesQuery.
 		Where(sq.Eq{"team_id": t.id}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -559,7 +628,7 @@ func (t *team) VisiblePipelines() ([]Pipeline, error) {
 	rows, err = pipelinesQuery.
 		Where(sq.NotEq{"team_id": t.id}).
 		Where(sq.Eq{"public": true}).
-		OrderBy("team_id ASC", "ordering ASC").
+		OrderBy("team_id ASC", "ordering ASC", "id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -684,7 +753,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, disable_secret_redaction
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -694,8 +763,8 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, variablesFactory creds.VariablesFactory) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -963,12 +1032,19 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 }
 
 func scanPipeline(p *pipeline, scan scannable) error {
-	var groups sql.NullString
-	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public)
+	var groups, instanceVars sql.NullString
+	err := scan.Scan(&p.id, &p.name, &groups, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &instanceVars)
 	if err != nil {
 		return err
 	}
 
+	if instanceVars.Valid {
+		err = json.Unmarshal([]byte(instanceVars.String), &p.instanceVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -982,6 +1058,21 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	return nil
 }
 
+// instanceVarsEq matches the pipeline instance with exactly the given vars, or
+// the pipeline without any instance vars if there are none.
+func instanceVarsEq(column string, instanceVars atc.InstanceVars) (sq.Sqlizer, error) {
+	if len(instanceVars) == 0 {
+		return sq.Eq{column: nil}, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return sq.Expr(column+" = ?::jsonb", string(payload)), nil
+}
+
 func scanPipelines(conn Conn, lockFactory lock.LockFactory, rows *sql.Rows) ([]Pipeline, error) {
 	defer Close(rows)
 
@@ -1037,6 +1128,7 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&t.secretRedactionDisabled,
 	)
 	if err != nil {
 		return err
@@ -51,9 +51,9 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 	}
 
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, disable_secret_redaction").
+		Values(t.Name, auth, admin, t.DisableSecretRedaction).
+		Suffix("RETURNING id, name, admin, auth, disable_secret_redaction").
 		RunWith(tx).
 		QueryRow()
 
@@ -89,7 +89,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, disable_secret_redaction").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -108,7 +108,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, disable_secret_redaction").
 		From("teams").
 		OrderBy("id ASC").
 		RunWith(factory.conn).
@@ -173,6 +173,7 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&t.secretRedactionDisabled,
 	)
 
 	if providerAuth.Valid {
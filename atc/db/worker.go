@@ -67,6 +67,10 @@ type Worker interface {
 	Prune() error
 	Delete() error
 
+	ActiveTasks() (int, error)
+	IncreaseActiveTasks(max int) (bool, error)
+	DecreaseActiveTasks() error
+
 	FindContainerOnWorker(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 }
@@ -211,16 +215,8 @@ func (worker *worker) Prune() error {
 	}
 
 	if affected == 0 {
-		//check whether the worker exists in the database at all
-		var one int
-		err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
-			RunWith(worker.conn).
-			QueryRow().
-			Scan(&one)
+		err := worker.checkPresent()
 		if err != nil {
-			if err == sql.ErrNoRows {
-				return ErrWorkerNotPresent
-			}
 			return err
 		}
 
@@ -242,6 +238,93 @@ func (worker *worker) Delete() error {
 	return err
 }
 
+// ActiveTasks returns the number of task containers currently running on the
+// worker, as tracked by IncreaseActiveTasks and DecreaseActiveTasks, and
+// reconciled by WorkerLifecycle.ReconcileActiveTasks.
+func (worker *worker) ActiveTasks() (int, error) {
+	var activeTasks int
+	err := psql.Select("active_tasks").
+		From("workers").
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&activeTasks)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrWorkerNotPresent
+		}
+		return 0, err
+	}
+
+	return activeTasks, nil
+}
+
+// IncreaseActiveTasks counts another task as running on the worker, unless it
+// is already running max tasks, in which case false is returned. A max of 0
+// means there is no limit.
+func (worker *worker) IncreaseActiveTasks(max int) (bool, error) {
+	where := sq.And{sq.Eq{"name": worker.name}}
+	if max > 0 {
+		where = append(where, sq.Lt{"active_tasks": max})
+	}
+
+	result, err := psql.Update("workers").
+		Set("active_tasks", sq.Expr("active_tasks + 1")).
+		Where(where).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if count == 0 {
+		err := worker.checkPresent()
+		if err != nil {
+			return false, err
+		}
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// checkPresent returns ErrWorkerNotPresent if the worker is not in the
+// database at all, e.g. to tell why an update of it affected no rows.
+func (worker *worker) checkPresent() error {
+	var one int
+	err := psql.Select("1").From("workers").Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&one)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrWorkerNotPresent
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (worker *worker) DecreaseActiveTasks() error {
+	_, err := psql.Update("workers").
+		Set("active_tasks", sq.Expr("active_tasks - 1")).
+		Where(sq.And{
+			sq.Eq{"name": worker.name},
+			sq.Gt{"active_tasks": 0},
+		}).
+		RunWith(worker.conn).
+		Exec()
+
+	return err
+}
+
 func (worker *worker) ResourceCerts() (*UsedWorkerResourceCerts, bool, error) {
 	if worker.certsPath != nil {
 		wrc := &WorkerResourceCerts{
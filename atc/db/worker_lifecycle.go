@@ -4,6 +4,7 @@ import (
 	"database/sql"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
 )
 
 //go:generate counterfeiter . WorkerLifecycle
@@ -14,6 +15,7 @@ type WorkerLifecycle interface {
 	LandFinishedLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
 	GetWorkerStateByName() (map[string]WorkerState, error)
+	ReconcileActiveTasks() ([]string, error)
 }
 
 type workerLifecycle struct {
@@ -181,6 +183,44 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// ReconcileActiveTasks lowers the active tasks of each worker to the number
+// of task containers on it which belong to running builds. This releases the
+// tasks counted by an ATC which went away before the task finished, and whose
+// build was not resumed since.
+//
+// A task is counted shortly before its container is created, so a task which
+// is just starting may be released too. This errs on the side of placing a
+// task too many rather than having a worker be considered busy forever.
+func (lifecycle *workerLifecycle) ReconcileActiveTasks() ([]string, error) {
+	rows, err := lifecycle.conn.Query(`
+		UPDATE workers w
+		SET active_tasks = t.running
+		FROM (
+			SELECT w.name, count(c.id) AS running
+			FROM workers w
+			LEFT JOIN containers c
+				ON c.worker_name = w.name
+				AND c.meta_type = $1
+				AND c.state IN ($2, $3)
+				AND EXISTS (
+					SELECT 1
+					FROM builds b
+					WHERE b.id = c.build_id
+					AND b.status = $4
+				)
+			GROUP BY w.name
+		) t
+		WHERE w.name = t.name
+		AND w.active_tasks > t.running
+		RETURNING w.name
+	`, string(ContainerTypeTask), atc.ContainerStateCreating, atc.ContainerStateCreated, string(BuildStatusStarted))
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
 func (lifecycle *workerLifecycle) GetWorkerStateByName() (map[string]WorkerState, error) {
 	rows, err := psql.Select(`
 		name,
@@ -224,7 +224,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -256,7 +256,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -471,7 +471,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -503,7 +503,7 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
@@ -553,6 +553,91 @@ var _ = Describe("Worker Lifecycle", func() {
 		})
 	})
 
+	Describe("ReconcileActiveTasks", func() {
+		var dbWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			dbWorker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			for i := 0; i < 3; i++ {
+				increased, err := dbWorker.IncreaseActiveTasks(0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(increased).To(BeTrue())
+			}
+		})
+
+		DescribeTable("counts the task containers of builds that are",
+			func(s db.BuildStatus, expectedActiveTasks int) {
+				dbBuild, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				switch s {
+				case db.BuildStatusStarted:
+					_, err := dbBuild.Start("exec.v2", "{}", atc.Plan{})
+					Expect(err).ToNot(HaveOccurred())
+				default:
+					err := dbBuild.Finish(s)
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				_, err = dbWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(dbBuild.ID(), atc.PlanID("some-task"), defaultTeam.ID()),
+					db.ContainerMetadata{Type: db.ContainerTypeTask},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(dbBuild.ID(), atc.PlanID("some-get"), defaultTeam.ID()),
+					db.ContainerMetadata{Type: db.ContainerTypeGet},
+				)
+				Expect(err).ToNot(HaveOccurred())
+
+				reconciled, err := workerLifecycle.ReconcileActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reconciled).To(Equal([]string{atcWorker.Name}))
+
+				activeTasks, err := dbWorker.ActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(activeTasks).To(Equal(expectedActiveTasks))
+			},
+			Entry("started", db.BuildStatusStarted, 1),
+			Entry("aborted", db.BuildStatusAborted, 0),
+			Entry("succeeded", db.BuildStatusSucceeded, 0),
+		)
+
+		Context("when the worker is running fewer tasks than it has containers for", func() {
+			BeforeEach(func() {
+				Expect(dbWorker.DecreaseActiveTasks()).To(Succeed())
+				Expect(dbWorker.DecreaseActiveTasks()).To(Succeed())
+				Expect(dbWorker.DecreaseActiveTasks()).To(Succeed())
+
+				dbBuild, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbBuild.Start("exec.v2", "{}", atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = dbWorker.CreateContainer(
+					db.NewBuildStepContainerOwner(dbBuild.ID(), atc.PlanID("some-task"), defaultTeam.ID()),
+					db.ContainerMetadata{Type: db.ContainerTypeTask},
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves its active tasks alone", func() {
+				reconciled, err := workerLifecycle.ReconcileActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reconciled).To(BeEmpty())
+
+				activeTasks, err := dbWorker.ActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(activeTasks).To(BeZero())
+			})
+		})
+	})
+
 	Describe("GetWorkersState", func() {
 
 		JustBeforeEach(func() {
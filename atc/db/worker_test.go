@@ -149,6 +149,75 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("ActiveTasks", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("starts at zero", func() {
+			activeTasks, err := worker.ActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeTasks).To(Equal(0))
+		})
+
+		It("is increased and decreased", func() {
+			increased, err := worker.IncreaseActiveTasks(0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(increased).To(BeTrue())
+
+			increased, err = worker.IncreaseActiveTasks(0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(increased).To(BeTrue())
+
+			Expect(worker.DecreaseActiveTasks()).To(Succeed())
+
+			activeTasks, err := worker.ActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeTasks).To(Equal(1))
+		})
+
+		It("is not increased beyond the max", func() {
+			increased, err := worker.IncreaseActiveTasks(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(increased).To(BeTrue())
+
+			increased, err = worker.IncreaseActiveTasks(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(increased).To(BeFalse())
+
+			activeTasks, err := worker.ActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeTasks).To(Equal(1))
+		})
+
+		It("does not go below zero", func() {
+			Expect(worker.DecreaseActiveTasks()).To(Succeed())
+
+			activeTasks, err := worker.ActiveTasks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeTasks).To(Equal(0))
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				Expect(worker.Delete()).To(Succeed())
+			})
+
+			It("returns ErrWorkerNotPresent", func() {
+				_, err := worker.IncreaseActiveTasks(0)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+
+				_, err = worker.IncreaseActiveTasks(1)
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+
+				_, err = worker.ActiveTasks()
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Prune", func() {
 		Context("when worker exists", func() {
 			DescribeTable("worker in state",
@@ -74,7 +74,7 @@ var SanitizeDecodeHook = func(
 	valKind reflect.Kind,
 	data interface{},
 ) (interface{}, error) {
-	if valKind == reflect.Map {
+	if valKind == reflect.Map || valKind == reflect.Interface {
 		if dataKind == reflect.Map {
 			return sanitize(data)
 		}
@@ -0,0 +1,48 @@
+package engine
+
+import (
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/exec"
+)
+
+type acrossDelegate struct {
+	*BuildStepDelegate
+
+	build       db.Build
+	eventOrigin event.Origin
+	clock       clock.Clock
+}
+
+func NewAcrossDelegate(build db.Build, planID atc.PlanID, clock clock.Clock, secrets *creds.Secrets) exec.AcrossDelegate {
+	return &acrossDelegate{
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock, secrets),
+
+		build: build,
+		eventOrigin: event.Origin{
+			ID: event.OriginID(planID),
+		},
+		clock: clock,
+	}
+}
+
+func (d *acrossDelegate) IterationStarted(logger lager.Logger, varName string, index int, value interface{}) {
+	err := d.build.SaveEvent(event.AcrossIteration{
+		Time:   d.clock.Now().Unix(),
+		Origin: d.eventOrigin,
+		Var:    varName,
+		Index:  index,
+		Value:  value,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-across-iteration-event", err)
+		return
+	}
+
+	logger.Info("iteration-started", lager.Data{"var": varName, "index": index})
+}
@@ -1,6 +1,9 @@
 package engine
 
 import (
+	"encoding/json"
+	"fmt"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -37,6 +40,73 @@ func (build *execBuild) buildDoStep(logger lager.Logger, plan atc.Plan) exec.Ste
 	return step
 }
 
+func (build *execBuild) buildAcrossStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("across")
+
+	return build.factory.Across(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.AcrossDelegate(plan.ID),
+		func(index int, factory exec.Factory) (exec.Step, error) {
+			var innerPlan atc.Plan
+			if plan.Across.Step != nil {
+				var err error
+				innerPlan, err = iterationPlan(*plan.Across.Step, index)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				innerPlan = plan.Across.Steps[index].Step
+			}
+
+			innerPlan.Attempts = plan.Attempts
+
+			scoped := *build
+			scoped.factory = factory
+
+			return scoped.buildStep(logger, innerPlan), nil
+		},
+	)
+}
+
+// iterationPlan returns a copy of the plan with the index appended to the IDs
+// of its plans, so that the steps, containers, and events of each iteration
+// of an across step are told apart.
+func iterationPlan(plan atc.Plan, index int) (atc.Plan, error) {
+	payload, err := json.Marshal(plan)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	var iteration atc.Plan
+	err = json.Unmarshal(payload, &iteration)
+	if err != nil {
+		return atc.Plan{}, err
+	}
+
+	ids := map[atc.PlanID]bool{}
+	iteration.Each(func(p *atc.Plan) {
+		ids[p.ID] = true
+	})
+
+	suffixed := func(id atc.PlanID) atc.PlanID {
+		return atc.PlanID(fmt.Sprintf("%s/%d", id, index))
+	}
+
+	iteration.Each(func(p *atc.Plan) {
+		p.ID = suffixed(p.ID)
+
+		// gets may take the version of a put within the same iteration
+		if p.Get != nil && p.Get.VersionFrom != nil && ids[*p.Get.VersionFrom] {
+			versionFrom := suffixed(*p.Get.VersionFrom)
+			p.Get.VersionFrom = &versionFrom
+		}
+	})
+
+	return iteration, nil
+}
+
 func (build *execBuild) buildTimeoutStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	innerPlan := plan.Timeout.Step
 	innerPlan.Attempts = plan.Attempts
@@ -101,6 +171,19 @@ func (build *execBuild) buildTaskStep(logger lager.Logger, plan atc.Plan) exec.S
 	)
 }
 
+func (build *execBuild) buildSetPipelineStep(logger lager.Logger, plan atc.Plan) exec.Step {
+	logger = logger.Session("set-pipeline", lager.Data{
+		"name": plan.SetPipeline.Name,
+	})
+
+	return build.factory.SetPipeline(
+		logger,
+		plan,
+		build.dbBuild,
+		build.delegate.BuildStepDelegate(plan.ID),
+	)
+}
+
 func (build *execBuild) buildGetStep(logger lager.Logger, plan atc.Plan) exec.Step {
 	logger = logger.Session("get", lager.Data{
 		"name": plan.Get.Name,
@@ -2,58 +2,105 @@ package engine
 
 import (
 	"io"
+	"sync"
 	"unicode/utf8"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 )
 
 type BuildStepDelegate struct {
-	build  db.Build
-	planID atc.PlanID
-	clock  clock.Clock
+	build   db.Build
+	planID  atc.PlanID
+	clock   clock.Clock
+	secrets *creds.Secrets
+
+	writersLock sync.Mutex
+	stdout      *dbEventWriter
+	stderr      *dbEventWriter
 }
 
 func NewBuildStepDelegate(
 	build db.Build,
 	planID atc.PlanID,
 	clock clock.Clock,
+	secrets *creds.Secrets,
 ) *BuildStepDelegate {
 	return &BuildStepDelegate{
-		build:  build,
-		planID: planID,
-		clock:  clock,
+		build:   build,
+		planID:  planID,
+		clock:   clock,
+		secrets: secrets,
 	}
 }
 
+func (delegate *BuildStepDelegate) Secrets() *creds.Secrets {
+	return delegate.secrets
+}
+
 func (delegate *BuildStepDelegate) ImageVersionDetermined(resourceCache db.UsedResourceCache) error {
 	return delegate.build.SaveImageResourceVersion(resourceCache)
 }
 
 func (delegate *BuildStepDelegate) Stdout() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStdout,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	delegate.writersLock.Lock()
+	defer delegate.writersLock.Unlock()
+
+	if delegate.stdout == nil {
+		delegate.stdout = newDBEventWriter(
+			delegate.build,
+			event.Origin{
+				Source: event.OriginSourceStdout,
+				ID:     event.OriginID(delegate.planID),
+			},
+			delegate.clock,
+			delegate.secrets,
+		)
+	}
+
+	return delegate.stdout
 }
 
 func (delegate *BuildStepDelegate) Stderr() io.Writer {
-	return newDBEventWriter(
-		delegate.build,
-		event.Origin{
-			Source: event.OriginSourceStderr,
-			ID:     event.OriginID(delegate.planID),
-		},
-		delegate.clock,
-	)
+	delegate.writersLock.Lock()
+	defer delegate.writersLock.Unlock()
+
+	if delegate.stderr == nil {
+		delegate.stderr = newDBEventWriter(
+			delegate.build,
+			event.Origin{
+				Source: event.OriginSourceStderr,
+				ID:     event.OriginID(delegate.planID),
+			},
+			delegate.clock,
+			delegate.secrets,
+		)
+	}
+
+	return delegate.stderr
+}
+
+// Flush saves any output the step's writers are holding back because it
+// might be the start of a secret.
+func (delegate *BuildStepDelegate) Flush(logger lager.Logger) {
+	delegate.writersLock.Lock()
+	defer delegate.writersLock.Unlock()
+
+	for _, writer := range []*dbEventWriter{delegate.stdout, delegate.stderr} {
+		if writer == nil {
+			continue
+		}
+
+		err := writer.flush()
+		if err != nil {
+			logger.Error("failed-to-flush-log-event", err)
+		}
+	}
 }
 
 func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string) {
@@ -68,11 +115,12 @@ func (delegate *BuildStepDelegate) Errored(logger lager.Logger, message string)
 	}
 }
 
-func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock) io.Writer {
+func newDBEventWriter(build db.Build, origin event.Origin, clock clock.Clock, secrets *creds.Secrets) *dbEventWriter {
 	return &dbEventWriter{
-		build:  build,
-		origin: origin,
-		clock:  clock,
+		build:   build,
+		origin:  origin,
+		clock:   clock,
+		secrets: secrets,
 	}
 }
 
@@ -81,12 +129,18 @@ type dbEventWriter struct {
 
 	origin event.Origin
 
+	lock     sync.Mutex
 	dangling []byte
 
 	clock clock.Clock
+
+	secrets *creds.Secrets
 }
 
 func (writer *dbEventWriter) Write(data []byte) (int, error) {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
 	text := append(writer.dangling, data...)
 
 	checkEncoding, _ := utf8.DecodeLastRune(text)
@@ -95,16 +149,44 @@ func (writer *dbEventWriter) Write(data []byte) (int, error) {
 		return len(data), nil
 	}
 
-	writer.dangling = nil
+	// hold back the end of the text if it could be the start of a secret
+	// whose remainder is yet to be written
+	payload := writer.secrets.Redact(string(text))
+	held := writer.secrets.PartialSuffix(payload)
 
-	err := writer.build.SaveEvent(event.Log{
-		Time:    writer.clock.Now().Unix(),
-		Payload: string(text),
-		Origin:  writer.origin,
-	})
+	writer.dangling = []byte(payload[len(payload)-held:])
+
+	payload = payload[:len(payload)-held]
+	if payload == "" {
+		return len(data), nil
+	}
+
+	err := writer.save(payload)
 	if err != nil {
 		return 0, err
 	}
 
 	return len(data), nil
 }
+
+func (writer *dbEventWriter) flush() error {
+	writer.lock.Lock()
+	defer writer.lock.Unlock()
+
+	if len(writer.dangling) == 0 {
+		return nil
+	}
+
+	payload := string(writer.dangling)
+	writer.dangling = nil
+
+	return writer.save(payload)
+}
+
+func (writer *dbEventWriter) save(payload string) error {
+	return writer.build.SaveEvent(event.Log{
+		Time:    writer.clock.Now().Unix(),
+		Payload: payload,
+		Origin:  writer.origin,
+	})
+}
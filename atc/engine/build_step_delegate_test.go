@@ -7,6 +7,8 @@ import (
 
 	"code.cloudfoundry.org/clock/fakeclock"
 
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/engine"
 	"github.com/concourse/concourse/atc/event"
@@ -18,6 +20,7 @@ var _ = Describe("BuildStepDelegate", func() {
 	var (
 		fakeBuild *dbfakes.FakeBuild
 		fakeClock *fakeclock.FakeClock
+		secrets   *creds.Secrets
 
 		delegate *engine.BuildStepDelegate
 	)
@@ -25,7 +28,8 @@ var _ = Describe("BuildStepDelegate", func() {
 	BeforeEach(func() {
 		fakeBuild = new(dbfakes.FakeBuild)
 		fakeClock = fakeclock.NewFakeClock(time.Unix(123456789, 0))
-		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock)
+		secrets = creds.NewSecrets()
+		delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", fakeClock, secrets)
 	})
 
 	Describe("ImageVersionDetermined", func() {
@@ -151,4 +155,54 @@ var _ = Describe("BuildStepDelegate", func() {
 			})
 		})
 	})
+
+	Describe("redacting secrets", func() {
+		var writer io.Writer
+
+		BeforeEach(func() {
+			secrets.Track("some-secret")
+			writer = delegate.Stdout()
+		})
+
+		payloads := func() []string {
+			saved := []string{}
+			for i := 0; i < fakeBuild.SaveEventCallCount(); i++ {
+				saved = append(saved, fakeBuild.SaveEventArgsForCall(i).(event.Log).Payload)
+			}
+			return saved
+		}
+
+		It("replaces secrets in the output", func() {
+			_, err := writer.Write([]byte("the secret is some-secret!\n"))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(payloads()).To(Equal([]string{"the secret is ((redacted))!\n"}))
+		})
+
+		It("redacts secrets split across writes", func() {
+			_, err := writer.Write([]byte("the secret is some-"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payloads()).To(Equal([]string{"the secret is "}))
+
+			_, err = writer.Write([]byte("secret!"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payloads()).To(Equal([]string{"the secret is ", "((redacted))!"}))
+		})
+
+		It("saves held back output when flushed", func() {
+			_, err := writer.Write([]byte("not quite some-sec"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(payloads()).To(Equal([]string{"not quite "}))
+
+			delegate.Flush(lagertest.NewTestLogger("test"))
+			Expect(payloads()).To(Equal([]string{"not quite ", "some-sec"}))
+
+			delegate.Flush(lagertest.NewTestLogger("test"))
+			Expect(payloads()).To(HaveLen(2))
+		})
+
+		It("returns the same writer each time", func() {
+			Expect(delegate.Stdout()).To(BeIdenticalTo(writer))
+		})
+	})
 })
@@ -15,12 +15,13 @@ import (
 
 const trackLockDuration = time.Minute
 
-func NewDBEngine(engines Engines, peerURL string) Engine {
+func NewDBEngine(engines Engines, peerURL string, defaultBuildTimeout time.Duration) Engine {
 	return &dbEngine{
-		engines:   engines,
-		peerURL:   peerURL,
-		releaseCh: make(chan struct{}),
-		waitGroup: new(sync.WaitGroup),
+		engines:             engines,
+		peerURL:             peerURL,
+		defaultBuildTimeout: defaultBuildTimeout,
+		releaseCh:           make(chan struct{}),
+		waitGroup:           new(sync.WaitGroup),
 	}
 }
 
@@ -33,10 +34,11 @@ func (err UnknownEngineError) Error() string {
 }
 
 type dbEngine struct {
-	engines   Engines
-	peerURL   string
-	releaseCh chan struct{}
-	waitGroup *sync.WaitGroup
+	engines             Engines
+	peerURL             string
+	defaultBuildTimeout time.Duration
+	releaseCh           chan struct{}
+	waitGroup           *sync.WaitGroup
 }
 
 func (*dbEngine) Name() string {
@@ -61,21 +63,23 @@ func (engine *dbEngine) CreateBuild(logger lager.Logger, build db.Build, plan at
 	}
 
 	return &dbBuild{
-		engines:   engine.engines,
-		peerURL:   engine.peerURL,
-		releaseCh: engine.releaseCh,
-		waitGroup: engine.waitGroup,
-		build:     build,
+		engines:             engine.engines,
+		peerURL:             engine.peerURL,
+		defaultBuildTimeout: engine.defaultBuildTimeout,
+		releaseCh:           engine.releaseCh,
+		waitGroup:           engine.waitGroup,
+		build:               build,
 	}, nil
 }
 
 func (engine *dbEngine) LookupBuild(logger lager.Logger, build db.Build) (Build, error) {
 	return &dbBuild{
-		engines:   engine.engines,
-		peerURL:   engine.peerURL,
-		releaseCh: engine.releaseCh,
-		waitGroup: engine.waitGroup,
-		build:     build,
+		engines:             engine.engines,
+		peerURL:             engine.peerURL,
+		defaultBuildTimeout: engine.defaultBuildTimeout,
+		releaseCh:           engine.releaseCh,
+		waitGroup:           engine.waitGroup,
+		build:               build,
 	}, nil
 }
 
@@ -96,11 +100,12 @@ func (engine *dbEngine) ReleaseAll(logger lager.Logger) {
 }
 
 type dbBuild struct {
-	engines   Engines
-	peerURL   string
-	releaseCh chan struct{}
-	build     db.Build
-	waitGroup *sync.WaitGroup
+	engines             Engines
+	peerURL             string
+	defaultBuildTimeout time.Duration
+	releaseCh           chan struct{}
+	build               db.Build
+	waitGroup           *sync.WaitGroup
 }
 
 func (build *dbBuild) Metadata() string {
@@ -266,6 +271,11 @@ func (build *dbBuild) Resume(logger lager.Logger) {
 		}
 	}()
 
+	timeout := build.timeout(logger)
+	if timeout > 0 {
+		go build.abortAfter(logger, timeout, done)
+	}
+
 	metric.BuildStarted{
 		PipelineName: build.build.PipelineName(),
 		JobName:      build.build.JobName(),
@@ -305,6 +315,62 @@ func (build *dbBuild) Resume(logger lager.Logger) {
 	}
 }
 
+// timeout returns how long the build may run for: its job's build_timeout, or
+// the default build timeout otherwise. Zero means there's no limit.
+func (build *dbBuild) timeout(logger lager.Logger) time.Duration {
+	if build.build.JobID() == 0 {
+		return build.defaultBuildTimeout
+	}
+
+	pipeline, found, err := build.build.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-find-pipeline", err)
+		return build.defaultBuildTimeout
+	}
+
+	if !found {
+		return build.defaultBuildTimeout
+	}
+
+	job, found, err := pipeline.Job(build.build.JobName())
+	if err != nil {
+		logger.Error("failed-to-find-job", err)
+		return build.defaultBuildTimeout
+	}
+
+	if !found || job.Config().BuildTimeout == "" {
+		return build.defaultBuildTimeout
+	}
+
+	timeout, err := time.ParseDuration(job.Config().BuildTimeout)
+	if err != nil {
+		logger.Error("failed-to-parse-build-timeout", err)
+		return build.defaultBuildTimeout
+	}
+
+	return timeout
+}
+
+// abortAfter aborts the build once it's been running for the given timeout,
+// the same way as a user aborting it would, so that its on_abort hooks run.
+// The deadline is relative to the build's start so that it still holds when
+// the build is resumed by another ATC.
+func (build *dbBuild) abortAfter(logger lager.Logger, timeout time.Duration, done <-chan struct{}) {
+	timer := time.NewTimer(time.Until(build.build.StartTime().Add(timeout)))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		logger.Info("timed-out", lager.Data{"timeout": timeout.String()})
+
+		err := build.build.MarkAsTimedOut()
+		if err != nil {
+			logger.Error("failed-to-mark-build-as-timed-out", err)
+		}
+	case <-done:
+	}
+}
+
 func (build *dbBuild) ReceiveInput(logger lager.Logger, id atc.PlanID, input io.ReadCloser) {
 	buildEngineName := build.build.Engine()
 	if buildEngineName == "" {
@@ -43,7 +43,7 @@ var _ = Describe("DBEngine", func() {
 		dbBuild = new(dbfakes.FakeBuild)
 		dbBuild.IDReturns(128)
 
-		dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB}, "http://10.2.3.4:8080")
+		dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB}, "http://10.2.3.4:8080", 0)
 	})
 
 	Describe("CreateBuild", func() {
@@ -603,6 +603,83 @@ var _ = Describe("DBEngine", func() {
 							})
 						})
 
+						Context("when the build has a timeout", func() {
+							var (
+								fakePipeline *dbfakes.FakePipeline
+								fakeJob      *dbfakes.FakeJob
+							)
+
+							BeforeEach(func() {
+								notifier := new(dbfakes.FakeNotifier)
+								notifier.NotifyReturns(make(chan struct{}))
+								dbBuild.AbortNotifierReturns(notifier, nil)
+
+								fakeJob = new(dbfakes.FakeJob)
+								fakeJob.ConfigReturns(atc.JobConfig{
+									Name:         "some-job",
+									BuildTimeout: "1h",
+								})
+
+								fakePipeline = new(dbfakes.FakePipeline)
+								fakePipeline.JobReturns(fakeJob, true, nil)
+
+								dbBuild.JobIDReturns(42)
+								dbBuild.JobNameReturns("some-job")
+								dbBuild.PipelineReturns(fakePipeline, true, nil)
+							})
+
+							Context("when the build has run for longer than its job's build_timeout", func() {
+								BeforeEach(func() {
+									dbBuild.StartTimeReturns(time.Now().Add(-2 * time.Hour))
+
+									realBuild.ResumeStub = func(lager.Logger) {
+										Eventually(dbBuild.MarkAsTimedOutCallCount).Should(Equal(1))
+									}
+								})
+
+								It("looks up the build's job", func() {
+									Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+								})
+
+								It("aborts the build as timed out", func() {
+									Expect(dbBuild.MarkAsTimedOutCallCount()).To(Equal(1))
+									Expect(dbBuild.MarkAsAbortedCallCount()).To(BeZero())
+								})
+							})
+
+							Context("when the build is within its job's build_timeout", func() {
+								BeforeEach(func() {
+									dbBuild.StartTimeReturns(time.Now())
+								})
+
+								It("does not abort the build", func() {
+									Expect(realBuild.ResumeCallCount()).To(Equal(1))
+									Consistently(dbBuild.MarkAsTimedOutCallCount, 100*time.Millisecond).Should(BeZero())
+								})
+							})
+
+							Context("when the job has no build_timeout", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"})
+
+									dbBuild.StartTimeReturns(time.Now().Add(-2 * time.Hour))
+
+									var err error
+									dbEngine = NewDBEngine(Engines{fakeEngineA, fakeEngineB}, "http://10.2.3.4:8080", time.Hour)
+									build, err = dbEngine.LookupBuild(logger, dbBuild)
+									Expect(err).NotTo(HaveOccurred())
+
+									realBuild.ResumeStub = func(lager.Logger) {
+										Eventually(dbBuild.MarkAsTimedOutCallCount).Should(Equal(1))
+									}
+								})
+
+								It("aborts the build after the default build timeout", func() {
+									Expect(dbBuild.MarkAsTimedOutCallCount()).To(Equal(1))
+								})
+							})
+						})
+
 						Context("when listening for aborts fails", func() {
 							disaster := errors.New("oh no!")
 
@@ -11,6 +11,17 @@ import (
 )
 
 type FakeBuildDelegate struct {
+	AcrossDelegateStub        func(atc.PlanID) exec.AcrossDelegate
+	acrossDelegateMutex       sync.RWMutex
+	acrossDelegateArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	acrossDelegateReturns struct {
+		result1 exec.AcrossDelegate
+	}
+	acrossDelegateReturnsOnCall map[int]struct {
+		result1 exec.AcrossDelegate
+	}
 	BuildStepDelegateStub        func(atc.PlanID) exec.BuildStepDelegate
 	buildStepDelegateMutex       sync.RWMutex
 	buildStepDelegateArgsForCall []struct {
@@ -66,6 +77,66 @@ type FakeBuildDelegate struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBuildDelegate) AcrossDelegate(arg1 atc.PlanID) exec.AcrossDelegate {
+	fake.acrossDelegateMutex.Lock()
+	ret, specificReturn := fake.acrossDelegateReturnsOnCall[len(fake.acrossDelegateArgsForCall)]
+	fake.acrossDelegateArgsForCall = append(fake.acrossDelegateArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("AcrossDelegate", []interface{}{arg1})
+	fake.acrossDelegateMutex.Unlock()
+	if fake.AcrossDelegateStub != nil {
+		return fake.AcrossDelegateStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.acrossDelegateReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateCallCount() int {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	return len(fake.acrossDelegateArgsForCall)
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateCalls(stub func(atc.PlanID) exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = stub
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateArgsForCall(i int) atc.PlanID {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
+	argsForCall := fake.acrossDelegateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateReturns(result1 exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = nil
+	fake.acrossDelegateReturns = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
+func (fake *FakeBuildDelegate) AcrossDelegateReturnsOnCall(i int, result1 exec.AcrossDelegate) {
+	fake.acrossDelegateMutex.Lock()
+	defer fake.acrossDelegateMutex.Unlock()
+	fake.AcrossDelegateStub = nil
+	if fake.acrossDelegateReturnsOnCall == nil {
+		fake.acrossDelegateReturnsOnCall = make(map[int]struct {
+			result1 exec.AcrossDelegate
+		})
+	}
+	fake.acrossDelegateReturnsOnCall[i] = struct {
+		result1 exec.AcrossDelegate
+	}{result1}
+}
+
 func (fake *FakeBuildDelegate) BuildStepDelegate(arg1 atc.PlanID) exec.BuildStepDelegate {
 	fake.buildStepDelegateMutex.Lock()
 	ret, specificReturn := fake.buildStepDelegateReturnsOnCall[len(fake.buildStepDelegateArgsForCall)]
@@ -85,6 +156,8 @@ func (fake *FakeBuildDelegate) BuildStepDelegate(arg1 atc.PlanID) exec.BuildStep
 }
 
 func (fake *FakeBuildDelegate) BuildStepDelegateCallCount() int {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	return len(fake.buildStepDelegateArgsForCall)
@@ -97,6 +170,8 @@ func (fake *FakeBuildDelegate) BuildStepDelegateCalls(stub func(atc.PlanID) exec
 }
 
 func (fake *FakeBuildDelegate) BuildStepDelegateArgsForCall(i int) atc.PlanID {
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	argsForCall := fake.buildStepDelegateArgsForCall[i]
@@ -342,6 +417,8 @@ func (fake *FakeBuildDelegate) TaskDelegateReturnsOnCall(i int, result1 exec.Tas
 func (fake *FakeBuildDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acrossDelegateMutex.RLock()
+	defer fake.acrossDelegateMutex.RUnlock()
 	fake.buildStepDelegateMutex.RLock()
 	defer fake.buildStepDelegateMutex.RUnlock()
 	fake.finishMutex.RLock()
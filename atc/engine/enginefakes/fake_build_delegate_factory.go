@@ -4,15 +4,17 @@ package enginefakes
 import (
 	sync "sync"
 
+	lager "code.cloudfoundry.org/lager"
 	db "github.com/concourse/concourse/atc/db"
 	engine "github.com/concourse/concourse/atc/engine"
 )
 
 type FakeBuildDelegateFactory struct {
-	DelegateStub        func(db.Build) engine.BuildDelegate
+	DelegateStub        func(lager.Logger, db.Build) engine.BuildDelegate
 	delegateMutex       sync.RWMutex
 	delegateArgsForCall []struct {
-		arg1 db.Build
+		arg1 lager.Logger
+		arg2 db.Build
 	}
 	delegateReturns struct {
 		result1 engine.BuildDelegate
@@ -24,16 +26,17 @@ type FakeBuildDelegateFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildDelegateFactory) Delegate(arg1 db.Build) engine.BuildDelegate {
+func (fake *FakeBuildDelegateFactory) Delegate(arg1 lager.Logger, arg2 db.Build) engine.BuildDelegate {
 	fake.delegateMutex.Lock()
 	ret, specificReturn := fake.delegateReturnsOnCall[len(fake.delegateArgsForCall)]
 	fake.delegateArgsForCall = append(fake.delegateArgsForCall, struct {
-		arg1 db.Build
-	}{arg1})
-	fake.recordInvocation("Delegate", []interface{}{arg1})
+		arg1 lager.Logger
+		arg2 db.Build
+	}{arg1, arg2})
+	fake.recordInvocation("Delegate", []interface{}{arg1, arg2})
 	fake.delegateMutex.Unlock()
 	if fake.DelegateStub != nil {
-		return fake.DelegateStub(arg1)
+		return fake.DelegateStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -48,17 +51,17 @@ func (fake *FakeBuildDelegateFactory) DelegateCallCount() int {
 	return len(fake.delegateArgsForCall)
 }
 
-func (fake *FakeBuildDelegateFactory) DelegateCalls(stub func(db.Build) engine.BuildDelegate) {
+func (fake *FakeBuildDelegateFactory) DelegateCalls(stub func(lager.Logger, db.Build) engine.BuildDelegate) {
 	fake.delegateMutex.Lock()
 	defer fake.delegateMutex.Unlock()
 	fake.DelegateStub = stub
 }
 
-func (fake *FakeBuildDelegateFactory) DelegateArgsForCall(i int) db.Build {
+func (fake *FakeBuildDelegateFactory) DelegateArgsForCall(i int) (lager.Logger, db.Build) {
 	fake.delegateMutex.RLock()
 	defer fake.delegateMutex.RUnlock()
 	argsForCall := fake.delegateArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeBuildDelegateFactory) DelegateReturns(result1 engine.BuildDelegate) {
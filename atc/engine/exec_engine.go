@@ -13,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 type execMetadata struct {
@@ -58,7 +59,7 @@ func (engine *execEngine) CreateBuild(logger lager.Logger, build db.Build, plan
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
 		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
+		delegate: engine.delegateFactory.Delegate(logger, build),
 		metadata: execMetadata{
 			Plan: plan,
 		},
@@ -88,7 +89,7 @@ func (engine *execEngine) LookupBuild(logger lager.Logger, build db.Build) (Buil
 		stepMetadata: buildMetadata(build, engine.externalURL),
 
 		factory:  engine.factory,
-		delegate: engine.delegateFactory.Delegate(build),
+		delegate: engine.delegateFactory.Delegate(logger, build),
 		metadata: metadata,
 
 		ctx:    ctx,
@@ -148,7 +149,16 @@ func (build *execBuild) Abort(lager.Logger) error {
 func (build *execBuild) Resume(logger lager.Logger) {
 	step := build.buildStep(logger, build.metadata.Plan)
 
-	runCtx := lagerctx.NewContext(build.ctx, logger)
+	runCtx, span := tracing.StartSpan(build.ctx, "build", tracing.Attrs{
+		"team":     build.dbBuild.TeamName(),
+		"pipeline": build.dbBuild.PipelineName(),
+		"job":      build.dbBuild.JobName(),
+		"build":    build.dbBuild.Name(),
+		"build-id": strconv.Itoa(build.dbBuild.ID()),
+	})
+	defer span.End()
+
+	runCtx = lagerctx.NewContext(runCtx, logger)
 
 	state := build.runState()
 	defer build.clearRunState()
@@ -164,6 +174,10 @@ func (build *execBuild) Resume(logger lager.Logger) {
 			logger.Info("releasing")
 			return
 		case err := <-done:
+			if err != nil {
+				span.SetError(err)
+			}
+
 			build.delegate.Finish(logger.Session("finish"), err, step.Succeeded())
 			return
 		}
@@ -196,6 +210,10 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildDoStep(logger, plan)
 	}
 
+	if plan.Across != nil {
+		return build.buildAcrossStep(logger, plan)
+	}
+
 	if plan.Timeout != nil {
 		return build.buildTimeoutStep(logger, plan)
 	}
@@ -224,6 +242,10 @@ func (build *execBuild) buildStep(logger lager.Logger, plan atc.Plan) exec.Step
 		return build.buildTaskStep(logger, plan)
 	}
 
+	if plan.SetPipeline != nil {
+		return build.buildSetPipelineStep(logger, plan)
+	}
+
 	if plan.Get != nil {
 		return build.buildGetStep(logger, plan)
 	}
@@ -267,6 +289,7 @@ func (build *execBuild) containerMetadata(
 		PipelineName: build.dbBuild.PipelineName(),
 		JobName:      build.dbBuild.JobName(),
 		BuildName:    build.dbBuild.Name(),
+		TeamName:     build.dbBuild.TeamName(),
 
 		StepName: stepName,
 		Attempt:  strings.Join(attemptStrs, "."),
@@ -2,10 +2,12 @@ package engine
 
 import (
 	"context"
+	"sync"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
 )
@@ -16,6 +18,7 @@ type BuildDelegate interface {
 	GetDelegate(atc.PlanID) exec.GetDelegate
 	PutDelegate(atc.PlanID) exec.PutDelegate
 	TaskDelegate(atc.PlanID) exec.TaskDelegate
+	AcrossDelegate(atc.PlanID) exec.AcrossDelegate
 
 	BuildStepDelegate(atc.PlanID) exec.BuildStepDelegate
 
@@ -25,46 +28,111 @@ type BuildDelegate interface {
 //go:generate counterfeiter . BuildDelegateFactory
 
 type BuildDelegateFactory interface {
-	Delegate(db.Build) BuildDelegate
+	Delegate(lager.Logger, db.Build) BuildDelegate
 }
 
-type buildDelegateFactory struct{}
+type buildDelegateFactory struct {
+	teamFactory db.TeamFactory
+}
 
-func NewBuildDelegateFactory() BuildDelegateFactory {
-	return buildDelegateFactory{}
+func NewBuildDelegateFactory(teamFactory db.TeamFactory) BuildDelegateFactory {
+	return buildDelegateFactory{
+		teamFactory: teamFactory,
+	}
 }
 
-func (factory buildDelegateFactory) Delegate(build db.Build) BuildDelegate {
-	return newBuildDelegate(build)
+func (factory buildDelegateFactory) Delegate(logger lager.Logger, build db.Build) BuildDelegate {
+	return newBuildDelegate(build, factory.secrets(logger, build))
+}
+
+// secrets returns what the build's steps track the credentials they fetch
+// with, or nil if the build's team opted out of having them redacted from its
+// logs.
+func (factory buildDelegateFactory) secrets(logger lager.Logger, build db.Build) *creds.Secrets {
+	team, found, err := factory.teamFactory.FindTeam(build.TeamName())
+	if err != nil {
+		// err on the side of redacting
+		logger.Error("failed-to-find-team", err)
+	} else if found && team.SecretRedactionDisabled() {
+		return nil
+	}
+
+	return creds.NewSecrets()
 }
 
 type delegate struct {
 	build db.Build
+
+	// secrets collects the credentials interpolated by every step of the
+	// build, so that they're redacted from all of its logs. It's nil if the
+	// build's team opted out of redaction.
+	secrets *creds.Secrets
+
+	stepsLock sync.Mutex
+	steps     []flusher
 }
 
-func newBuildDelegate(build db.Build) BuildDelegate {
+type flusher interface {
+	Flush(lager.Logger)
+}
+
+func newBuildDelegate(build db.Build, secrets *creds.Secrets) BuildDelegate {
 	return &delegate{
-		build: build,
+		build:   build,
+		secrets: secrets,
 	}
 }
 
 func (delegate *delegate) GetDelegate(planID atc.PlanID) exec.GetDelegate {
-	return NewGetDelegate(delegate.build, planID, clock.NewClock())
+	stepDelegate := NewGetDelegate(delegate.build, planID, clock.NewClock(), delegate.secrets)
+	delegate.track(stepDelegate)
+	return stepDelegate
 }
 
 func (delegate *delegate) PutDelegate(planID atc.PlanID) exec.PutDelegate {
-	return NewPutDelegate(delegate.build, planID, clock.NewClock())
+	stepDelegate := NewPutDelegate(delegate.build, planID, clock.NewClock(), delegate.secrets)
+	delegate.track(stepDelegate)
+	return stepDelegate
 }
 
 func (delegate *delegate) TaskDelegate(planID atc.PlanID) exec.TaskDelegate {
-	return NewTaskDelegate(delegate.build, planID, clock.NewClock())
+	stepDelegate := NewTaskDelegate(delegate.build, planID, clock.NewClock(), delegate.secrets)
+	delegate.track(stepDelegate)
+	return stepDelegate
+}
+
+func (delegate *delegate) AcrossDelegate(planID atc.PlanID) exec.AcrossDelegate {
+	stepDelegate := NewAcrossDelegate(delegate.build, planID, clock.NewClock(), delegate.secrets)
+	delegate.track(stepDelegate)
+	return stepDelegate
 }
 
 func (delegate *delegate) BuildStepDelegate(planID atc.PlanID) exec.BuildStepDelegate {
-	return NewBuildStepDelegate(delegate.build, planID, clock.NewClock())
+	stepDelegate := NewBuildStepDelegate(delegate.build, planID, clock.NewClock(), delegate.secrets)
+	delegate.track(stepDelegate)
+	return stepDelegate
+}
+
+// track remembers the step delegate so that any output its writers held back
+// is saved before the build finishes.
+func (delegate *delegate) track(step exec.BuildStepDelegate) {
+	flusher, ok := step.(flusher)
+	if !ok {
+		return
+	}
+
+	delegate.stepsLock.Lock()
+	delegate.steps = append(delegate.steps, flusher)
+	delegate.stepsLock.Unlock()
 }
 
 func (delegate *delegate) Finish(logger lager.Logger, err error, succeeded bool) {
+	delegate.stepsLock.Lock()
+	for _, step := range delegate.steps {
+		step.Flush(logger)
+	}
+	delegate.stepsLock.Unlock()
+
 	if err == context.Canceled {
 		delegate.saveStatus(logger, atc.StatusAborted)
 		logger.Info("aborted")
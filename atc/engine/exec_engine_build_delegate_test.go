@@ -17,7 +17,9 @@ var _ = Describe("BuildDelegate", func() {
 	var (
 		factory BuildDelegateFactory
 
-		fakeBuild *dbfakes.FakeBuild
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakeBuild       *dbfakes.FakeBuild
 
 		delegate BuildDelegate
 
@@ -25,17 +27,61 @@ var _ = Describe("BuildDelegate", func() {
 	)
 
 	BeforeEach(func() {
-		factory = NewBuildDelegateFactory()
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+		factory = NewBuildDelegateFactory(fakeTeamFactory)
 
 		fakeBuild = new(dbfakes.FakeBuild)
-		delegate = factory.Delegate(fakeBuild)
+		fakeBuild.TeamNameReturns("some-team")
 
 		logger = lagertest.NewTestLogger("test")
 	})
 
+	JustBeforeEach(func() {
+		delegate = factory.Delegate(logger, fakeBuild)
+	})
+
+	Describe("secrets", func() {
+		It("looks up the build's team once for all of its steps", func() {
+			delegate.TaskDelegate("some-plan")
+			delegate.GetDelegate("some-other-plan")
+
+			Expect(fakeTeamFactory.FindTeamCallCount()).To(Equal(1))
+			Expect(fakeTeamFactory.FindTeamArgsForCall(0)).To(Equal("some-team"))
+		})
+
+		It("gives every step the same secrets to track", func() {
+			secrets := delegate.TaskDelegate("some-plan").Secrets()
+			Expect(secrets).NotTo(BeNil())
+			Expect(delegate.GetDelegate("some-other-plan").Secrets()).To(BeIdenticalTo(secrets))
+		})
+
+		Context("when the team opted out of redaction", func() {
+			BeforeEach(func() {
+				fakeTeam.SecretRedactionDisabledReturns(true)
+			})
+
+			It("gives the steps no secrets to track", func() {
+				Expect(delegate.TaskDelegate("some-plan").Secrets()).To(BeNil())
+			})
+		})
+
+		Context("when finding the team fails", func() {
+			BeforeEach(func() {
+				fakeTeamFactory.FindTeamReturns(nil, false, errors.New("nope"))
+			})
+
+			It("redacts anyway", func() {
+				Expect(delegate.TaskDelegate("some-plan").Secrets()).NotTo(BeNil())
+			})
+		})
+	})
+
 	Describe("Finish", func() {
 		Context("when build was aborted", func() {
-			BeforeEach(func() {
+			JustBeforeEach(func() {
 				delegate.Finish(logger, context.Canceled, false)
 			})
 
@@ -46,7 +92,7 @@ var _ = Describe("BuildDelegate", func() {
 		})
 
 		Context("when build had error", func() {
-			BeforeEach(func() {
+			JustBeforeEach(func() {
 				delegate.Finish(logger, errors.New("disaster"), false)
 			})
 
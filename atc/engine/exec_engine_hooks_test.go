@@ -167,6 +167,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 						StepName:     "some-input",
 						Type:         db.ContainerTypeGet,
 					}))
@@ -185,6 +186,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 						StepName:     "some-completion-task",
 						Type:         db.ContainerTypeTask,
 					}))
@@ -203,6 +205,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 						StepName:     "some-failure-task",
 						Type:         db.ContainerTypeTask,
 					}))
@@ -221,6 +224,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 						StepName:     "some-success-task",
 						Type:         db.ContainerTypeTask,
 					}))
@@ -239,6 +243,7 @@ var _ = Describe("Exec Engine With Hooks", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 						StepName:     "some-next-task",
 						Type:         db.ContainerTypeTask,
 					}))
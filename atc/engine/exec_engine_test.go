@@ -155,6 +155,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 
 					logger, plan, build, stepMetadata, containerMetadata, _ = fakeFactory.PutArgsForCall(1)
@@ -171,6 +172,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 				})
 			})
@@ -251,6 +253,7 @@ var _ = Describe("ExecEngine", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 					Attempt:      "1",
 				}))
 			})
@@ -272,6 +275,7 @@ var _ = Describe("ExecEngine", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 					Attempt:      "3",
 				}))
 			})
@@ -296,6 +300,7 @@ var _ = Describe("ExecEngine", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 					Attempt:      "2.1",
 				}))
 
@@ -314,6 +319,7 @@ var _ = Describe("ExecEngine", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 					Attempt:      "2.2",
 				}))
 			})
@@ -381,6 +387,92 @@ var _ = Describe("ExecEngine", func() {
 			})
 		})
 
+		Context("with an across plan", func() {
+			var (
+				taskPlan   atc.Plan
+				acrossPlan atc.Plan
+				acrossStep *execfakes.FakeStep
+			)
+
+			BeforeEach(func() {
+				taskPlan = planFactory.NewPlan(atc.TaskPlan{
+					Name:   "some-task",
+					Config: &atc.TaskConfig{},
+				})
+
+				acrossStep = new(execfakes.FakeStep)
+				acrossStep.SucceededReturns(true)
+				fakeFactory.AcrossReturns(acrossStep)
+			})
+
+			Context("when the values come from a var", func() {
+				BeforeEach(func() {
+					acrossPlan = planFactory.NewPlan(atc.AcrossPlan{
+						Var:       "some-var",
+						ValuesVar: "some-values",
+						Step:      &taskPlan,
+					})
+				})
+
+				It("builds each iteration from the step with distinct plan IDs", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, acrossPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.AcrossCallCount()).To(Equal(1))
+
+					_, plan, _, _, newStep := fakeFactory.AcrossArgsForCall(0)
+					Expect(plan).To(Equal(acrossPlan))
+
+					scopedFactory := new(execfakes.FakeFactory)
+					scopedFactory.TaskReturns(taskStep)
+
+					step, err := newStep(1, scopedFactory)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(step).NotTo(BeNil())
+
+					Expect(fakeFactory.TaskCallCount()).To(BeZero())
+					Expect(scopedFactory.TaskCallCount()).To(Equal(1))
+
+					_, plan, _, _, _ = scopedFactory.TaskArgsForCall(0)
+					Expect(plan.ID).To(Equal(taskPlan.ID + "/1"))
+					Expect(plan.Task).To(Equal(taskPlan.Task))
+				})
+			})
+
+			Context("when the values are static", func() {
+				BeforeEach(func() {
+					acrossPlan = planFactory.NewPlan(atc.AcrossPlan{
+						Var: "some-var",
+						Steps: []atc.VarScopedPlan{
+							{Step: taskPlan, Value: "a"},
+							{Step: planFactory.NewPlan(atc.TaskPlan{Name: "other-task"}), Value: "b"},
+						},
+					})
+				})
+
+				It("builds each iteration from its own plan", func() {
+					build, err := execEngine.CreateBuild(logger, dbBuild, acrossPlan)
+					Expect(err).NotTo(HaveOccurred())
+
+					build.Resume(logger)
+					Expect(fakeFactory.AcrossCallCount()).To(Equal(1))
+
+					_, _, _, _, newStep := fakeFactory.AcrossArgsForCall(0)
+
+					scopedFactory := new(execfakes.FakeFactory)
+					scopedFactory.TaskReturns(taskStep)
+
+					_, err = newStep(1, scopedFactory)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(scopedFactory.TaskCallCount()).To(Equal(1))
+					_, plan, _, _, _ := scopedFactory.TaskArgsForCall(0)
+					Expect(plan.ID).To(Equal(acrossPlan.Across.Steps[1].Step.ID))
+				})
+			})
+		})
+
 		Context("with a basic plan", func() {
 			var expectedPlan atc.Plan
 
@@ -419,6 +511,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 				})
 			})
@@ -467,6 +560,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 				})
 			})
@@ -525,6 +619,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 				})
 
@@ -550,6 +645,7 @@ var _ = Describe("ExecEngine", func() {
 						JobName:      "some-job",
 						BuildID:      expectedBuildID,
 						BuildName:    "42",
+						TeamName:     "some-team",
 					}))
 				})
 			})
@@ -624,6 +720,7 @@ var _ = Describe("ExecEngine", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 					Attempt:      "1",
 				}))
 			})
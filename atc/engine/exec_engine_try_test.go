@@ -124,6 +124,7 @@ var _ = Describe("Exec Engine with Try", func() {
 					JobName:      "some-job",
 					BuildID:      expectedBuildID,
 					BuildName:    "42",
+					TeamName:     "some-team",
 				}))
 			})
 		})
@@ -7,21 +7,22 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
 )
 
 type taskDelegate struct {
-	exec.BuildStepDelegate
+	*BuildStepDelegate
 
 	build       db.Build
 	eventOrigin event.Origin
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, planID atc.PlanID, clock clock.Clock, secrets *creds.Secrets) exec.TaskDelegate {
 	return &taskDelegate{
-		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock),
+		BuildStepDelegate: NewBuildStepDelegate(build, planID, clock, secrets),
 
 		build: build,
 		eventOrigin: event.Origin{
@@ -44,6 +45,19 @@ func (d *taskDelegate) Initializing(logger lager.Logger, taskConfig atc.TaskConf
 	logger.Debug("initializing")
 }
 
+func (d *taskDelegate) WaitingForWorker(logger lager.Logger) {
+	err := d.build.SaveEvent(event.WaitingForWorker{
+		Origin: d.eventOrigin,
+		Time:   time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-waiting-for-worker-event", err)
+		return
+	}
+
+	logger.Debug("waiting-for-worker")
+}
+
 func (d *taskDelegate) Starting(logger lager.Logger, taskConfig atc.TaskConfig) {
 	err := d.build.SaveEvent(event.StartTask{
 		Origin:     d.eventOrigin,
@@ -59,6 +73,8 @@ func (d *taskDelegate) Starting(logger lager.Logger, taskConfig atc.TaskConfig)
 }
 
 func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus) {
+	d.Flush(logger)
+
 	err := d.build.SaveEvent(event.FinishTask{
 		ExitStatus: int(exitStatus),
 		Time:       time.Now().Unix(),
@@ -71,3 +87,33 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
+
+func (d *taskDelegate) Annotated(logger lager.Logger, annotations map[string]string) {
+	err := d.build.SaveEvent(event.Annotations{
+		Origin:      d.eventOrigin,
+		Time:        time.Now().Unix(),
+		Annotations: annotations,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-annotations-event", err)
+		return
+	}
+
+	err = d.build.Annotate(annotations)
+	if err != nil {
+		logger.Error("failed-to-annotate-build", err)
+		return
+	}
+
+	logger.Debug("annotated", lager.Data{"annotations": annotations})
+}
+
+func (d *taskDelegate) TestResults(logger lager.Logger, results []atc.TestResult) {
+	err := d.build.SaveTestResults(results)
+	if err != nil {
+		logger.Error("failed-to-save-test-results", err)
+		return
+	}
+
+	logger.Debug("saved-test-results", lager.Data{"results": len(results)})
+}
@@ -82,6 +82,9 @@ func (StartTask) Version() atc.EventVersion { return "5.0" }
 type Status struct {
 	Status atc.BuildStatus `json:"status"`
 	Time   int64           `json:"time"`
+
+	// Reason is why an aborted build was aborted, if not by a user.
+	Reason string `json:"reason,omitempty"`
 }
 
 func (Status) EventType() atc.EventType  { return EventTypeStatus }
@@ -129,3 +132,31 @@ type FinishPut struct {
 
 func (FinishPut) EventType() atc.EventType  { return EventTypeFinishPut }
 func (FinishPut) Version() atc.EventVersion { return "5.0" }
+
+type AcrossIteration struct {
+	Time   int64       `json:"time"`
+	Origin Origin      `json:"origin"`
+	Var    string      `json:"var"`
+	Index  int         `json:"index"`
+	Value  interface{} `json:"value"`
+}
+
+func (AcrossIteration) EventType() atc.EventType  { return EventTypeAcrossIteration }
+func (AcrossIteration) Version() atc.EventVersion { return "1.0" }
+
+type WaitingForWorker struct {
+	Time   int64  `json:"time"`
+	Origin Origin `json:"origin"`
+}
+
+func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
+func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
+
+type Annotations struct {
+	Time        int64             `json:"time"`
+	Origin      Origin            `json:"origin"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+func (Annotations) EventType() atc.EventType  { return EventTypeAnnotations }
+func (Annotations) Version() atc.EventVersion { return "1.0" }
@@ -41,6 +41,9 @@ func init() {
 	registerEvent(Status{})
 	registerEvent(Log{})
 	registerEvent(Error{})
+	registerEvent(AcrossIteration{})
+	registerEvent(WaitingForWorker{})
+	registerEvent(Annotations{})
 
 	// deprecated:
 	registerEvent(InitializeV10{})
@@ -90,6 +93,15 @@ type Envelope struct {
 	Version atc.EventVersion `json:"version"`
 }
 
+// WebSocketMessage is a build event, or the end of a build's events, as sent
+// over a WebSocket. It carries the same ID, name and envelope as the
+// corresponding Server-Sent Event.
+type WebSocketMessage struct {
+	ID   uint             `json:"id"`
+	Name string           `json:"name"`
+	Data *json.RawMessage `json:"data,omitempty"`
+}
+
 func (m Message) MarshalJSON() ([]byte, error) {
 	var envelope Envelope
 
@@ -29,4 +29,13 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// iteration of an across step started
+	EventTypeAcrossIteration atc.EventType = "across-iteration"
+
+	// task waiting for a worker with capacity to run it
+	EventTypeWaitingForWorker atc.EventType = "waiting-for-worker"
+
+	// task annotated the build with metadata
+	EventTypeAnnotations atc.EventType = "annotations"
 )
@@ -0,0 +1,67 @@
+package eventstore
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/flag"
+)
+
+type Config struct {
+	Type string `long:"build-event-store" default:"postgres" choice:"postgres" choice:"disk" choice:"s3" description:"Where to keep the events of completed builds. Events of running builds are always kept in Postgres."`
+
+	DiskPath flag.Dir `long:"build-event-store-disk-path" description:"Directory to keep build events in when using the disk store. Must be shared by all web nodes."`
+
+	S3Bucket         string `long:"build-event-store-s3-bucket"           description:"Bucket to keep build events in when using the S3 store."`
+	S3Prefix         string `long:"build-event-store-s3-prefix"           default:"build-events/" description:"Prefix for the keys of build event objects."`
+	S3Region         string `long:"build-event-store-s3-region"           default:"us-east-1" description:"Region of the bucket."`
+	S3Endpoint       string `long:"build-event-store-s3-endpoint"         description:"Endpoint of an S3-compatible API, e.g. a MinIO server. Defaults to AWS."`
+	S3AccessKeyID    string `long:"build-event-store-s3-access-key-id"    description:"Access key ID to use. Defaults to the usual AWS credential chain."`
+	S3SecretKey      string `long:"build-event-store-s3-secret-access-key" description:"Secret access key to use."`
+	S3ForcePathStyle bool   `long:"build-event-store-s3-force-path-style" description:"Address the bucket in the path rather than the host name, as needed by most S3-compatible stores."`
+}
+
+// NewStore returns the configured event store to archive the events of
+// completed builds to, or nil if they are to stay in Postgres, the default
+// store of every connection.
+func (config Config) NewStore() (db.EventStore, error) {
+	switch config.Type {
+	case "disk":
+		if config.DiskPath == "" {
+			return nil, errors.New("--build-event-store-disk-path must be specified for the disk build event store")
+		}
+
+		return NewDiskStore(config.DiskPath.Path())
+
+	case "s3":
+		if config.S3Bucket == "" {
+			return nil, errors.New("--build-event-store-s3-bucket must be specified for the s3 build event store")
+		}
+
+		awsConfig := aws.NewConfig().
+			WithRegion(config.S3Region).
+			WithS3ForcePathStyle(config.S3ForcePathStyle)
+
+		if config.S3Endpoint != "" {
+			awsConfig = awsConfig.WithEndpoint(config.S3Endpoint)
+		}
+
+		if config.S3AccessKeyID != "" {
+			awsConfig = awsConfig.WithCredentials(credentials.NewStaticCredentials(config.S3AccessKeyID, config.S3SecretKey, ""))
+		}
+
+		sess, err := session.NewSession(awsConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewS3Store(s3.New(sess), config.S3Bucket, config.S3Prefix), nil
+
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,79 @@
+package eventstore
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/event"
+)
+
+// DiskStore keeps the events of each build in a file in a directory on the
+// local disk. Every web node serving build logs must share the directory,
+// e.g. via a network filesystem.
+type DiskStore struct {
+	dir string
+}
+
+func NewDiskStore(dir string) (*DiskStore, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiskStore{dir: dir}, nil
+}
+
+func (store *DiskStore) Put(buildID int, events []event.Envelope) error {
+	tmp, err := ioutil.TempFile(store.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	err = encodeEvents(tmp, events)
+	if err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	// rename so that readers never see a partially written file
+	return os.Rename(tmp.Name(), store.path(buildID))
+}
+
+func (store *DiskStore) Get(buildID int, from uint, limit int) ([]event.Envelope, error) {
+	file, err := os.Open(store.path(buildID))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []event.Envelope{}, nil
+		}
+
+		return nil, err
+	}
+
+	defer file.Close()
+
+	return decodeEvents(file, from, limit)
+}
+
+func (store *DiskStore) Delete(buildIDs []int) error {
+	for _, buildID := range buildIDs {
+		err := os.Remove(store.path(buildID))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (store *DiskStore) path(buildID int) string {
+	return filepath.Join(store.dir, fmt.Sprintf("%d.json.gz", buildID))
+}
@@ -0,0 +1,61 @@
+package eventstore
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+
+	"github.com/concourse/concourse/atc/event"
+)
+
+// Events are stored as gzipped JSON, one envelope per line, so that large
+// build logs can be written and read without holding the encoding in memory
+// twice.
+
+func encodeEvents(w io.Writer, events []event.Envelope) error {
+	gz := gzip.NewWriter(w)
+
+	enc := json.NewEncoder(gz)
+	for _, ev := range events {
+		err := enc.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return gz.Close()
+}
+
+// decodeEvents returns at most limit events, starting from the event with the
+// given index. Events before it are skipped without being kept.
+func decodeEvents(r io.Reader, from uint, limit int) ([]event.Envelope, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	defer gz.Close()
+
+	events := []event.Envelope{}
+
+	dec := json.NewDecoder(gz)
+	for index := uint(0); len(events) < limit; index++ {
+		var ev event.Envelope
+		err := dec.Decode(&ev)
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if index < from {
+			continue
+		}
+
+		events = append(events, ev)
+	}
+
+	return events, nil
+}
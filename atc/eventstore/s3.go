@@ -0,0 +1,105 @@
+package eventstore
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// the most objects that can be deleted with a single request
+const s3DeleteBatchSize = 1000
+
+// S3Store keeps the events of each build in an object in an S3 bucket, or a
+// bucket in any store with an S3-compatible API such as MinIO.
+type S3Store struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+func NewS3Store(client s3iface.S3API, bucket string, prefix string) *S3Store {
+	return &S3Store{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (store *S3Store) Put(buildID int, events []event.Envelope) error {
+	buf := new(bytes.Buffer)
+
+	err := encodeEvents(buf, events)
+	if err != nil {
+		return err
+	}
+
+	_, err = store.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(store.bucket),
+		Key:         aws.String(store.key(buildID)),
+		Body:        bytes.NewReader(buf.Bytes()),
+		ContentType: aws.String("application/gzip"),
+	})
+
+	return err
+}
+
+func (store *S3Store) Get(buildID int, from uint, limit int) ([]event.Envelope, error) {
+	output, err := store.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(buildID)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return []event.Envelope{}, nil
+		}
+
+		return nil, err
+	}
+
+	defer output.Body.Close()
+
+	return decodeEvents(output.Body, from, limit)
+}
+
+func (store *S3Store) Delete(buildIDs []int) error {
+	for start := 0; start < len(buildIDs); start += s3DeleteBatchSize {
+		end := start + s3DeleteBatchSize
+		if end > len(buildIDs) {
+			end = len(buildIDs)
+		}
+
+		objects := []*s3.ObjectIdentifier{}
+		for _, buildID := range buildIDs[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{
+				Key: aws.String(store.key(buildID)),
+			})
+		}
+
+		output, err := store.client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(store.bucket),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(output.Errors) > 0 {
+			failure := output.Errors[0]
+			return fmt.Errorf("failed to delete %s: %s", aws.StringValue(failure.Key), aws.StringValue(failure.Message))
+		}
+	}
+
+	return nil
+}
+
+func (store *S3Store) key(buildID int) string {
+	return fmt.Sprintf("%s%d.json.gz", store.prefix, buildID)
+}
@@ -0,0 +1,186 @@
+package eventstore_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/eventstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func envelope(payload string) event.Envelope {
+	data := json.RawMessage(fmt.Sprintf(`{"payload":%q}`, payload))
+	return event.Envelope{
+		Data:    &data,
+		Event:   event.Log{}.EventType(),
+		Version: event.Log{}.Version(),
+	}
+}
+
+func itBehavesLikeAnEventStore(newStore func() db.EventStore) {
+	var store db.EventStore
+
+	BeforeEach(func() {
+		store = newStore()
+	})
+
+	It("returns the events that were put", func() {
+		events := []event.Envelope{envelope("hello\n"), envelope("world\n")}
+		Expect(store.Put(1, events)).To(Succeed())
+
+		stored, err := store.Get(1, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(Equal(events))
+	})
+
+	It("returns the events from the given index, up to the limit", func() {
+		events := []event.Envelope{envelope("one"), envelope("two"), envelope("three")}
+		Expect(store.Put(1, events)).To(Succeed())
+
+		stored, err := store.Get(1, 1, 1)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(Equal([]event.Envelope{envelope("two")}))
+
+		stored, err = store.Get(1, 3, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(BeEmpty())
+	})
+
+	It("keeps builds apart", func() {
+		Expect(store.Put(1, []event.Envelope{envelope("one")})).To(Succeed())
+		Expect(store.Put(2, []event.Envelope{envelope("two")})).To(Succeed())
+
+		stored, err := store.Get(2, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(Equal([]event.Envelope{envelope("two")}))
+	})
+
+	It("stores builds without events", func() {
+		Expect(store.Put(1, []event.Envelope{})).To(Succeed())
+
+		stored, err := store.Get(1, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(BeEmpty())
+	})
+
+	It("returns no events for builds that were never put", func() {
+		stored, err := store.Get(42, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(BeEmpty())
+	})
+
+	It("deletes builds", func() {
+		Expect(store.Put(1, []event.Envelope{envelope("one")})).To(Succeed())
+		Expect(store.Put(2, []event.Envelope{envelope("two")})).To(Succeed())
+
+		Expect(store.Delete([]int{1, 3})).To(Succeed())
+
+		stored, err := store.Get(1, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(BeEmpty())
+
+		stored, err = store.Get(2, 0, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(HaveLen(1))
+	})
+}
+
+var _ = Describe("DiskStore", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "event-store")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	itBehavesLikeAnEventStore(func() db.EventStore {
+		store, err := eventstore.NewDiskStore(dir)
+		Expect(err).ToNot(HaveOccurred())
+		return store
+	})
+})
+
+// The S3 store is tested against a MinIO server, e.g.:
+//
+//	docker run -p 9000:9000 -e MINIO_ACCESS_KEY=minio -e MINIO_SECRET_KEY=minio123 minio/minio server /data
+//	MINIO_ENDPOINT=http://127.0.0.1:9000 MINIO_ACCESS_KEY=minio MINIO_SECRET_KEY=minio123 ginkgo atc/eventstore
+var _ = Describe("S3Store", func() {
+	var (
+		client *s3.S3
+		bucket string
+	)
+
+	BeforeEach(func() {
+		endpoint := os.Getenv("MINIO_ENDPOINT")
+		if endpoint == "" {
+			Skip("MINIO_ENDPOINT must be set to test the S3 store")
+		}
+
+		sess, err := session.NewSession(aws.NewConfig().
+			WithEndpoint(endpoint).
+			WithRegion("us-east-1").
+			WithS3ForcePathStyle(true).
+			WithCredentials(credentials.NewStaticCredentials(os.Getenv("MINIO_ACCESS_KEY"), os.Getenv("MINIO_SECRET_KEY"), "")))
+		Expect(err).ToNot(HaveOccurred())
+
+		client = s3.New(sess)
+
+		bucket = fmt.Sprintf("event-store-%d", time.Now().UnixNano())
+		_, err = client.CreateBucket(&s3.CreateBucketInput{Bucket: aws.String(bucket)})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		if client == nil {
+			return
+		}
+
+		objects, err := client.ListObjects(&s3.ListObjectsInput{Bucket: aws.String(bucket)})
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, object := range objects.Contents {
+			_, err := client.DeleteObject(&s3.DeleteObjectInput{Bucket: aws.String(bucket), Key: object.Key})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		_, err = client.DeleteBucket(&s3.DeleteBucketInput{Bucket: aws.String(bucket)})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	itBehavesLikeAnEventStore(func() db.EventStore {
+		return eventstore.NewS3Store(client, bucket, "build-events/")
+	})
+})
+
+var _ = Describe("Config", func() {
+	It("keeps events in postgres by default", func() {
+		store, err := eventstore.Config{Type: "postgres"}.NewStore()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store).To(BeNil())
+	})
+
+	It("requires a path for the disk store", func() {
+		_, err := eventstore.Config{Type: "disk"}.NewStore()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("requires a bucket for the s3 store", func() {
+		_, err := eventstore.Config{Type: "s3"}.NewStore()
+		Expect(err).To(HaveOccurred())
+	})
+})
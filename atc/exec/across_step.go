@@ -0,0 +1,142 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+//go:generate counterfeiter . AcrossDelegate
+
+type AcrossDelegate interface {
+	BuildStepDelegate
+
+	IterationStarted(logger lager.Logger, varName string, index int, value interface{})
+}
+
+// AcrossStepFactory constructs the step to run for the value at the index
+// with the given factory, which binds the value to the across step's var as a
+// local var.
+type AcrossStepFactory func(index int, factory Factory) (Step, error)
+
+// AcrossStep is a step of steps to run in parallel, one for each value of a
+// var. It behaves like an AggregateStep, except that at most MaxInFlight of
+// its steps will run at once.
+type AcrossStep struct {
+	varName     string
+	values      creds.List
+	factory     Factory
+	newStep     AcrossStepFactory
+	maxInFlight int
+	delegate    AcrossDelegate
+
+	steps []Step
+}
+
+func Across(
+	varName string,
+	values creds.List,
+	factory Factory,
+	newStep AcrossStepFactory,
+	maxInFlight int,
+	delegate AcrossDelegate,
+) Step {
+	return &AcrossStep{
+		varName:     varName,
+		values:      values,
+		factory:     factory,
+		newStep:     newStep,
+		maxInFlight: maxInFlight,
+		delegate:    delegate,
+	}
+}
+
+// Run resolves the values, which may be given by a var, and constructs a step
+// for each of them. It then starts the steps in order, waiting for a running
+// step to exit whenever MaxInFlight steps are already running. A MaxInFlight
+// of 0 runs all of the steps at once.
+//
+// Like an AggregateStep, it will wait for all started steps to exit, even if
+// one step fails or errors, and their errors (if any) will be aggregated and
+// returned as a single error. If the context is canceled, no further steps
+// will be started.
+func (step *AcrossStep) Run(ctx context.Context, state RunState) error {
+	logger := lagerctx.FromContext(ctx)
+
+	values, err := step.values.Evaluate()
+	if err != nil {
+		return fmt.Errorf("failed to resolve the values of %s: %s", step.varName, err)
+	}
+
+	step.steps = make([]Step, len(values))
+	for i, value := range values {
+		step.steps[i], err = step.newStep(i, step.factory.WithLocalVar(step.varName, value))
+		if err != nil {
+			return err
+		}
+	}
+
+	maxInFlight := step.maxInFlight
+	if maxInFlight <= 0 || maxInFlight > len(step.steps) {
+		maxInFlight = len(step.steps)
+	}
+
+	inFlight := make(chan struct{}, maxInFlight)
+	errs := make(chan error, len(step.steps))
+
+	started := 0
+
+dispatch:
+	for i, s := range step.steps {
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
+		}
+
+		started++
+
+		go func(index int, s Step) {
+			defer func() { <-inFlight }()
+
+			step.delegate.IterationStarted(logger, step.varName, index, values[index])
+
+			errs <- s.Run(ctx, state)
+		}(i, s)
+	}
+
+	var errorMessages []string
+	for i := 0; i < started; i++ {
+		err := <-errs
+		if err != nil {
+			errorMessages = append(errorMessages, err.Error())
+		}
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("one or more across steps errored:\n%s", strings.Join(errorMessages, "\n"))
+	}
+
+	return nil
+}
+
+// Succeeded is true if all of the steps' Succeeded is true
+func (step *AcrossStep) Succeeded() bool {
+	succeeded := true
+
+	for _, step := range step.steps {
+		if !step.Succeeded() {
+			succeeded = false
+		}
+	}
+
+	return succeeded
+}
@@ -0,0 +1,281 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/concourse/atc/creds"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/worker"
+
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeStepA *execfakes.FakeStep
+		fakeStepB *execfakes.FakeStep
+
+		fakeDelegate *execfakes.FakeAcrossDelegate
+
+		variables       template.StaticVariables
+		fakeFactory     *execfakes.FakeFactory
+		scopedFactories []*execfakes.FakeFactory
+		stepFactories   []Factory
+		newStepErr      error
+
+		maxInFlight int
+
+		repo  *worker.ArtifactRepository
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeStepA = new(execfakes.FakeStep)
+		fakeStepB = new(execfakes.FakeStep)
+
+		fakeDelegate = new(execfakes.FakeAcrossDelegate)
+
+		variables = template.StaticVariables{
+			"go_versions": []interface{}{"1.10", "1.11"},
+		}
+
+		fakeFactory = new(execfakes.FakeFactory)
+		scopedFactories = []*execfakes.FakeFactory{
+			new(execfakes.FakeFactory),
+			new(execfakes.FakeFactory),
+		}
+		fakeFactory.WithLocalVarReturnsOnCall(0, scopedFactories[0])
+		fakeFactory.WithLocalVarReturnsOnCall(1, scopedFactories[1])
+
+		stepFactories = nil
+		newStepErr = nil
+
+		maxInFlight = 0
+
+		repo = worker.NewArtifactRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across(
+			"go_version",
+			creds.NewList(variables, "((go_versions))"),
+			fakeFactory,
+			func(index int, factory Factory) (Step, error) {
+				stepFactories = append(stepFactories, factory)
+
+				if newStepErr != nil {
+					return nil, newStepErr
+				}
+
+				return []Step{fakeStepA, fakeStepB}[index], nil
+			},
+			maxInFlight,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+	})
+
+	It("constructs each step with a factory binding its value to the var", func() {
+		Expect(fakeFactory.WithLocalVarCallCount()).To(Equal(2))
+
+		name, value := fakeFactory.WithLocalVarArgsForCall(0)
+		Expect(name).To(Equal("go_version"))
+		Expect(value).To(Equal("1.10"))
+
+		name, value = fakeFactory.WithLocalVarArgsForCall(1)
+		Expect(name).To(Equal("go_version"))
+		Expect(value).To(Equal("1.11"))
+
+		Expect(stepFactories).To(Equal([]Factory{scopedFactories[0], scopedFactories[1]}))
+	})
+
+	It("runs each step with the run state", func() {
+		Expect(fakeStepA.RunCallCount()).To(Equal(1))
+		_, runState := fakeStepA.RunArgsForCall(0)
+		Expect(runState).To(Equal(state))
+
+		Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		_, runState = fakeStepB.RunArgsForCall(0)
+		Expect(runState).To(Equal(state))
+	})
+
+	It("notifies the delegate of each iteration", func() {
+		Expect(fakeDelegate.IterationStartedCallCount()).To(Equal(2))
+
+		values := map[int]interface{}{}
+		for i := 0; i < 2; i++ {
+			_, varName, index, value := fakeDelegate.IterationStartedArgsForCall(i)
+			Expect(varName).To(Equal("go_version"))
+			values[index] = value
+		}
+
+		Expect(values).To(Equal(map[int]interface{}{0: "1.10", 1: "1.11"}))
+	})
+
+	Context("when max_in_flight is not set", func() {
+		BeforeEach(func() {
+			wg := new(sync.WaitGroup)
+			wg.Add(2)
+
+			fakeStepA.RunStub = func(context.Context, RunState) error {
+				wg.Done()
+				wg.Wait()
+				return nil
+			}
+
+			fakeStepB.RunStub = func(context.Context, RunState) error {
+				wg.Done()
+				wg.Wait()
+				return nil
+			}
+		})
+
+		It("runs the steps concurrently", func() {
+			Expect(fakeStepA.RunCallCount()).To(Equal(1))
+			Expect(fakeStepB.RunCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when max_in_flight is 1", func() {
+		var order []string
+
+		BeforeEach(func() {
+			maxInFlight = 1
+			order = nil
+
+			fakeStepA.RunStub = func(context.Context, RunState) error {
+				order = append(order, "a-start")
+				order = append(order, "a-end")
+				return nil
+			}
+
+			fakeStepB.RunStub = func(context.Context, RunState) error {
+				order = append(order, "b-start")
+				order = append(order, "b-end")
+				return nil
+			}
+		})
+
+		It("runs the steps one at a time, in order", func() {
+			Expect(order).To(Equal([]string{"a-start", "a-end", "b-start", "b-end"}))
+		})
+
+		Context("when canceled while the first step is running", func() {
+			BeforeEach(func() {
+				fakeStepA.RunStub = func(context.Context, RunState) error {
+					cancel()
+					return nil
+				}
+			})
+
+			It("does not start the remaining steps", func() {
+				Expect(fakeStepA.RunCallCount()).To(Equal(1))
+				Expect(fakeStepB.RunCallCount()).To(BeZero())
+			})
+
+			It("returns ctx.Err()", func() {
+				Expect(stepErr).To(Equal(context.Canceled))
+			})
+		})
+	})
+
+	Context("when the values cannot be resolved", func() {
+		BeforeEach(func() {
+			variables = template.StaticVariables{}
+		})
+
+		It("returns an error without running any steps", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(fakeStepA.RunCallCount()).To(BeZero())
+			Expect(fakeStepB.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the var's value is empty", func() {
+		BeforeEach(func() {
+			variables = template.StaticVariables{"go_versions": []interface{}{}}
+		})
+
+		It("succeeds without running any steps", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeStepA.RunCallCount()).To(BeZero())
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+	})
+
+	Context("when constructing a step fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			newStepErr = disaster
+		})
+
+		It("returns the error without running any steps", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(fakeStepA.RunCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when steps fail", func() {
+		disasterA := errors.New("nope A")
+		disasterB := errors.New("nope B")
+
+		BeforeEach(func() {
+			fakeStepA.RunReturns(disasterA)
+			fakeStepB.RunReturns(disasterB)
+		})
+
+		It("exits with an error including the original message", func() {
+			Expect(stepErr.Error()).To(ContainSubstring("nope A"))
+			Expect(stepErr.Error()).To(ContainSubstring("nope B"))
+		})
+	})
+
+	Describe("Succeeded", func() {
+		Context("when all steps are successful", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(true)
+			})
+
+			It("yields true", func() {
+				Expect(step.Succeeded()).To(BeTrue())
+			})
+		})
+
+		Context("when some steps are not successful", func() {
+			BeforeEach(func() {
+				fakeStepA.SucceededReturns(true)
+				fakeStepB.SucceededReturns(false)
+			})
+
+			It("yields false", func() {
+				Expect(step.Succeeded()).To(BeFalse())
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
+	creds "github.com/concourse/concourse/atc/creds"
 	db "github.com/concourse/concourse/atc/db"
 	exec "github.com/concourse/concourse/atc/exec"
 )
@@ -28,6 +29,16 @@ type FakeBuildStepDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SecretsStub        func() *creds.Secrets
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct {
+	}
+	secretsReturns struct {
+		result1 *creds.Secrets
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 *creds.Secrets
+	}
 	StderrStub        func() io.Writer
 	stderrMutex       sync.RWMutex
 	stderrArgsForCall []struct {
@@ -144,6 +155,58 @@ func (fake *FakeBuildStepDelegate) ImageVersionDeterminedReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeBuildStepDelegate) Secrets() *creds.Secrets {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Secrets", []interface{}{})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildStepDelegate) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeBuildStepDelegate) SecretsCalls(stub func() *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = stub
+}
+
+func (fake *FakeBuildStepDelegate) SecretsReturns(result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
+func (fake *FakeBuildStepDelegate) SecretsReturnsOnCall(i int, result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 *creds.Secrets
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
 func (fake *FakeBuildStepDelegate) Stderr() io.Writer {
 	fake.stderrMutex.Lock()
 	ret, specificReturn := fake.stderrReturnsOnCall[len(fake.stderrArgsForCall)]
@@ -255,6 +318,8 @@ func (fake *FakeBuildStepDelegate) Invocations() map[string][][]interface{} {
 	defer fake.erroredMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
 	fake.stderrMutex.RLock()
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
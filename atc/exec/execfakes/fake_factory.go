@@ -11,6 +11,21 @@ import (
 )
 
 type FakeFactory struct {
+	AcrossStub        func(lager.Logger, atc.Plan, db.Build, exec.AcrossDelegate, exec.AcrossStepFactory) exec.Step
+	acrossMutex       sync.RWMutex
+	acrossArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.AcrossDelegate
+		arg5 exec.AcrossStepFactory
+	}
+	acrossReturns struct {
+		result1 exec.Step
+	}
+	acrossReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	GetStub        func(lager.Logger, atc.Plan, db.Build, exec.StepMetadata, db.ContainerMetadata, exec.GetDelegate) exec.Step
 	getMutex       sync.RWMutex
 	getArgsForCall []struct {
@@ -43,6 +58,20 @@ type FakeFactory struct {
 	putReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	SetPipelineStub        func(lager.Logger, atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step
+	setPipelineMutex       sync.RWMutex
+	setPipelineArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.BuildStepDelegate
+	}
+	setPipelineReturns struct {
+		result1 exec.Step
+	}
+	setPipelineReturnsOnCall map[int]struct {
+		result1 exec.Step
+	}
 	TaskStub        func(lager.Logger, atc.Plan, db.Build, db.ContainerMetadata, exec.TaskDelegate) exec.Step
 	taskMutex       sync.RWMutex
 	taskArgsForCall []struct {
@@ -58,10 +87,86 @@ type FakeFactory struct {
 	taskReturnsOnCall map[int]struct {
 		result1 exec.Step
 	}
+	WithLocalVarStub        func(string, interface{}) exec.Factory
+	withLocalVarMutex       sync.RWMutex
+	withLocalVarArgsForCall []struct {
+		arg1 string
+		arg2 interface{}
+	}
+	withLocalVarReturns struct {
+		result1 exec.Factory
+	}
+	withLocalVarReturnsOnCall map[int]struct {
+		result1 exec.Factory
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeFactory) Across(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.AcrossDelegate, arg5 exec.AcrossStepFactory) exec.Step {
+	fake.acrossMutex.Lock()
+	ret, specificReturn := fake.acrossReturnsOnCall[len(fake.acrossArgsForCall)]
+	fake.acrossArgsForCall = append(fake.acrossArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.AcrossDelegate
+		arg5 exec.AcrossStepFactory
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("Across", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.acrossMutex.Unlock()
+	if fake.AcrossStub != nil {
+		return fake.AcrossStub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.acrossReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) AcrossCallCount() int {
+	fake.acrossMutex.RLock()
+	defer fake.acrossMutex.RUnlock()
+	return len(fake.acrossArgsForCall)
+}
+
+func (fake *FakeFactory) AcrossCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.AcrossDelegate, exec.AcrossStepFactory) exec.Step) {
+	fake.acrossMutex.Lock()
+	defer fake.acrossMutex.Unlock()
+	fake.AcrossStub = stub
+}
+
+func (fake *FakeFactory) AcrossArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.AcrossDelegate, exec.AcrossStepFactory) {
+	fake.acrossMutex.RLock()
+	defer fake.acrossMutex.RUnlock()
+	argsForCall := fake.acrossArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeFactory) AcrossReturns(result1 exec.Step) {
+	fake.acrossMutex.Lock()
+	defer fake.acrossMutex.Unlock()
+	fake.AcrossStub = nil
+	fake.acrossReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) AcrossReturnsOnCall(i int, result1 exec.Step) {
+	fake.acrossMutex.Lock()
+	defer fake.acrossMutex.Unlock()
+	fake.AcrossStub = nil
+	if fake.acrossReturnsOnCall == nil {
+		fake.acrossReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.acrossReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeFactory) Get(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.StepMetadata, arg5 db.ContainerMetadata, arg6 exec.GetDelegate) exec.Step {
 	fake.getMutex.Lock()
 	ret, specificReturn := fake.getReturnsOnCall[len(fake.getArgsForCall)]
@@ -192,6 +297,69 @@ func (fake *FakeFactory) PutReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeFactory) SetPipeline(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 exec.BuildStepDelegate) exec.Step {
+	fake.setPipelineMutex.Lock()
+	ret, specificReturn := fake.setPipelineReturnsOnCall[len(fake.setPipelineArgsForCall)]
+	fake.setPipelineArgsForCall = append(fake.setPipelineArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 atc.Plan
+		arg3 db.Build
+		arg4 exec.BuildStepDelegate
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SetPipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setPipelineMutex.Unlock()
+	if fake.SetPipelineStub != nil {
+		return fake.SetPipelineStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) SetPipelineCallCount() int {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	return len(fake.setPipelineArgsForCall)
+}
+
+func (fake *FakeFactory) SetPipelineCalls(stub func(lager.Logger, atc.Plan, db.Build, exec.BuildStepDelegate) exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = stub
+}
+
+func (fake *FakeFactory) SetPipelineArgsForCall(i int) (lager.Logger, atc.Plan, db.Build, exec.BuildStepDelegate) {
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
+	argsForCall := fake.setPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeFactory) SetPipelineReturns(result1 exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = nil
+	fake.setPipelineReturns = struct {
+		result1 exec.Step
+	}{result1}
+}
+
+func (fake *FakeFactory) SetPipelineReturnsOnCall(i int, result1 exec.Step) {
+	fake.setPipelineMutex.Lock()
+	defer fake.setPipelineMutex.Unlock()
+	fake.SetPipelineStub = nil
+	if fake.setPipelineReturnsOnCall == nil {
+		fake.setPipelineReturnsOnCall = make(map[int]struct {
+			result1 exec.Step
+		})
+	}
+	fake.setPipelineReturnsOnCall[i] = struct {
+		result1 exec.Step
+	}{result1}
+}
+
 func (fake *FakeFactory) Task(arg1 lager.Logger, arg2 atc.Plan, arg3 db.Build, arg4 db.ContainerMetadata, arg5 exec.TaskDelegate) exec.Step {
 	fake.taskMutex.Lock()
 	ret, specificReturn := fake.taskReturnsOnCall[len(fake.taskArgsForCall)]
@@ -256,15 +424,82 @@ func (fake *FakeFactory) TaskReturnsOnCall(i int, result1 exec.Step) {
 	}{result1}
 }
 
+func (fake *FakeFactory) WithLocalVar(arg1 string, arg2 interface{}) exec.Factory {
+	fake.withLocalVarMutex.Lock()
+	ret, specificReturn := fake.withLocalVarReturnsOnCall[len(fake.withLocalVarArgsForCall)]
+	fake.withLocalVarArgsForCall = append(fake.withLocalVarArgsForCall, struct {
+		arg1 string
+		arg2 interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("WithLocalVar", []interface{}{arg1, arg2})
+	fake.withLocalVarMutex.Unlock()
+	if fake.WithLocalVarStub != nil {
+		return fake.WithLocalVarStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.withLocalVarReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeFactory) WithLocalVarCallCount() int {
+	fake.withLocalVarMutex.RLock()
+	defer fake.withLocalVarMutex.RUnlock()
+	return len(fake.withLocalVarArgsForCall)
+}
+
+func (fake *FakeFactory) WithLocalVarCalls(stub func(string, interface{}) exec.Factory) {
+	fake.withLocalVarMutex.Lock()
+	defer fake.withLocalVarMutex.Unlock()
+	fake.WithLocalVarStub = stub
+}
+
+func (fake *FakeFactory) WithLocalVarArgsForCall(i int) (string, interface{}) {
+	fake.withLocalVarMutex.RLock()
+	defer fake.withLocalVarMutex.RUnlock()
+	argsForCall := fake.withLocalVarArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeFactory) WithLocalVarReturns(result1 exec.Factory) {
+	fake.withLocalVarMutex.Lock()
+	defer fake.withLocalVarMutex.Unlock()
+	fake.WithLocalVarStub = nil
+	fake.withLocalVarReturns = struct {
+		result1 exec.Factory
+	}{result1}
+}
+
+func (fake *FakeFactory) WithLocalVarReturnsOnCall(i int, result1 exec.Factory) {
+	fake.withLocalVarMutex.Lock()
+	defer fake.withLocalVarMutex.Unlock()
+	fake.WithLocalVarStub = nil
+	if fake.withLocalVarReturnsOnCall == nil {
+		fake.withLocalVarReturnsOnCall = make(map[int]struct {
+			result1 exec.Factory
+		})
+	}
+	fake.withLocalVarReturnsOnCall[i] = struct {
+		result1 exec.Factory
+	}{result1}
+}
+
 func (fake *FakeFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acrossMutex.RLock()
+	defer fake.acrossMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
 	fake.putMutex.RLock()
 	defer fake.putMutex.RUnlock()
+	fake.setPipelineMutex.RLock()
+	defer fake.setPipelineMutex.RUnlock()
 	fake.taskMutex.RLock()
 	defer fake.taskMutex.RUnlock()
+	fake.withLocalVarMutex.RLock()
+	defer fake.withLocalVarMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -6,6 +6,7 @@ import (
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
+	creds "github.com/concourse/concourse/atc/creds"
 	db "github.com/concourse/concourse/atc/db"
 	exec "github.com/concourse/concourse/atc/exec"
 )
@@ -35,6 +36,16 @@ type FakeGetDelegate struct {
 	imageVersionDeterminedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SecretsStub        func() *creds.Secrets
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct {
+	}
+	secretsReturns struct {
+		result1 *creds.Secrets
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 *creds.Secrets
+	}
 	StderrStub        func() io.Writer
 	stderrMutex       sync.RWMutex
 	stderrArgsForCall []struct {
@@ -184,6 +195,58 @@ func (fake *FakeGetDelegate) ImageVersionDeterminedReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeGetDelegate) Secrets() *creds.Secrets {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Secrets", []interface{}{})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeGetDelegate) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeGetDelegate) SecretsCalls(stub func() *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = stub
+}
+
+func (fake *FakeGetDelegate) SecretsReturns(result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
+func (fake *FakeGetDelegate) SecretsReturnsOnCall(i int, result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 *creds.Secrets
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
 func (fake *FakeGetDelegate) Stderr() io.Writer {
 	fake.stderrMutex.Lock()
 	ret, specificReturn := fake.stderrReturnsOnCall[len(fake.stderrArgsForCall)]
@@ -297,6 +360,8 @@ func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
 	defer fake.finishedMutex.RUnlock()
 	fake.imageVersionDeterminedMutex.RLock()
 	defer fake.imageVersionDeterminedMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
 	fake.stderrMutex.RLock()
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
@@ -7,11 +7,18 @@ import (
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
+	creds "github.com/concourse/concourse/atc/creds"
 	db "github.com/concourse/concourse/atc/db"
 	exec "github.com/concourse/concourse/atc/exec"
 )
 
 type FakeTaskDelegate struct {
+	AnnotatedStub        func(lager.Logger, map[string]string)
+	annotatedMutex       sync.RWMutex
+	annotatedArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}
 	ErroredStub        func(lager.Logger, string)
 	erroredMutex       sync.RWMutex
 	erroredArgsForCall []struct {
@@ -41,6 +48,16 @@ type FakeTaskDelegate struct {
 		arg1 lager.Logger
 		arg2 atc.TaskConfig
 	}
+	SecretsStub        func() *creds.Secrets
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct {
+	}
+	secretsReturns struct {
+		result1 *creds.Secrets
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 *creds.Secrets
+	}
 	StartingStub        func(lager.Logger, atc.TaskConfig)
 	startingMutex       sync.RWMutex
 	startingArgsForCall []struct {
@@ -67,10 +84,53 @@ type FakeTaskDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
+	TestResultsStub        func(lager.Logger, []atc.TestResult)
+	testResultsMutex       sync.RWMutex
+	testResultsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 []atc.TestResult
+	}
+	WaitingForWorkerStub        func(lager.Logger)
+	waitingForWorkerMutex       sync.RWMutex
+	waitingForWorkerArgsForCall []struct {
+		arg1 lager.Logger
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTaskDelegate) Annotated(arg1 lager.Logger, arg2 map[string]string) {
+	fake.annotatedMutex.Lock()
+	fake.annotatedArgsForCall = append(fake.annotatedArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 map[string]string
+	}{arg1, arg2})
+	fake.recordInvocation("Annotated", []interface{}{arg1, arg2})
+	fake.annotatedMutex.Unlock()
+	if fake.AnnotatedStub != nil {
+		fake.AnnotatedStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCallCount() int {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	return len(fake.annotatedArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) AnnotatedCalls(stub func(lager.Logger, map[string]string)) {
+	fake.annotatedMutex.Lock()
+	defer fake.annotatedMutex.Unlock()
+	fake.AnnotatedStub = stub
+}
+
+func (fake *FakeTaskDelegate) AnnotatedArgsForCall(i int) (lager.Logger, map[string]string) {
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
+	argsForCall := fake.annotatedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeTaskDelegate) Errored(arg1 lager.Logger, arg2 string) {
 	fake.erroredMutex.Lock()
 	fake.erroredArgsForCall = append(fake.erroredArgsForCall, struct {
@@ -227,6 +287,58 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) (lager.Logger, atc.
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeTaskDelegate) Secrets() *creds.Secrets {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Secrets", []interface{}{})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) SecretsCalls(stub func() *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = stub
+}
+
+func (fake *FakeTaskDelegate) SecretsReturns(result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) SecretsReturnsOnCall(i int, result1 *creds.Secrets) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 *creds.Secrets
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 *creds.Secrets
+	}{result1}
+}
+
 func (fake *FakeTaskDelegate) Starting(arg1 lager.Logger, arg2 atc.TaskConfig) {
 	fake.startingMutex.Lock()
 	fake.startingArgsForCall = append(fake.startingArgsForCall, struct {
@@ -363,9 +475,79 @@ func (fake *FakeTaskDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
+func (fake *FakeTaskDelegate) TestResults(arg1 lager.Logger, arg2 []atc.TestResult) {
+	var arg2Copy []atc.TestResult
+	if arg2 != nil {
+		arg2Copy = make([]atc.TestResult, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.testResultsMutex.Lock()
+	fake.testResultsArgsForCall = append(fake.testResultsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 []atc.TestResult
+	}{arg1, arg2Copy})
+	fake.recordInvocation("TestResults", []interface{}{arg1, arg2Copy})
+	fake.testResultsMutex.Unlock()
+	if fake.TestResultsStub != nil {
+		fake.TestResultsStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeTaskDelegate) TestResultsCallCount() int {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	return len(fake.testResultsArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) TestResultsCalls(stub func(lager.Logger, []atc.TestResult)) {
+	fake.testResultsMutex.Lock()
+	defer fake.testResultsMutex.Unlock()
+	fake.TestResultsStub = stub
+}
+
+func (fake *FakeTaskDelegate) TestResultsArgsForCall(i int) (lager.Logger, []atc.TestResult) {
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	argsForCall := fake.testResultsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger) {
+	fake.waitingForWorkerMutex.Lock()
+	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.waitingForWorkerMutex.Unlock()
+	if fake.WaitingForWorkerStub != nil {
+		fake.WaitingForWorkerStub(arg1)
+	}
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerCallCount() int {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	return len(fake.waitingForWorkerArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+	fake.waitingForWorkerMutex.Lock()
+	defer fake.waitingForWorkerMutex.Unlock()
+	fake.WaitingForWorkerStub = stub
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
+	argsForCall := fake.waitingForWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.annotatedMutex.RLock()
+	defer fake.annotatedMutex.RUnlock()
 	fake.erroredMutex.RLock()
 	defer fake.erroredMutex.RUnlock()
 	fake.finishedMutex.RLock()
@@ -374,12 +556,18 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
 	fake.startingMutex.RLock()
 	defer fake.startingMutex.RUnlock()
 	fake.stderrMutex.RLock()
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.testResultsMutex.RLock()
+	defer fake.testResultsMutex.RUnlock()
+	fake.waitingForWorkerMutex.RLock()
+	defer fake.waitingForWorkerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
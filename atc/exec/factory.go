@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -40,6 +41,28 @@ type Factory interface {
 		db.ContainerMetadata,
 		TaskDelegate,
 	) Step
+
+	// SetPipeline constructs a SetPipeline step.
+	SetPipeline(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		BuildStepDelegate,
+	) Step
+
+	// Across constructs an Across step, which constructs a step for each of
+	// its values when it runs.
+	Across(
+		lager.Logger,
+		atc.Plan,
+		db.Build,
+		AcrossDelegate,
+		AcrossStepFactory,
+	) Step
+
+	// WithLocalVar returns a Factory whose steps resolve the var to the
+	// value, rather than looking it up in the credential manager.
+	WithLocalVar(name string, value interface{}) Factory
 }
 
 // StepMetadata is used to inject metadata to make available to the step when
@@ -57,6 +80,11 @@ type BuildStepDelegate interface {
 	Stderr() io.Writer
 
 	Errored(lager.Logger, string)
+
+	// Secrets tracks the credentials fetched for the step, so that they're
+	// redacted from the build's logs. It's nil if the build's team opted out
+	// of redaction.
+	Secrets() *creds.Secrets
 }
 
 // Privileged is used to indicate whether the given step should run with
@@ -21,8 +21,13 @@ type gardenFactory struct {
 	resourceFactory       resource.ResourceFactory
 	resourceCacheFactory  db.ResourceCacheFactory
 	resourceConfigFactory db.ResourceConfigFactory
+	teamFactory           db.TeamFactory
 	variablesFactory      creds.VariablesFactory
 	defaultLimits         atc.ContainerLimits
+
+	maxActiveTasksPerWorker int
+
+	localVars map[string]interface{}
 }
 
 func NewGardenFactory(
@@ -31,8 +36,10 @@ func NewGardenFactory(
 	resourceFactory resource.ResourceFactory,
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
+	teamFactory db.TeamFactory,
 	variablesFactory creds.VariablesFactory,
 	defaultLimits atc.ContainerLimits,
+	maxActiveTasksPerWorker int,
 ) Factory {
 	return &gardenFactory{
 		workerClient:          workerClient,
@@ -40,8 +47,11 @@ func NewGardenFactory(
 		resourceFactory:       resourceFactory,
 		resourceCacheFactory:  resourceCacheFactory,
 		resourceConfigFactory: resourceConfigFactory,
+		teamFactory:           teamFactory,
 		variablesFactory:      variablesFactory,
 		defaultLimits:         defaultLimits,
+
+		maxActiveTasksPerWorker: maxActiveTasksPerWorker,
 	}
 }
 
@@ -55,7 +65,7 @@ func (factory *gardenFactory) Get(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("get")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(build, delegate)
 
 	getStep := NewGetStep(
 		build,
@@ -93,7 +103,7 @@ func (factory *gardenFactory) Put(
 ) Step {
 	workerMetadata.WorkingDirectory = resource.ResourcesDir("put")
 
-	variables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	variables := factory.variables(build, delegate)
 
 	var putInputs PutInputs
 	if plan.Put.Inputs != nil {
@@ -136,7 +146,7 @@ func (factory *gardenFactory) Task(
 	workingDirectory := factory.taskWorkingDirectory(worker.ArtifactName(plan.Task.Name))
 	containerMetadata.WorkingDirectory = workingDirectory
 
-	credMgrVariables := factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName())
+	credMgrVariables := factory.variables(build, delegate)
 
 	var taskConfigSource TaskConfigSource
 	var taskVars []boshtemplate.Variables
@@ -172,6 +182,7 @@ func (factory *gardenFactory) Task(
 
 		workingDirectory,
 		plan.Task.ImageArtifactName,
+		plan.Task.TestReports,
 
 		delegate,
 
@@ -185,11 +196,85 @@ func (factory *gardenFactory) Task(
 
 		creds.NewVersionedResourceTypes(credMgrVariables, plan.Task.VersionedResourceTypes),
 		factory.defaultLimits,
+		factory.maxActiveTasksPerWorker,
 	)
 
 	return LogError(taskStep, delegate)
 }
 
+func (factory *gardenFactory) SetPipeline(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate BuildStepDelegate,
+) Step {
+	setPipelineStep := NewSetPipelineStep(
+		plan.ID,
+		*plan.SetPipeline,
+		build,
+		factory.teamFactory,
+		delegate,
+	)
+
+	return LogError(setPipelineStep, delegate)
+}
+
+func (factory *gardenFactory) Across(
+	logger lager.Logger,
+	plan atc.Plan,
+	build db.Build,
+	delegate AcrossDelegate,
+	newStep AcrossStepFactory,
+) Step {
+	var values creds.List
+	if plan.Across.ValuesVar != "" {
+		values = creds.NewList(factory.variables(build, delegate), "(("+plan.Across.ValuesVar+"))")
+	} else {
+		static := make([]interface{}, len(plan.Across.Steps))
+		for i, step := range plan.Across.Steps {
+			static[i] = step.Value
+		}
+
+		values = creds.NewList(factory.variables(build, delegate), static)
+	}
+
+	return Across(
+		plan.Across.Var,
+		values,
+		factory,
+		newStep,
+		plan.Across.MaxInFlight,
+		delegate,
+	)
+}
+
+func (factory *gardenFactory) WithLocalVar(name string, value interface{}) Factory {
+	localVars := map[string]interface{}{}
+	for k, v := range factory.localVars {
+		localVars[k] = v
+	}
+
+	localVars[name] = value
+
+	scoped := *factory
+	scoped.localVars = localVars
+
+	return &scoped
+}
+
+// variables returns the credential manager variables for the build's steps,
+// overridden by any local vars. The values fetched through the credential
+// manager are tracked by the delegate's secrets, if any, so that they're
+// redacted from the build's logs.
+func (factory *gardenFactory) variables(build db.Build, delegate BuildStepDelegate) creds.Variables {
+	variables := creds.NewTrackedVariables(
+		factory.variablesFactory.NewVariables(build.TeamName(), build.PipelineName()),
+		delegate.Secrets(),
+	)
+
+	return creds.NewLocalVariables(variables, factory.localVars)
+}
+
 func (factory *gardenFactory) taskWorkingDirectory(sourceName worker.ArtifactName) string {
 	sum := sha1.Sum([]byte(sourceName))
 	return filepath.Join("/tmp", "build", fmt.Sprintf("%x", sum[:4]))
@@ -6,13 +6,16 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -133,6 +136,29 @@ func NewGetStep(
 // At the end, the resulting ArtifactSource (either from using the cache or
 // fetching the resource) is registered under the step's SourceName.
 func (step *GetStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "get", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	start := time.Now()
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	metric.StepFinished{
+		TeamName:     step.containerMetadata.TeamName,
+		PipelineName: step.containerMetadata.PipelineName,
+		JobName:      step.containerMetadata.JobName,
+		StepType:     "get",
+		Duration:     time.Since(start),
+	}.Emit(lagerctx.FromContext(ctx))
+
+	return err
+}
+
+func (step *GetStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	version, err := step.versionSource.Version(state)
@@ -2,13 +2,16 @@ package exec
 
 import (
 	"context"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -92,6 +95,29 @@ func NewPutStep(
 // The resource's put script is then invoked. If the context is canceled, the
 // script will be interrupted.
 func (step *PutStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "put", tracing.Attrs{
+		"name":     step.name,
+		"resource": step.resource,
+		"type":     step.resourceType,
+	})
+
+	start := time.Now()
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	metric.StepFinished{
+		TeamName:     step.containerMetadata.TeamName,
+		PipelineName: step.containerMetadata.PipelineName,
+		JobName:      step.containerMetadata.JobName,
+		StepType:     "put",
+		Duration:     time.Since(start),
+	}.Emit(lagerctx.FromContext(ctx))
+
+	return err
+}
+
+func (step *PutStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	containerInputs, err := step.inputs.FindAll(state.Artifacts())
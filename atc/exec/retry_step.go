@@ -2,6 +2,9 @@ package exec
 
 import (
 	"context"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 // RetryStep is a step that will run the steps in order until one of them
@@ -22,10 +25,16 @@ func Retry(attempts ...Step) Step {
 func (step *RetryStep) Run(ctx context.Context, state RunState) error {
 	var attemptErr error
 
-	for _, attempt := range step.Attempts {
+	for i, attempt := range step.Attempts {
 		step.LastAttempt = attempt
 
-		attemptErr = attempt.Run(ctx, state)
+		attemptCtx, span := tracing.StartSpan(ctx, "retry", tracing.Attrs{
+			"attempt": strconv.Itoa(i + 1),
+		})
+
+		attemptErr = attempt.Run(attemptCtx, state)
+		tracing.End(span, attemptErr)
+
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
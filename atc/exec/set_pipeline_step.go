@@ -0,0 +1,186 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/template"
+	"github.com/concourse/concourse/atc/worker"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// SetPipelineStep configures a pipeline belonging to the build's team from a
+// config file in one of the build's artifacts, as `fly set-pipeline` would.
+type SetPipelineStep struct {
+	planID      atc.PlanID
+	plan        atc.SetPipelinePlan
+	build       db.Build
+	teamFactory db.TeamFactory
+	delegate    BuildStepDelegate
+	succeeded   bool
+}
+
+func NewSetPipelineStep(
+	planID atc.PlanID,
+	plan atc.SetPipelinePlan,
+	build db.Build,
+	teamFactory db.TeamFactory,
+	delegate BuildStepDelegate,
+) *SetPipelineStep {
+	return &SetPipelineStep{
+		planID:      planID,
+		plan:        plan,
+		build:       build,
+		teamFactory: teamFactory,
+		delegate:    delegate,
+	}
+}
+
+// Run reads the pipeline config and any var files out of the build's
+// artifacts, interpolates the vars into the config, validates it, and saves
+// it for the build's team.
+//
+// Vars are interpolated with the same precedence as `fly set-pipeline`: instance
+// vars override vars given in the plan, which override those loaded from var
+// files, and later var files override earlier ones. Any other vars are left in
+// the config to be resolved by the credential manager.
+//
+// If the config is invalid, the errors are written to stderr and the step
+// fails. If the pipeline was changed by someone else while the step was
+// running, db.ErrConfigComparisonFailed is returned.
+func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	pipelineRef := atc.PipelineRef{
+		Name:         step.plan.Name,
+		InstanceVars: step.plan.InstanceVars,
+	}
+
+	logger := lagerctx.FromContext(ctx).WithData(lager.Data{
+		"plan-id":  step.planID,
+		"pipeline": pipelineRef.String(),
+	})
+
+	stdout := step.delegate.Stdout()
+	stderr := step.delegate.Stderr()
+
+	repo := state.Artifacts()
+
+	configPayload, err := readArtifactFile(logger, repo, step.plan.File)
+	if err != nil {
+		return err
+	}
+
+	params := []boshtemplate.Variables{}
+	for _, path := range step.plan.VarFiles {
+		payload, err := readArtifactFile(logger, repo, path)
+		if err != nil {
+			return err
+		}
+
+		var staticVars boshtemplate.StaticVariables
+		err = yaml.Unmarshal(payload, &staticVars)
+		if err != nil {
+			return fmt.Errorf("could not unmarshal template variables (%s): %s", path, err)
+		}
+
+		params = append(params, staticVars)
+	}
+
+	params = append(params, boshtemplate.StaticVariables(step.plan.Vars))
+	params = append(params, boshtemplate.StaticVariables(step.plan.InstanceVars))
+
+	evaluatedConfig, err := template.NewTemplateResolver(configPayload, params).Resolve(false, false)
+	if err != nil {
+		return err
+	}
+
+	var config atc.Config
+	err = yaml.Unmarshal(evaluatedConfig, &config)
+	if err != nil {
+		return fmt.Errorf("malformed pipeline config '%s': %s", step.plan.File, err)
+	}
+
+	warnings, errorMessages := config.Validate()
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "WARNING: %s\n", warning.Message)
+	}
+
+	if len(errorMessages) > 0 {
+		fmt.Fprintln(stderr, "invalid pipeline config:")
+		for _, message := range errorMessages {
+			fmt.Fprintf(stderr, "  - %s\n", strings.Replace(message, "\n", "\n    ", -1))
+		}
+
+		return nil
+	}
+
+	team := step.teamFactory.GetByID(step.build.TeamID())
+
+	var fromVersion db.ConfigVersion
+	pipeline, found, err := team.Pipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		fromVersion = pipeline.ConfigVersion()
+	}
+
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", pipelineRef.String())
+
+	_, created, err := team.SavePipeline(pipelineRef, config, fromVersion, db.PipelineNoChange)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		fmt.Fprintln(stdout, "pipeline created; it is currently paused")
+	} else {
+		fmt.Fprintln(stdout, "pipeline updated")
+	}
+
+	logger.Info("saved", lager.Data{"created": created})
+
+	step.succeeded = true
+
+	return nil
+}
+
+// Succeeded returns true if the pipeline config was valid and was saved.
+func (step *SetPipelineStep) Succeeded() bool {
+	return step.succeeded
+}
+
+func readArtifactFile(logger lager.Logger, repo *worker.ArtifactRepository, path string) ([]byte, error) {
+	segs := strings.SplitN(path, "/", 2)
+	if len(segs) != 2 {
+		return nil, UnspecifiedArtifactSourceError{path}
+	}
+
+	sourceName := worker.ArtifactName(segs[0])
+	filePath := segs[1]
+
+	source, found := repo.SourceFor(sourceName)
+	if !found {
+		return nil, fmt.Errorf("unknown artifact source: '%s' in file path '%s'", sourceName, path)
+	}
+
+	stream, err := source.StreamFile(logger, filePath)
+	if err != nil {
+		if err == baggageclaim.ErrFileNotFound {
+			return nil, fmt.Errorf("file '%s/%s' not found", sourceName, filePath)
+		}
+		return nil, err
+	}
+
+	defer stream.Close()
+
+	return ioutil.ReadAll(stream)
+}
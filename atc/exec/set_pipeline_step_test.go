@@ -0,0 +1,272 @@
+package exec_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+const pipelineConfigFixture = `
+resources:
+- name: some-repo
+  type: git
+  source:
+    uri: ((uri))
+    branch: ((branch))
+    private_key: ((private_key))
+
+jobs:
+- name: unit
+  plan:
+  - get: some-repo
+`
+
+var _ = Describe("SetPipelineStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeBuild       *dbfakes.FakeBuild
+		fakeTeamFactory *dbfakes.FakeTeamFactory
+		fakeTeam        *dbfakes.FakeTeam
+		fakePipeline    *dbfakes.FakePipeline
+		fakeDelegate    *execfakes.FakeBuildStepDelegate
+		fakeSource      *workerfakes.FakeArtifactSource
+
+		files map[string]string
+
+		plan atc.SetPipelinePlan
+
+		repo  *worker.ArtifactRepository
+		state *execfakes.FakeRunState
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+
+		step    *exec.SetPipelineStep
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.TeamIDReturns(123)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeamFactory = new(dbfakes.FakeTeamFactory)
+		fakeTeamFactory.GetByIDReturns(fakeTeam)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ConfigVersionReturns(db.ConfigVersion(7))
+		fakeTeam.PipelineReturns(fakePipeline, true, nil)
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+
+		files = map[string]string{
+			"ci/pipeline.yml": pipelineConfigFixture,
+			"ci/vars.yml":     "uri: git://from-var-file\nbranch: from-var-file\n",
+		}
+
+		fakeSource = new(workerfakes.FakeArtifactSource)
+		fakeSource.StreamFileStub = func(logger lager.Logger, path string) (io.ReadCloser, error) {
+			content, found := files[path]
+			if !found {
+				return nil, baggageclaim.ErrFileNotFound
+			}
+
+			return ioutil.NopCloser(bytes.NewBufferString(content)), nil
+		}
+
+		repo = worker.NewArtifactRepository()
+		repo.RegisterSource("some-repo", fakeSource)
+
+		state = new(execfakes.FakeRunState)
+		state.ArtifactsReturns(repo)
+
+		plan = atc.SetPipelinePlan{
+			Name:     "some-pipeline",
+			File:     "some-repo/ci/pipeline.yml",
+			VarFiles: []string{"some-repo/ci/vars.yml"},
+			Vars:     atc.Params{"branch": "from-vars"},
+		}
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = exec.NewSetPipelineStep(
+			atc.PlanID("some-plan-id"),
+			plan,
+			fakeBuild,
+			fakeTeamFactory,
+			fakeDelegate,
+		)
+
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("saves the pipeline for the build's team", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+
+		Expect(fakeTeamFactory.GetByIDArgsForCall(0)).To(Equal(123))
+		Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+
+		ref, config, from, pausedState := fakeTeam.SavePipelineArgsForCall(0)
+		Expect(ref).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		Expect(from).To(Equal(db.ConfigVersion(7)))
+		Expect(pausedState).To(Equal(db.PipelineNoChange))
+
+		Expect(config.Jobs).To(HaveLen(1))
+		Expect(config.Resources).To(HaveLen(1))
+	})
+
+	It("interpolates vars, preferring those in the plan over var files", func() {
+		_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+		Expect(config.Resources[0].Source["uri"]).To(Equal("git://from-var-file"))
+		Expect(config.Resources[0].Source["branch"]).To(Equal("from-vars"))
+	})
+
+	It("leaves other vars for the credential manager", func() {
+		_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+		Expect(config.Resources[0].Source["private_key"]).To(Equal("((private_key))"))
+	})
+
+	It("succeeds", func() {
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("says what it did", func() {
+		Expect(stdoutBuf).To(gbytes.Say("setting pipeline: some-pipeline"))
+		Expect(stdoutBuf).To(gbytes.Say("pipeline updated"))
+	})
+
+	Context("when instance vars are given", func() {
+		BeforeEach(func() {
+			plan.InstanceVars = atc.InstanceVars{"branch": "release-5.1"}
+		})
+
+		It("saves that instance of the pipeline", func() {
+			Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "release-5.1"},
+			}))
+
+			ref, _, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(ref).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "release-5.1"},
+			}))
+		})
+
+		It("interpolates them, preferring them over other vars", func() {
+			_, config, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(config.Resources[0].Source["branch"]).To(Equal("release-5.1"))
+		})
+
+		It("says which instance it set", func() {
+			Expect(stdoutBuf).To(gbytes.Say("setting pipeline: some-pipeline/branch:release-5.1"))
+		})
+	})
+
+	Context("when the pipeline does not exist yet", func() {
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(nil, false, nil)
+			fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+		})
+
+		It("creates it from the initial config version", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+
+			_, _, from, _ := fakeTeam.SavePipelineArgsForCall(0)
+			Expect(from).To(Equal(db.ConfigVersion(0)))
+		})
+
+		It("says it was created paused", func() {
+			Expect(stdoutBuf).To(gbytes.Say("pipeline created; it is currently paused"))
+		})
+	})
+
+	Context("when the config is invalid", func() {
+		BeforeEach(func() {
+			files["ci/pipeline.yml"] = "jobs:\n- name: unit\n  plan:\n  - get: missing-resource\n"
+		})
+
+		It("does not save the pipeline", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+		})
+
+		It("prints the errors and fails", func() {
+			Expect(stderrBuf).To(gbytes.Say("invalid pipeline config:"))
+			Expect(stderrBuf).To(gbytes.Say("refers to a resource that does not exist"))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the config file does not exist", func() {
+		BeforeEach(func() {
+			plan.File = "some-repo/ci/bogus.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("file 'some-repo/ci/bogus.yml' not found"))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when the config file is in an unknown artifact", func() {
+		BeforeEach(func() {
+			plan.File = "bogus-repo/ci/pipeline.yml"
+		})
+
+		It("returns an error", func() {
+			Expect(stepErr).To(MatchError("unknown artifact source: 'bogus-repo' in file path 'bogus-repo/ci/pipeline.yml'"))
+		})
+	})
+
+	Context("when saving the pipeline fails", func() {
+		BeforeEach(func() {
+			fakeTeam.SavePipelineReturns(nil, false, db.ErrConfigComparisonFailed)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(db.ErrConfigComparisonFailed))
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+	})
+
+	Context("when finding the pipeline fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeTeam.PipelineReturns(nil, false, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(Equal(disaster))
+			Expect(fakeTeam.SavePipelineCallCount()).To(BeZero())
+		})
+	})
+})
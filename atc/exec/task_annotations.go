@@ -0,0 +1,84 @@
+package exec
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"code.cloudfoundry.org/garden"
+)
+
+// Tasks annotate their build by writing a JSON object to the file named by
+// this environment variable. The annotations are collected once the task's
+// process exits.
+const taskAnnotationsEnv = "BUILD_ANNOTATIONS_FILE"
+
+const taskAnnotationsFileName = ".build-annotations.json"
+
+// maxTaskAnnotationsSize bounds the annotations file so that a task can't
+// bloat the build's row in the database.
+const maxTaskAnnotationsSize = 64 * 1024
+
+func taskAnnotationsPath(artifactsRoot string) string {
+	return path.Join(artifactsRoot, taskAnnotationsFileName)
+}
+
+// readTaskAnnotations reads the annotations written by the task, if any.
+//
+// Values may be any JSON value; anything but a string is kept as its JSON
+// representation, so that e.g. {"coverage": 87.5} annotates the build with
+// "87.5".
+func readTaskAnnotations(container garden.Container, artifactsRoot string) (map[string]string, bool, error) {
+	stream, err := container.StreamOut(garden.StreamOutSpec{
+		Path: taskAnnotationsPath(artifactsRoot),
+	})
+	if err != nil {
+		// the task didn't write any annotations
+		return nil, false, nil
+	}
+
+	defer stream.Close()
+
+	tarReader := tar.NewReader(stream)
+
+	_, err = tarReader.Next()
+	if err != nil {
+		return nil, false, nil
+	}
+
+	contents := new(bytes.Buffer)
+
+	_, err = io.Copy(contents, io.LimitReader(tarReader, maxTaskAnnotationsSize+1))
+	if err != nil {
+		return nil, false, err
+	}
+
+	if contents.Len() > maxTaskAnnotationsSize {
+		return nil, false, fmt.Errorf("annotations file is larger than %d bytes", maxTaskAnnotationsSize)
+	}
+
+	var rawAnnotations map[string]json.RawMessage
+	err = json.Unmarshal(contents.Bytes(), &rawAnnotations)
+	if err != nil {
+		return nil, false, fmt.Errorf("annotations file must contain a JSON object: %s", err)
+	}
+
+	annotations := map[string]string{}
+	for key, raw := range rawAnnotations {
+		if key == "" {
+			return nil, false, fmt.Errorf("annotations must not have an empty key")
+		}
+
+		var value string
+		if json.Unmarshal(raw, &value) != nil {
+			value = string(raw)
+		}
+
+		annotations[key] = value
+	}
+
+	return annotations, len(annotations) > 0, nil
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	boshtemplate "github.com/cloudfoundry/bosh-cli/director/template"
 
@@ -20,12 +21,18 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 const taskProcessID = "task"
 const taskExitStatusPropertyName = "concourse:exit-status"
 
+// WorkerAvailabilityPollingInterval is how often a task waiting for a worker
+// with capacity checks again.
+var WorkerAvailabilityPollingInterval = 5 * time.Second
+
 // MissingInputsError is returned when any of the task's required inputs are
 // missing.
 type MissingInputsError struct {
@@ -61,8 +68,11 @@ type TaskDelegate interface {
 	BuildStepDelegate
 
 	Initializing(lager.Logger, atc.TaskConfig)
+	WaitingForWorker(lager.Logger)
 	Starting(lager.Logger, atc.TaskConfig)
 	Finished(lager.Logger, ExitStatus)
+	Annotated(lager.Logger, map[string]string)
+	TestResults(lager.Logger, []atc.TestResult)
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -76,6 +86,7 @@ type TaskStep struct {
 
 	artifactsRoot     string
 	imageArtifactName string
+	testReports       []string
 
 	delegate TaskDelegate
 
@@ -91,6 +102,8 @@ type TaskStep struct {
 
 	defaultLimits atc.ContainerLimits
 
+	maxActiveTasks int
+
 	succeeded bool
 }
 
@@ -102,6 +115,7 @@ func NewTaskStep(
 	outputMapping map[string]string,
 	artifactsRoot string,
 	imageArtifactName string,
+	testReports []string,
 	delegate TaskDelegate,
 	workerPool worker.Client,
 	teamID int,
@@ -112,6 +126,7 @@ func NewTaskStep(
 	containerMetadata db.ContainerMetadata,
 	resourceTypes creds.VersionedResourceTypes,
 	defaultLimits atc.ContainerLimits,
+	maxActiveTasks int,
 ) Step {
 	return &TaskStep{
 		privileged:        privileged,
@@ -121,6 +136,7 @@ func NewTaskStep(
 		outputMapping:     outputMapping,
 		artifactsRoot:     artifactsRoot,
 		imageArtifactName: imageArtifactName,
+		testReports:       testReports,
 		delegate:          delegate,
 		workerPool:        workerPool,
 		teamID:            teamID,
@@ -131,6 +147,7 @@ func NewTaskStep(
 		containerMetadata: containerMetadata,
 		resourceTypes:     resourceTypes,
 		defaultLimits:     defaultLimits,
+		maxActiveTasks:    maxActiveTasks,
 	}
 }
 
@@ -150,6 +167,27 @@ func NewTaskStep(
 // task's entire working directory is registered as an ArtifactSource under the
 // name of the task.
 func (action *TaskStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "task", tracing.Attrs{
+		"name": action.stepName,
+	})
+
+	start := time.Now()
+
+	err := action.run(ctx, state)
+	tracing.End(span, err)
+
+	metric.StepFinished{
+		TeamName:     action.containerMetadata.TeamName,
+		PipelineName: action.containerMetadata.PipelineName,
+		JobName:      action.containerMetadata.JobName,
+		StepType:     "task",
+		Duration:     time.Since(start),
+	}.Emit(lagerctx.FromContext(ctx))
+
+	return err
+}
+
+func (action *TaskStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 
 	repository := state.Artifacts()
@@ -182,11 +220,25 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 		return err
 	}
 
-	container, err := action.workerPool.FindOrCreateContainer(
+	owner := db.NewBuildStepContainerOwner(action.buildID, action.planID, action.teamID)
+
+	chosenWorker, err := action.chooseWorker(ctx, logger, owner, containerSpec, workerSpec)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		err := chosenWorker.DecreaseActiveTasks()
+		if err != nil {
+			logger.Error("failed-to-decrease-active-tasks", err)
+		}
+	}()
+
+	container, err := chosenWorker.FindOrCreateContainer(
 		ctx,
 		logger,
 		action.delegate,
-		db.NewBuildStepContainerOwner(action.buildID, action.planID, action.teamID),
+		owner,
 		action.containerMetadata,
 		containerSpec,
 		workerSpec,
@@ -282,6 +334,30 @@ func (action *TaskStep) Run(ctx context.Context, state RunState) error {
 			return err
 		}
 
+		annotations, found, err := readTaskAnnotations(container, action.artifactsRoot)
+		if err != nil {
+			fmt.Fprintln(action.delegate.Stderr(), "[WARNING] ignoring build annotations:", err)
+		} else if found {
+			action.delegate.Annotated(logger, annotations)
+		}
+
+		if len(action.testReports) > 0 {
+			results := readTaskTestReports(container, action.artifactsRoot, action.testReports, action.delegate.Stderr())
+			summary := summarizeTestResults(results)
+
+			fmt.Fprintf(
+				action.delegate.Stdout(),
+				"%d tests: %d passed, %d failed, %d errored, %d skipped\n",
+				summary.Total(),
+				summary.Passed,
+				summary.Failed,
+				summary.Errored,
+				summary.Skipped,
+			)
+
+			action.delegate.TestResults(logger, results)
+		}
+
 		action.delegate.Finished(logger, ExitStatus(processStatus))
 
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", processStatus))
@@ -299,6 +375,70 @@ func (action *TaskStep) Succeeded() bool {
 	return action.succeeded
 }
 
+// chooseWorker picks a worker for the task and counts the task as active on
+// it. If every compatible worker is already running the maximum number of
+// active tasks, it waits until one of them frees up.
+//
+// A worker already running the task's container, e.g. because the build is
+// being resumed after an ATC restart, is returned as-is: the task is already
+// counted as active on it, and the step takes over releasing it.
+func (action *TaskStep) chooseWorker(
+	ctx context.Context,
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	containerSpec worker.ContainerSpec,
+	workerSpec worker.WorkerSpec,
+) (worker.Worker, error) {
+	var waitingSince time.Time
+	defer func() {
+		if !waitingSince.IsZero() {
+			metric.TasksWaiting.Dec()
+			metric.TasksWaitDuration{
+				Duration: time.Since(waitingSince),
+			}.Emit(logger)
+		}
+	}()
+
+	for {
+		chosenWorker, err := action.workerPool.FindOrChooseWorker(logger, owner, containerSpec, workerSpec)
+		if err == nil {
+			reattaching, err := chosenWorker.HasContainer(logger, owner)
+			if err != nil {
+				return nil, err
+			}
+
+			if reattaching {
+				return chosenWorker, nil
+			}
+
+			// another task may have taken the last slot on the worker since
+			// it was chosen, in which case we keep waiting
+			increased, err := chosenWorker.IncreaseActiveTasks(action.maxActiveTasks)
+			if err != nil {
+				return nil, err
+			}
+
+			if increased {
+				return chosenWorker, nil
+			}
+		} else if err != worker.ErrAllWorkersBusy {
+			return nil, err
+		}
+
+		if waitingSince.IsZero() {
+			waitingSince = time.Now()
+			metric.TasksWaiting.Inc()
+			action.delegate.WaitingForWorker(logger)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(WorkerAvailabilityPollingInterval):
+		}
+	}
+}
+
 func (action *TaskStep) imageSpec(logger lager.Logger, repository *worker.ArtifactRepository, config atc.TaskConfig) (worker.ImageSpec, error) {
 	imageSpec := worker.ImageSpec{
 		Privileged: bool(action.privileged),
@@ -386,7 +526,7 @@ func (action *TaskStep) containerSpec(logger lager.Logger, repository *worker.Ar
 		Limits:    worker.ContainerLimits(config.Limits),
 		User:      config.Run.User,
 		Dir:       action.artifactsRoot,
-		Env:       action.envForParams(config.Params),
+		Env:       append(action.envForParams(config.Params), taskAnnotationsEnv+"="+taskAnnotationsPath(action.artifactsRoot)),
 
 		Inputs:  []worker.InputSource{},
 		Outputs: worker.OutputPaths{},
@@ -411,6 +551,8 @@ func (action *TaskStep) workerSpec(logger lager.Logger, resourceTypes creds.Vers
 		Tags:          action.tags,
 		TeamID:        action.teamID,
 		ResourceTypes: resourceTypes,
+
+		MaxActiveTasks: action.maxActiveTasks,
 	}
 
 	imageSpec, err := action.imageSpec(logger, repository, config)
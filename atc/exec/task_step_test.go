@@ -2,12 +2,14 @@ package exec_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
 	"io"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
@@ -34,11 +36,13 @@ var _ = Describe("TaskStep", func() {
 		cancel func()
 
 		fakeWorkerClient *workerfakes.FakeClient
+		fakeWorker       *workerfakes.FakeWorker
 
 		stdoutBuf *gbytes.Buffer
 		stderrBuf *gbytes.Buffer
 
 		imageArtifactName string
+		testReports       []string
 		containerMetadata db.ContainerMetadata
 
 		fakeDelegate *execfakes.FakeTaskDelegate
@@ -54,6 +58,8 @@ var _ = Describe("TaskStep", func() {
 		inputMapping  map[string]string
 		outputMapping map[string]string
 
+		maxActiveTasks int
+
 		repo  *worker.ArtifactRepository
 		state *execfakes.FakeRunState
 
@@ -67,6 +73,9 @@ var _ = Describe("TaskStep", func() {
 		logger = lagertest.NewTestLogger("task-action-test")
 
 		fakeWorkerClient = new(workerfakes.FakeClient)
+		fakeWorker = new(workerfakes.FakeWorker)
+		fakeWorkerClient.FindOrChooseWorkerReturns(fakeWorker, nil)
+		fakeWorker.IncreaseActiveTasksReturns(true, nil)
 
 		stdoutBuf = gbytes.NewBuffer()
 		stderrBuf = gbytes.NewBuffer()
@@ -102,6 +111,8 @@ var _ = Describe("TaskStep", func() {
 		inputMapping = nil
 		outputMapping = nil
 		imageArtifactName = ""
+		testReports = nil
+		maxActiveTasks = 0
 
 		containerMetadata = db.ContainerMetadata{
 			Type:     db.ContainerTypeTask,
@@ -120,6 +131,7 @@ var _ = Describe("TaskStep", func() {
 			outputMapping,
 			"some-artifact-root",
 			imageArtifactName,
+			testReports,
 			fakeDelegate,
 			fakeWorkerClient,
 			teamID,
@@ -130,6 +142,7 @@ var _ = Describe("TaskStep", func() {
 			containerMetadata,
 			resourceTypes,
 			atc.ContainerLimits{},
+			maxActiveTasks,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -173,14 +186,15 @@ var _ = Describe("TaskStep", func() {
 			BeforeEach(func() {
 				fakeContainer = new(workerfakes.FakeContainer)
 				fakeContainer.HandleReturns("some-handle")
-				fakeWorkerClient.FindOrCreateContainerReturns(fakeContainer, nil)
+				fakeContainer.StreamOutReturns(nil, errors.New("no such file"))
+				fakeWorker.FindOrCreateContainerReturns(fakeContainer, nil)
 			})
 
 			Describe("before creating a container", func() {
 				BeforeEach(func() {
 					fakeDelegate.InitializingStub = func(lager.Logger, atc.TaskConfig) {
 						defer GinkgoRecover()
-						Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(BeZero())
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
 					}
 				})
 
@@ -189,9 +203,20 @@ var _ = Describe("TaskStep", func() {
 				})
 			})
 
+			It("chooses a worker for the container", func() {
+				Expect(fakeWorkerClient.FindOrChooseWorkerCallCount()).To(Equal(1))
+				_, owner, _, _ := fakeWorkerClient.FindOrChooseWorkerArgsForCall(0)
+				Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID, teamID)))
+			})
+
+			It("counts the task as active on the worker while it runs", func() {
+				Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+				Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+			})
+
 			It("finds or creates a container", func() {
-				Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-				_, cancel, delegate, owner, createdMetadata, containerSpec, workerSpec, actualResourceTypes := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				_, cancel, delegate, owner, createdMetadata, containerSpec, workerSpec, actualResourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
 				Expect(cancel).ToNot(BeNil())
 				Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID, teamID)))
 				Expect(createdMetadata).To(Equal(db.ContainerMetadata{
@@ -221,7 +246,7 @@ var _ = Describe("TaskStep", func() {
 						Memory: &memory,
 					},
 					Dir:     "some-artifact-root",
-					Env:     []string{"SECURE=secret-task-param"},
+					Env:     []string{"SECURE=secret-task-param", "BUILD_ANNOTATIONS_FILE=some-artifact-root/.build-annotations.json"},
 					Inputs:  []worker.InputSource{},
 					Outputs: worker.OutputPaths{},
 				}))
@@ -252,8 +277,8 @@ var _ = Describe("TaskStep", func() {
 				})
 
 				It("finds or creates a container", func() {
-					Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-					_, cancel, delegate, owner, createdMetadata, containerSpec, workerSpec, actualResourceTypes := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+					_, cancel, delegate, owner, createdMetadata, containerSpec, workerSpec, actualResourceTypes := fakeWorker.FindOrCreateContainerArgsForCall(0)
 					Expect(cancel).ToNot(BeNil())
 					Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID, teamID)))
 					Expect(createdMetadata).To(Equal(db.ContainerMetadata{
@@ -272,7 +297,7 @@ var _ = Describe("TaskStep", func() {
 							Privileged: false,
 						},
 						Dir:     "some-artifact-root",
-						Env:     []string{"SOME=params"},
+						Env:     []string{"SOME=params", "BUILD_ANNOTATIONS_FILE=some-artifact-root/.build-annotations.json"},
 						Inputs:  []worker.InputSource{},
 						Outputs: worker.OutputPaths{},
 					}))
@@ -482,8 +507,8 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the container privileged", func() {
-						Expect(fakeWorkerClient.FindOrCreateContainerCallCount()).To(Equal(1))
-						_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+						_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.ImageSpec.Privileged).To(BeTrue())
 					})
 
@@ -531,7 +556,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("creates the container with the inputs configured correctly", func() {
-							_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(containerSpec.Inputs).To(HaveLen(2))
 							for _, input := range containerSpec.Inputs {
 								switch input.DestinationPath() {
@@ -581,7 +606,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("uses remapped input", func() {
-							_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(containerSpec.Inputs).To(HaveLen(1))
 							Expect(containerSpec.Inputs[0].Source()).To(Equal(remappedInputSource))
 							Expect(containerSpec.Inputs[0].DestinationPath()).To(Equal("some-artifact-root/remapped-input"))
@@ -626,7 +651,7 @@ var _ = Describe("TaskStep", func() {
 
 						It("runs successfully without the optional input", func() {
 							Expect(stepErr).ToNot(HaveOccurred())
-							_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(containerSpec.Inputs).To(HaveLen(2))
 							Expect(containerSpec.Inputs[0].Source()).To(Equal(optionalInput2Source))
 							Expect(containerSpec.Inputs[0].DestinationPath()).To(Equal("some-artifact-root/optional-input-2"))
@@ -680,7 +705,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the container with the caches in the inputs", func() {
-						_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.Inputs).To(HaveLen(2))
 						Expect([]string{
 							containerSpec.Inputs[0].DestinationPath(),
@@ -741,7 +766,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("configures them appropriately in the container spec", func() {
-						_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
 							"some-output":                "some-artifact-root/some-output-configured-path/",
 							"some-other-output":          "some-artifact-root/some-other-output/",
@@ -841,7 +866,7 @@ var _ = Describe("TaskStep", func() {
 								})
 
 								It("passes existing output volumes to the resource", func() {
-									_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+									_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 									Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
 										"some-output":                "some-artifact-root/some-output-configured-path/",
 										"some-other-output":          "some-artifact-root/some-other-output/",
@@ -1154,7 +1179,7 @@ var _ = Describe("TaskStep", func() {
 						})
 
 						It("creates the container with the image artifact source", func() {
-							_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+							_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 							Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 								ImageArtifactSource: imageArtifactSource,
 								ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1191,7 +1216,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1222,7 +1247,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1254,7 +1279,7 @@ var _ = Describe("TaskStep", func() {
 									})
 
 									It("still creates the container with the volume and a metadata stream", func() {
-										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+										_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 										Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 											ImageArtifactSource: imageArtifactSource,
 											ImageArtifactName:   worker.ArtifactName(imageArtifactName),
@@ -1299,7 +1324,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the specs with the image resource", func() {
-						_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.ImageSpec.ImageResource).To(Equal(&worker.ImageResource{
 							Type:    "docker",
 							Source:  creds.NewSource(template.StaticVariables{}, atc.Source{"some": "super-secret-source"}),
@@ -1333,7 +1358,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("creates the specs with the image resource", func() {
-						_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, containerSpec, workerSpec, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.ImageSpec.ImageURL).To(Equal("some-image"))
 
 						Expect(workerSpec).To(Equal(worker.WorkerSpec{
@@ -1364,7 +1389,7 @@ var _ = Describe("TaskStep", func() {
 					})
 
 					It("adds the user to the container spec", func() {
-						_, _, _, _, _, containerSpec, _, _ := fakeWorkerClient.FindOrCreateContainerArgsForCall(0)
+						_, _, _, _, _, containerSpec, _, _ := fakeWorker.FindOrCreateContainerArgsForCall(0)
 						Expect(containerSpec.User).To(Equal("some-user"))
 					})
 
@@ -1392,6 +1417,101 @@ var _ = Describe("TaskStep", func() {
 						Expect(taskStep.Succeeded()).To(BeTrue())
 					})
 
+					It("does not annotate the build", func() {
+						Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+					})
+
+					Context("when the task wrote annotations", func() {
+						var annotations string
+
+						BeforeEach(func() {
+							annotations = `{"coverage": 87.5, "release": "https://example.com/releases/1.2.3"}`
+
+							fakeContainer.StreamOutStub = func(garden.StreamOutSpec) (io.ReadCloser, error) {
+								return tarFile(".build-annotations.json", annotations), nil
+							}
+						})
+
+						It("reads them from the task's working directory", func() {
+							Expect(fakeContainer.StreamOutCallCount()).To(Equal(1))
+							Expect(fakeContainer.StreamOutArgsForCall(0)).To(Equal(garden.StreamOutSpec{
+								Path: "some-artifact-root/.build-annotations.json",
+							}))
+						})
+
+						It("annotates the build before finishing", func() {
+							Expect(fakeDelegate.AnnotatedCallCount()).To(Equal(1))
+							_, annotated := fakeDelegate.AnnotatedArgsForCall(0)
+							Expect(annotated).To(Equal(map[string]string{
+								"coverage": "87.5",
+								"release":  "https://example.com/releases/1.2.3",
+							}))
+						})
+
+						Context("when the annotations are not a JSON object", func() {
+							BeforeEach(func() {
+								annotations = `coverage=87.5`
+							})
+
+							It("warns and carries on without annotating the build", func() {
+								Expect(stepErr).ToNot(HaveOccurred())
+								Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+								Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring build annotations: annotations file must contain a JSON object`))
+							})
+						})
+
+						Context("when the annotations are too large", func() {
+							BeforeEach(func() {
+								annotations = `{"huge": "` + strings.Repeat("x", 64*1024) + `"}`
+							})
+
+							It("warns and carries on without annotating the build", func() {
+								Expect(stepErr).ToNot(HaveOccurred())
+								Expect(fakeDelegate.AnnotatedCallCount()).To(BeZero())
+								Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] ignoring build annotations: annotations file is larger than`))
+							})
+						})
+					})
+
+					It("does not report any test results", func() {
+						Expect(fakeDelegate.TestResultsCallCount()).To(BeZero())
+					})
+
+					Context("when the task has test reports", func() {
+						BeforeEach(func() {
+							testReports = []string{"results/junit.xml", "results/missing.tap"}
+
+							fakeContainer.StreamOutStub = func(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+								if spec.Path == "some-artifact-root/results/junit.xml" {
+									return tarFile("junit.xml", `<testsuite name="unit">
+  <testcase name="adds" classname="math"/>
+  <testcase name="subtracts" classname="math"><failure message="off by one"/></testcase>
+</testsuite>`), nil
+								}
+
+								return nil, errors.New("not found")
+							}
+						})
+
+						It("reports the results parsed from the reports", func() {
+							Expect(stepErr).ToNot(HaveOccurred())
+							Expect(fakeDelegate.TestResultsCallCount()).To(Equal(1))
+							_, results := fakeDelegate.TestResultsArgsForCall(0)
+							Expect(results).To(Equal([]atc.TestResult{
+								{Suite: "math", Name: "adds", Status: atc.TestPassed},
+								{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "off by one"},
+							}))
+						})
+
+						It("prints a summary", func() {
+							Expect(stdoutBuf).To(gbytes.Say("2 tests: 1 passed, 1 failed, 0 errored, 0 skipped"))
+						})
+
+						It("warns about missing reports", func() {
+							Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] test report results/missing.tap not found`))
+						})
+					})
+
 					It("doesn't register a source", func() {
 						Expect(stepErr).ToNot(HaveOccurred())
 
@@ -1591,7 +1711,7 @@ var _ = Describe("TaskStep", func() {
 			disaster := errors.New("nope")
 
 			BeforeEach(func() {
-				fakeWorkerClient.FindOrCreateContainerReturns(nil, disaster)
+				fakeWorker.FindOrCreateContainerReturns(nil, disaster)
 			})
 
 			It("returns the error", func() {
@@ -1601,6 +1721,156 @@ var _ = Describe("TaskStep", func() {
 			It("is not successful", func() {
 				Expect(taskStep.Succeeded()).To(BeFalse())
 			})
+
+			It("no longer counts the task as active", func() {
+				Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+				Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when choosing a worker fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				fakeWorkerClient.FindOrChooseWorkerReturns(nil, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(stepErr).To(Equal(disaster))
+			})
+
+			It("does not create a container", func() {
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the active tasks are limited", func() {
+			var (
+				originalPollingInterval time.Duration
+				containerErr            error
+			)
+
+			BeforeEach(func() {
+				maxActiveTasks = 2
+
+				// stop the step once the container is requested
+				containerErr = errors.New("no container")
+				fakeWorker.FindOrCreateContainerReturns(nil, containerErr)
+
+				originalPollingInterval = exec.WorkerAvailabilityPollingInterval
+				exec.WorkerAvailabilityPollingInterval = time.Millisecond
+			})
+
+			AfterEach(func() {
+				exec.WorkerAvailabilityPollingInterval = originalPollingInterval
+			})
+
+			It("only chooses workers with capacity", func() {
+				_, _, _, workerSpec := fakeWorkerClient.FindOrChooseWorkerArgsForCall(0)
+				Expect(workerSpec.MaxActiveTasks).To(Equal(2))
+			})
+
+			It("increases the active tasks up to the limit", func() {
+				Expect(fakeWorker.IncreaseActiveTasksArgsForCall(0)).To(Equal(2))
+			})
+
+			It("does not wait for a worker", func() {
+				Expect(fakeDelegate.WaitingForWorkerCallCount()).To(BeZero())
+			})
+
+			Context("when all workers are busy", func() {
+				BeforeEach(func() {
+					fakeWorkerClient.FindOrChooseWorkerReturnsOnCall(0, nil, worker.ErrAllWorkersBusy)
+					fakeWorkerClient.FindOrChooseWorkerReturnsOnCall(1, nil, worker.ErrAllWorkersBusy)
+					fakeWorkerClient.FindOrChooseWorkerReturnsOnCall(2, fakeWorker, nil)
+				})
+
+				It("waits until a worker has capacity", func() {
+					Expect(fakeWorkerClient.FindOrChooseWorkerCallCount()).To(Equal(3))
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+
+				It("notifies the delegate once", func() {
+					Expect(fakeDelegate.WaitingForWorkerCallCount()).To(Equal(1))
+				})
+
+				It("goes on to create the container", func() {
+					Expect(stepErr).To(Equal(containerErr))
+				})
+
+				Context("when the context is canceled while waiting", func() {
+					BeforeEach(func() {
+						fakeWorkerClient.FindOrChooseWorkerStub = func(lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec) (worker.Worker, error) {
+							cancel()
+							return nil, worker.ErrAllWorkersBusy
+						}
+					})
+
+					It("returns the context's error", func() {
+						Expect(stepErr).To(Equal(context.Canceled))
+					})
+
+					It("does not create a container", func() {
+						Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the chosen worker fills up before the task is counted", func() {
+				BeforeEach(func() {
+					fakeWorker.IncreaseActiveTasksReturnsOnCall(0, false, nil)
+					fakeWorker.IncreaseActiveTasksReturnsOnCall(1, true, nil)
+				})
+
+				It("chooses a worker again", func() {
+					Expect(fakeWorkerClient.FindOrChooseWorkerCallCount()).To(Equal(2))
+					Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(2))
+				})
+
+				It("only decreases the active tasks once", func() {
+					Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the worker already has the task's container", func() {
+				BeforeEach(func() {
+					fakeWorker.HasContainerReturns(true, nil)
+					fakeWorker.IncreaseActiveTasksReturns(false, nil)
+				})
+
+				It("looks for the container of the step", func() {
+					_, owner := fakeWorker.HasContainerArgsForCall(0)
+					Expect(owner).To(Equal(db.NewBuildStepContainerOwner(buildID, planID, teamID)))
+				})
+
+				It("reattaches to it without waiting for capacity", func() {
+					Expect(fakeWorkerClient.FindOrChooseWorkerCallCount()).To(Equal(1))
+					Expect(fakeDelegate.WaitingForWorkerCallCount()).To(BeZero())
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(1))
+				})
+
+				It("does not count the task again", func() {
+					Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(BeZero())
+				})
+
+				It("takes over releasing the task's slot", func() {
+					Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when looking for the task's container fails", func() {
+				disaster := errors.New("nope")
+
+				BeforeEach(func() {
+					fakeWorker.HasContainerReturns(false, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(stepErr).To(Equal(disaster))
+					Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(BeZero())
+					Expect(fakeWorker.FindOrCreateContainerCallCount()).To(BeZero())
+				})
+			})
 		})
 
 		Context("when getting the config fails", func() {
@@ -1620,3 +1890,23 @@ var _ = Describe("TaskStep", func() {
 		})
 	})
 })
+
+func tarFile(name string, contents string) io.ReadCloser {
+	buf := new(bytes.Buffer)
+
+	tarWriter := tar.NewWriter(buf)
+
+	err := tarWriter.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0644,
+		Size: int64(len(contents)),
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	_, err = tarWriter.Write([]byte(contents))
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(tarWriter.Close()).To(Succeed())
+
+	return ioutil.NopCloser(buf)
+}
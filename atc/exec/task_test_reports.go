@@ -0,0 +1,103 @@
+package exec
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/testreport"
+)
+
+// maxTaskTestReportSize bounds each report file read out of the container.
+const maxTaskTestReportSize = 10 * 1024 * 1024
+
+// readTaskTestReports collects the results from the test reports configured
+// on the task. Each path is relative to the task's working directory and may
+// name either a report or a directory of reports.
+//
+// A report that is missing or can't be parsed is reported on stderr rather
+// than failing the step, as the task itself has already run to completion.
+func readTaskTestReports(container garden.Container, artifactsRoot string, reportPaths []string, stderr io.Writer) []atc.TestResult {
+	results := []atc.TestResult{}
+
+	for _, reportPath := range reportPaths {
+		stream, err := container.StreamOut(garden.StreamOutSpec{
+			Path: path.Join(artifactsRoot, reportPath),
+		})
+		if err != nil {
+			fmt.Fprintf(stderr, "[WARNING] test report %s not found\n", reportPath)
+			continue
+		}
+
+		reportResults, err := readTestReportStream(stream)
+		stream.Close()
+
+		if err != nil {
+			fmt.Fprintf(stderr, "[WARNING] ignoring test report %s: %s\n", reportPath, err)
+			continue
+		}
+
+		results = append(results, reportResults...)
+	}
+
+	return results
+}
+
+func readTestReportStream(stream io.Reader) ([]atc.TestResult, error) {
+	results := []atc.TestResult{}
+
+	tarReader := tar.NewReader(stream)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return results, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		switch strings.ToLower(path.Ext(header.Name)) {
+		case ".xml", ".tap":
+		default:
+			continue
+		}
+
+		if header.Size > maxTaskTestReportSize {
+			return nil, fmt.Errorf("%s is larger than %d bytes", header.Name, maxTaskTestReportSize)
+		}
+
+		reportResults, err := testreport.Parse(header.Name, tarReader)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, reportResults...)
+	}
+}
+
+func summarizeTestResults(results []atc.TestResult) atc.TestSummary {
+	var summary atc.TestSummary
+	for _, result := range results {
+		switch result.Status {
+		case atc.TestPassed:
+			summary.Passed++
+		case atc.TestFailed:
+			summary.Failed++
+		case atc.TestErrored:
+			summary.Errored++
+		case atc.TestSkipped:
+			summary.Skipped++
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,47 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type buildEventArchiver struct {
+	buildFactory db.BuildFactory
+	batchSize    int
+}
+
+// NewBuildEventArchiver returns a collector which moves the events of
+// completed builds out of Postgres and into the configured event store.
+func NewBuildEventArchiver(buildFactory db.BuildFactory, batchSize int) Collector {
+	return &buildEventArchiver{
+		buildFactory: buildFactory,
+		batchSize:    batchSize,
+	}
+}
+
+func (a *buildEventArchiver) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-archiver")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	builds, err := a.buildFactory.GetCompletedBuildsWithUnarchivedEvents(a.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-builds-to-archive", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err := build.ArchiveEvents()
+		if err != nil {
+			// leave the events in postgres to try again on the next run
+			logger.Error("failed-to-archive-build-events", err, lager.Data{"build": build.ID()})
+			continue
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/gc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventArchiver", func() {
+	var (
+		archiver         Collector
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+
+		err error
+	)
+
+	BeforeEach(func() {
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		archiver = NewBuildEventArchiver(fakeBuildFactory, 10)
+	})
+
+	JustBeforeEach(func() {
+		err = archiver.Run(context.TODO())
+	})
+
+	It("only asks for a batch of builds", func() {
+		Expect(fakeBuildFactory.GetCompletedBuildsWithUnarchivedEventsCallCount()).To(Equal(1))
+		Expect(fakeBuildFactory.GetCompletedBuildsWithUnarchivedEventsArgsForCall(0)).To(Equal(10))
+	})
+
+	Context("when there are builds to archive", func() {
+		var build1, build2 *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			build1 = new(dbfakes.FakeBuild)
+			build2 = new(dbfakes.FakeBuild)
+
+			fakeBuildFactory.GetCompletedBuildsWithUnarchivedEventsReturns([]db.Build{build1, build2}, nil)
+		})
+
+		It("archives the events of each build", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build1.ArchiveEventsCallCount()).To(Equal(1))
+			Expect(build2.ArchiveEventsCallCount()).To(Equal(1))
+		})
+
+		Context("when archiving a build fails", func() {
+			BeforeEach(func() {
+				build1.ArchiveEventsReturns(errors.New("nope"))
+			})
+
+			It("carries on with the other builds", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(build2.ArchiveEventsCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when getting the builds fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			fakeBuildFactory.GetCompletedBuildsWithUnarchivedEventsReturns(nil, disaster)
+		})
+
+		It("returns the error", func() {
+			Expect(err).To(Equal(disaster))
+		})
+	})
+})
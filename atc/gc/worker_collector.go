@@ -64,6 +64,16 @@ func (wc *workerCollector) Run(ctx context.Context) error {
 		logger.Info("marked-workers-as-landed", lager.Data{"count": len(affected), "workers": affected})
 	}
 
+	affected, err = wc.workerLifecycle.ReconcileActiveTasks()
+	if err != nil {
+		logger.Error("failed-to-reconcile-active-tasks", err)
+		return err
+	}
+
+	if len(affected) > 0 {
+		logger.Info("reconciled-active-tasks", lager.Data{"count": len(affected), "workers": affected})
+	}
+
 	workerStateByName, err := wc.workerLifecycle.GetWorkerStateByName()
 
 	if err != nil {
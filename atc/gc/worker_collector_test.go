@@ -58,6 +58,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.LandFinishedLandingWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker lifecycle to reconcile active tasks", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.ReconcileActiveTasksCallCount()).To(Equal(1))
+		})
+
 		It("returns an error if stalling unresponsive workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, returnedErr)
@@ -82,5 +89,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(err).To(MatchError(returnedErr))
 		})
 
+		It("returns an error if reconciling active tasks fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.ReconcileActiveTasksReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
 	})
 })
@@ -3,15 +3,16 @@ package atc
 type Job struct {
 	ID int `json:"id"`
 
-	Name                 string `json:"name"`
-	PipelineName         string `json:"pipeline_name"`
-	TeamName             string `json:"team_name"`
-	Paused               bool   `json:"paused,omitempty"`
-	FirstLoggedBuildID   int    `json:"first_logged_build_id,omitempty"`
-	DisableManualTrigger bool   `json:"disable_manual_trigger,omitempty"`
-	NextBuild            *Build `json:"next_build"`
-	FinishedBuild        *Build `json:"finished_build"`
-	TransitionBuild      *Build `json:"transition_build,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Paused               bool         `json:"paused,omitempty"`
+	FirstLoggedBuildID   int          `json:"first_logged_build_id,omitempty"`
+	DisableManualTrigger bool         `json:"disable_manual_trigger,omitempty"`
+	NextBuild            *Build       `json:"next_build"`
+	FinishedBuild        *Build       `json:"finished_build"`
+	TransitionBuild      *Build       `json:"transition_build,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs"`
 	Outputs []JobOutput `json:"outputs"`
@@ -19,6 +20,13 @@ type Job struct {
 	Groups []string `json:"groups"`
 }
 
+func (j Job) PipelineRef() PipelineRef {
+	return PipelineRef{
+		Name:         j.PipelineName,
+		InstanceVars: j.PipelineInstanceVars,
+	}
+}
+
 type JobInput struct {
 	Name     string         `json:"name"`
 	Resource string         `json:"resource"`
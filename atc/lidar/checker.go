@@ -0,0 +1,221 @@
+package lidar
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/radar"
+)
+
+var (
+	errPipelineNotFound = errors.New("pipeline not found")
+	errCheckDeferred    = errors.New("check deferred")
+)
+
+// Checker runs the queued checks, with at most MaxConcurrent of them running
+// at a time. When the queue is empty, it's polled every Interval.
+//
+// Checks of resource types over their rate limit are deferred until the
+// limit allows them, rather than run.
+type Checker struct {
+	Logger         lager.Logger
+	CheckFactory   db.CheckFactory
+	ScannerFactory radar.ScannerFactory
+	MaxConcurrent  int
+	Interval       time.Duration
+	Clock          clock.Clock
+}
+
+func (checker Checker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	close(ready)
+
+	stop := make(chan struct{})
+
+	wg := new(sync.WaitGroup)
+	for i := 0; i < checker.MaxConcurrent; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checker.work(stop)
+		}()
+	}
+
+	<-signals
+
+	close(stop)
+	wg.Wait()
+
+	return nil
+}
+
+func (checker Checker) work(stop <-chan struct{}) {
+	for {
+		if checker.RunNext() {
+			select {
+			case <-stop:
+				return
+			default:
+				continue
+			}
+		}
+
+		timer := checker.Clock.NewTimer(checker.Interval)
+
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+	}
+}
+
+// RunNext claims the oldest queued check and runs it, recording how it went.
+// It returns whether there was a check to run.
+func (checker Checker) RunNext() bool {
+	check, found, err := checker.CheckFactory.StartCheck()
+	if err != nil {
+		checker.Logger.Error("failed-to-start-check", err)
+		return false
+	}
+
+	if !found {
+		return false
+	}
+
+	logger := checker.Logger.Session("check", lager.Data{
+		"check":    check.ID(),
+		"team":     check.TeamName(),
+		"pipeline": check.PipelineName(),
+	})
+
+	result, checkErr := checker.check(logger, check)
+	if checkErr == errCheckDeferred {
+		return true
+	}
+
+	if checkErr != nil {
+		logger.Info("errored", lager.Data{"error": checkErr.Error()})
+	}
+
+	err = check.Finish(result, checkErr)
+	if err != nil {
+		logger.Error("failed-to-finish-check", err)
+	}
+
+	return true
+}
+
+func (checker Checker) check(logger lager.Logger, check db.Check) (db.CheckResult, error) {
+	pipeline, found, err := check.Pipeline()
+	if err != nil {
+		logger.Error("failed-to-get-pipeline", err)
+		return db.CheckResult{}, err
+	}
+
+	if !found {
+		return db.CheckResult{}, errPipelineNotFound
+	}
+
+	metric.CheckQueueLatency{
+		TeamName:     check.TeamName(),
+		PipelineName: check.PipelineName(),
+		Name:         checkedName(check),
+		Duration:     check.StartTime().Sub(check.CreateTime()),
+	}.Emit(logger)
+
+	if check.ResourceTypeID() != 0 {
+		name := check.ResourceTypeName()
+
+		result, err := checker.ScannerFactory.NewResourceTypeScanner(pipeline).Run(logger.WithData(lager.Data{"resource-type": name}), name)
+		if rateErr, ok := err.(radar.CheckRateLimitedError); ok {
+			return db.CheckResult{}, checker.deferCheck(logger, check, rateErr)
+		}
+
+		if err == radar.ErrFailedToAcquireLock {
+			// checked within its interval, or being checked elsewhere
+			return db.CheckResult{Skipped: true}, nil
+		}
+
+		if err != nil {
+			return result, err
+		}
+
+		resourceType, found, err := pipeline.ResourceType(name)
+		if err != nil {
+			logger.Error("failed-to-get-resource-type", err)
+			return result, err
+		}
+
+		if !found {
+			return result, db.ResourceTypeNotFoundError{Name: name}
+		}
+
+		return result, resourceType.ResourceConfigCheckError()
+	}
+
+	name := check.ResourceName()
+
+	// the scanner records a failing check script on the resource config
+	// rather than returning it, so it's looked up afterwards
+	result, err := checker.ScannerFactory.NewResourceScanner(pipeline).Run(logger.WithData(lager.Data{"resource": name}), name)
+	if rateErr, ok := err.(radar.CheckRateLimitedError); ok {
+		return db.CheckResult{}, checker.deferCheck(logger, check, rateErr)
+	}
+
+	if err == radar.ErrFailedToAcquireLock {
+		return db.CheckResult{Skipped: true}, nil
+	}
+
+	if err != nil {
+		return result, err
+	}
+
+	resource, found, err := pipeline.Resource(name)
+	if err != nil {
+		logger.Error("failed-to-get-resource", err)
+		return result, err
+	}
+
+	if !found {
+		return result, db.ResourceNotFoundError{Name: name}
+	}
+
+	return result, resource.ResourceConfigCheckError()
+}
+
+// deferCheck puts the check back on the queue until its resource type is
+// within its rate limit again.
+func (checker Checker) deferCheck(logger lager.Logger, check db.Check, rateErr radar.CheckRateLimitedError) error {
+	err := check.Defer(rateErr.Delay)
+	if err != nil {
+		logger.Error("failed-to-defer-check", err)
+		return err
+	}
+
+	logger.Debug("deferred", lager.Data{"type": rateErr.ResourceType, "delay": rateErr.Delay.String()})
+
+	metric.CheckDeferred{
+		TeamName:     check.TeamName(),
+		PipelineName: check.PipelineName(),
+		Name:         checkedName(check),
+		ResourceType: rateErr.ResourceType,
+		Delay:        rateErr.Delay,
+	}.Emit(logger)
+
+	return errCheckDeferred
+}
+
+func checkedName(check db.Check) string {
+	if check.ResourceTypeID() != 0 {
+		return check.ResourceTypeName()
+	}
+
+	return check.ResourceName()
+}
@@ -0,0 +1,309 @@
+package lidar_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/radar"
+	"github.com/concourse/concourse/atc/radar/radarfakes"
+	"github.com/concourse/concourse/atc/resource"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeCheckFactory   *dbfakes.FakeCheckFactory
+		fakeScannerFactory *radarfakes.FakeScannerFactory
+		fakeScanner        *radarfakes.FakeScanner
+		fakeClock          *fakeclock.FakeClock
+
+		fakeCheck    *dbfakes.FakeCheck
+		fakePipeline *dbfakes.FakePipeline
+
+		checker lidar.Checker
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeScannerFactory = new(radarfakes.FakeScannerFactory)
+		fakeScanner = new(radarfakes.FakeScanner)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+		fakeScannerFactory.NewResourceScannerReturns(fakeScanner)
+		fakeScannerFactory.NewResourceTypeScannerReturns(fakeScanner)
+
+		fakePipeline = new(dbfakes.FakePipeline)
+
+		fakeCheck = new(dbfakes.FakeCheck)
+		fakeCheck.IDReturns(42)
+		fakeCheck.PipelineReturns(fakePipeline, true, nil)
+
+		checker = lidar.Checker{
+			Logger:         lagertest.NewTestLogger("test"),
+			CheckFactory:   fakeCheckFactory,
+			ScannerFactory: fakeScannerFactory,
+			MaxConcurrent:  2,
+			Interval:       time.Second,
+			Clock:          fakeClock,
+		}
+	})
+
+	Describe("RunNext", func() {
+		var ran bool
+
+		JustBeforeEach(func() {
+			ran = checker.RunNext()
+		})
+
+		Context("when there is no queued check", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.StartCheckReturns(nil, false, nil)
+			})
+
+			It("returns false", func() {
+				Expect(ran).To(BeFalse())
+				Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when starting a check fails", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.StartCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("returns false", func() {
+				Expect(ran).To(BeFalse())
+			})
+		})
+
+		Context("when a resource check is queued", func() {
+			var fakeResource *dbfakes.FakeResource
+
+			BeforeEach(func() {
+				fakeCheck.ResourceIDReturns(1)
+				fakeCheck.ResourceNameReturns("some-resource")
+				fakeCheckFactory.StartCheckReturns(fakeCheck, true, nil)
+
+				fakeResource = new(dbfakes.FakeResource)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+			})
+
+			It("scans the resource in the check's pipeline", func() {
+				Expect(ran).To(BeTrue())
+				Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(Equal(1))
+				Expect(fakeScannerFactory.NewResourceScannerArgsForCall(0)).To(Equal(fakePipeline))
+
+				Expect(fakeScanner.RunCallCount()).To(Equal(1))
+				_, name := fakeScanner.RunArgsForCall(0)
+				Expect(name).To(Equal("some-resource"))
+			})
+
+			It("finishes the check successfully", func() {
+				Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+				_, checkErr := fakeCheck.FinishArgsForCall(0)
+				Expect(checkErr).To(BeNil())
+			})
+
+			Context("when the scanner ran the check script", func() {
+				var result db.CheckResult
+
+				BeforeEach(func() {
+					result = db.CheckResult{
+						FromVersion: atc.Version{"ref": "v1"},
+						NewVersions: 2,
+						Stderr:      "some-stderr",
+					}
+
+					fakeScanner.RunReturns(result, nil)
+				})
+
+				It("finishes the check with what it found", func() {
+					Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+					actualResult, checkErr := fakeCheck.FinishArgsForCall(0)
+					Expect(actualResult).To(Equal(result))
+					Expect(checkErr).To(BeNil())
+				})
+			})
+
+			Context("when the check script failed", func() {
+				var scriptErr error
+
+				BeforeEach(func() {
+					scriptErr = resource.ErrResourceScriptFailed{ExitStatus: 1}
+					fakeResource.ResourceConfigCheckErrorReturns(scriptErr)
+				})
+
+				It("finishes the check with the resource config's check error", func() {
+					Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+					_, checkErr := fakeCheck.FinishArgsForCall(0)
+					Expect(checkErr).To(Equal(scriptErr))
+				})
+			})
+
+			Context("when scanning fails", func() {
+				var disaster error
+
+				BeforeEach(func() {
+					disaster = errors.New("nope")
+					fakeScanner.RunReturns(db.CheckResult{}, disaster)
+				})
+
+				It("finishes the check with the error", func() {
+					Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+					_, checkErr := fakeCheck.FinishArgsForCall(0)
+					Expect(checkErr).To(Equal(disaster))
+				})
+			})
+
+			Context("when another check of the same config holds the lock", func() {
+				BeforeEach(func() {
+					fakeScanner.RunReturns(db.CheckResult{}, radar.ErrFailedToAcquireLock)
+				})
+
+				It("finishes the check as skipped", func() {
+					Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+					result, checkErr := fakeCheck.FinishArgsForCall(0)
+					Expect(result).To(Equal(db.CheckResult{Skipped: true}))
+					Expect(checkErr).To(BeNil())
+				})
+
+				It("does not look up the check error of the resource", func() {
+					Expect(fakePipeline.ResourceCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the resource's type is over its rate limit", func() {
+				BeforeEach(func() {
+					fakeScanner.RunReturns(db.CheckResult{}, radar.CheckRateLimitedError{
+						ResourceType: "git",
+						Delay:        30 * time.Second,
+					})
+				})
+
+				It("defers the check until a token is available", func() {
+					Expect(ran).To(BeTrue())
+					Expect(fakeCheck.DeferCallCount()).To(Equal(1))
+					Expect(fakeCheck.DeferArgsForCall(0)).To(Equal(30 * time.Second))
+				})
+
+				It("does not finish the check", func() {
+					Expect(fakeCheck.FinishCallCount()).To(BeZero())
+				})
+
+				Context("when deferring the check fails", func() {
+					BeforeEach(func() {
+						fakeCheck.DeferReturns(errors.New("nope"))
+					})
+
+					It("finishes the check with the error", func() {
+						Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+						_, checkErr := fakeCheck.FinishArgsForCall(0)
+						Expect(checkErr).To(MatchError("nope"))
+					})
+				})
+			})
+
+			Context("when the pipeline is gone", func() {
+				BeforeEach(func() {
+					fakeCheck.PipelineReturns(nil, false, nil)
+				})
+
+				It("finishes the check with an error", func() {
+					Expect(fakeScanner.RunCallCount()).To(BeZero())
+					Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+					_, checkErr := fakeCheck.FinishArgsForCall(0)
+					Expect(checkErr).To(HaveOccurred())
+				})
+			})
+		})
+
+		Context("when a resource type check is queued", func() {
+			var fakeResourceType *dbfakes.FakeResourceType
+
+			BeforeEach(func() {
+				fakeCheck.ResourceTypeIDReturns(1)
+				fakeCheck.ResourceTypeNameReturns("some-type")
+				fakeCheckFactory.StartCheckReturns(fakeCheck, true, nil)
+
+				fakeResourceType = new(dbfakes.FakeResourceType)
+				fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+			})
+
+			It("scans the resource type in the check's pipeline", func() {
+				Expect(ran).To(BeTrue())
+				Expect(fakeScannerFactory.NewResourceTypeScannerCallCount()).To(Equal(1))
+				Expect(fakeScannerFactory.NewResourceScannerCallCount()).To(BeZero())
+
+				Expect(fakeScanner.RunCallCount()).To(Equal(1))
+				_, name := fakeScanner.RunArgsForCall(0)
+				Expect(name).To(Equal("some-type"))
+			})
+
+			It("finishes the check successfully", func() {
+				Expect(fakeCheck.FinishCallCount()).To(Equal(1))
+				_, checkErr := fakeCheck.FinishArgsForCall(0)
+				Expect(checkErr).To(BeNil())
+			})
+
+			Context("when the resource type's type is over its rate limit", func() {
+				BeforeEach(func() {
+					fakeScanner.RunReturns(db.CheckResult{}, radar.CheckRateLimitedError{
+						ResourceType: "registry-image",
+						Delay:        time.Second,
+					})
+				})
+
+				It("defers the check without finishing it", func() {
+					Expect(fakeCheck.DeferCallCount()).To(Equal(1))
+					Expect(fakeCheck.DeferArgsForCall(0)).To(Equal(time.Second))
+					Expect(fakeCheck.FinishCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		var process ifrit.Process
+
+		BeforeEach(func() {
+			fakeCheck.ResourceIDReturns(1)
+			fakeCheck.ResourceNameReturns("some-resource")
+			fakePipeline.ResourceReturns(new(dbfakes.FakeResource), true, nil)
+
+			fakeCheckFactory.StartCheckReturnsOnCall(0, fakeCheck, true, nil)
+			fakeCheckFactory.StartCheckReturns(nil, false, nil)
+		})
+
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(checker)
+		})
+
+		AfterEach(func() {
+			process.Signal(os.Interrupt)
+			Eventually(process.Wait()).Should(Receive())
+		})
+
+		It("runs the queued checks", func() {
+			Eventually(fakeCheck.FinishCallCount).Should(Equal(1))
+		})
+
+		It("polls the queue on the interval once it's empty", func() {
+			Eventually(fakeCheckFactory.StartCheckCallCount).Should(Equal(1 + checker.MaxConcurrent))
+			Consistently(fakeCheckFactory.StartCheckCallCount).Should(Equal(1 + checker.MaxConcurrent))
+
+			fakeClock.WaitForNWatchersAndIncrement(time.Second, checker.MaxConcurrent)
+
+			Eventually(fakeCheckFactory.StartCheckCallCount).Should(BeNumerically(">", 1+checker.MaxConcurrent))
+		})
+	})
+})
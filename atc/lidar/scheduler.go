@@ -0,0 +1,116 @@
+package lidar
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/lockrunner"
+)
+
+type scheduler struct {
+	checkFactory                 db.CheckFactory
+	resourceCheckingInterval     time.Duration
+	resourceTypeCheckingInterval time.Duration
+	checkTimeout                 time.Duration
+	checkHistoryRetention        int
+}
+
+// NewScheduler returns a task which queues a check of every resource and
+// resource type whose last check finished longer ago than its check_every,
+// or the given default interval. It's meant to be run by one ATC at a time.
+//
+// Checks which have been running for longer than the check timeout are
+// assumed to have been abandoned, and errored so that they can be queued
+// again. Only the most recent checkHistoryRetention finished checks of each
+// resource and resource type are kept.
+func NewScheduler(
+	checkFactory db.CheckFactory,
+	resourceCheckingInterval time.Duration,
+	resourceTypeCheckingInterval time.Duration,
+	checkTimeout time.Duration,
+	checkHistoryRetention int,
+) lockrunner.Task {
+	return &scheduler{
+		checkFactory:                 checkFactory,
+		resourceCheckingInterval:     resourceCheckingInterval,
+		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
+		checkTimeout:                 checkTimeout,
+		checkHistoryRetention:        checkHistoryRetention,
+	}
+}
+
+func (s *scheduler) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("check-scheduler")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	err := s.checkFactory.ExpireChecks(s.checkTimeout)
+	if err != nil {
+		logger.Error("failed-to-expire-checks", err)
+		return err
+	}
+
+	err = s.checkFactory.PruneChecks(s.checkHistoryRetention)
+	if err != nil {
+		logger.Error("failed-to-prune-checks", err)
+		return err
+	}
+
+	resourceTypes, err := s.checkFactory.ResourceTypes()
+	if err != nil {
+		logger.Error("failed-to-get-resource-types", err)
+		return err
+	}
+
+	for _, resourceType := range resourceTypes {
+		tLogger := logger.WithData(lager.Data{"resource-type-id": resourceType.ID()})
+
+		interval, err := checkInterval(resourceType.CheckEvery(), s.resourceTypeCheckingInterval)
+		if err != nil {
+			tLogger.Error("failed-to-parse-check-every", err)
+			continue
+		}
+
+		_, _, err = s.checkFactory.CreateResourceTypeCheck(resourceType.ID(), interval)
+		if err != nil {
+			tLogger.Error("failed-to-create-check", err)
+			return err
+		}
+	}
+
+	resources, err := s.checkFactory.Resources()
+	if err != nil {
+		logger.Error("failed-to-get-resources", err)
+		return err
+	}
+
+	for _, resource := range resources {
+		rLogger := logger.WithData(lager.Data{"resource-id": resource.ID()})
+
+		interval, err := checkInterval(resource.CheckEvery(), s.resourceCheckingInterval)
+		if err != nil {
+			rLogger.Error("failed-to-parse-check-every", err)
+			continue
+		}
+
+		_, _, err = s.checkFactory.CreateResourceCheck(resource.ID(), interval)
+		if err != nil {
+			rLogger.Error("failed-to-create-check", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func checkInterval(checkEvery string, defaultInterval time.Duration) (time.Duration, error) {
+	if checkEvery == "" {
+		return defaultInterval, nil
+	}
+
+	return time.ParseDuration(checkEvery)
+}
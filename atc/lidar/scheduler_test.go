@@ -0,0 +1,142 @@
+package lidar_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/lockrunner"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scheduler", func() {
+	var (
+		fakeCheckFactory *dbfakes.FakeCheckFactory
+
+		scheduler lockrunner.Task
+		runErr    error
+	)
+
+	BeforeEach(func() {
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+
+		scheduler = lidar.NewScheduler(fakeCheckFactory, time.Minute, 2*time.Minute, time.Hour, 10)
+	})
+
+	JustBeforeEach(func() {
+		runErr = scheduler.Run(context.TODO())
+	})
+
+	It("expires checks running for longer than the check timeout", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(fakeCheckFactory.ExpireChecksCallCount()).To(Equal(1))
+		Expect(fakeCheckFactory.ExpireChecksArgsForCall(0)).To(Equal(time.Hour))
+	})
+
+	It("prunes all but the retained number of finished checks", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(fakeCheckFactory.PruneChecksCallCount()).To(Equal(1))
+		Expect(fakeCheckFactory.PruneChecksArgsForCall(0)).To(Equal(10))
+	})
+
+	Context("when pruning checks fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.PruneChecksReturns(errors.New("nope"))
+		})
+
+		It("returns the error without queueing checks", func() {
+			Expect(runErr).To(HaveOccurred())
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when expiring checks fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ExpireChecksReturns(errors.New("nope"))
+		})
+
+		It("returns the error without queueing checks", func() {
+			Expect(runErr).To(HaveOccurred())
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(BeZero())
+			Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when there are resources and resource types", func() {
+		BeforeEach(func() {
+			defaultResource := new(dbfakes.FakeResource)
+			defaultResource.IDReturns(1)
+
+			configuredResource := new(dbfakes.FakeResource)
+			configuredResource.IDReturns(2)
+			configuredResource.CheckEveryReturns("10s")
+
+			invalidResource := new(dbfakes.FakeResource)
+			invalidResource.IDReturns(3)
+			invalidResource.CheckEveryReturns("bogus")
+
+			fakeCheckFactory.ResourcesReturns([]db.Resource{defaultResource, invalidResource, configuredResource}, nil)
+
+			defaultResourceType := new(dbfakes.FakeResourceType)
+			defaultResourceType.IDReturns(4)
+
+			configuredResourceType := new(dbfakes.FakeResourceType)
+			configuredResourceType.IDReturns(5)
+			configuredResourceType.CheckEveryReturns("1h")
+
+			fakeCheckFactory.ResourceTypesReturns([]db.ResourceType{defaultResourceType, configuredResourceType}, nil)
+		})
+
+		It("queues a check of each resource with its interval", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(2))
+
+			id, interval := fakeCheckFactory.CreateResourceCheckArgsForCall(0)
+			Expect(id).To(Equal(1))
+			Expect(interval).To(Equal(time.Minute))
+
+			id, interval = fakeCheckFactory.CreateResourceCheckArgsForCall(1)
+			Expect(id).To(Equal(2))
+			Expect(interval).To(Equal(10 * time.Second))
+		})
+
+		It("queues a check of each resource type with its interval", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeCheckFactory.CreateResourceTypeCheckCallCount()).To(Equal(2))
+
+			id, interval := fakeCheckFactory.CreateResourceTypeCheckArgsForCall(0)
+			Expect(id).To(Equal(4))
+			Expect(interval).To(Equal(2 * time.Minute))
+
+			id, interval = fakeCheckFactory.CreateResourceTypeCheckArgsForCall(1)
+			Expect(id).To(Equal(5))
+			Expect(interval).To(Equal(time.Hour))
+		})
+
+		Context("when queueing a check fails", func() {
+			BeforeEach(func() {
+				fakeCheckFactory.CreateResourceCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(HaveOccurred())
+				Expect(fakeCheckFactory.CreateResourceCheckCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when getting the resources fails", func() {
+		BeforeEach(func() {
+			fakeCheckFactory.ResourcesReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(HaveOccurred())
+		})
+	})
+})
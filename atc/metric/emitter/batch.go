@@ -0,0 +1,174 @@
+package emitter
+
+import (
+	"bytes"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// lineBatcher buffers newline-delimited metrics and writes them to a
+// connection in batches, either once the next line would make the batch
+// exceed the maximum size or after the flush interval has passed.
+//
+// The connection is established lazily and re-established after a failed
+// write, dropping the batch that failed rather than holding up emission.
+type lineBatcher struct {
+	logger  lager.Logger
+	network string
+	address string
+	maxSize int
+
+	lock sync.Mutex
+	buf  bytes.Buffer
+	conn net.Conn
+}
+
+func newLineBatcher(network string, address string, maxSize int, flushInterval time.Duration) *lineBatcher {
+	batcher := &lineBatcher{
+		logger:  lager.NewLogger("metrics"),
+		network: network,
+		address: address,
+		maxSize: maxSize,
+	}
+
+	go batcher.flushLoop(flushInterval)
+
+	return batcher
+}
+
+// Add queues the line to be sent with the next batch. Errors sending the
+// batch are logged to the logger most recently passed to Add.
+func (batcher *lineBatcher) Add(logger lager.Logger, line string) {
+	batcher.lock.Lock()
+	defer batcher.lock.Unlock()
+
+	batcher.logger = logger
+
+	if batcher.buf.Len() > 0 && batcher.buf.Len()+len(line)+1 > batcher.maxSize {
+		batcher.flush()
+	}
+
+	batcher.buf.WriteString(line)
+	batcher.buf.WriteByte('\n')
+}
+
+func (batcher *lineBatcher) flushLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		batcher.lock.Lock()
+		batcher.flush()
+		batcher.lock.Unlock()
+	}
+}
+
+// flush must be called with the lock held.
+func (batcher *lineBatcher) flush() {
+	if batcher.buf.Len() == 0 {
+		return
+	}
+
+	defer batcher.buf.Reset()
+
+	if batcher.conn == nil {
+		conn, err := net.DialTimeout(batcher.network, batcher.address, 5*time.Second)
+		if err != nil {
+			batcher.logger.Error("failed-to-connect", err)
+			return
+		}
+
+		batcher.conn = conn
+	}
+
+	_ = batcher.conn.SetWriteDeadline(time.Now().Add(5 * time.Second))
+
+	_, err := batcher.conn.Write(batcher.buf.Bytes())
+	if err != nil {
+		batcher.logger.Error("failed-to-send-metrics", err)
+
+		_ = batcher.conn.Close()
+		batcher.conn = nil
+	}
+}
+
+var invalidPathChars = regexp.MustCompile("[^a-zA-Z0-9_-]+")
+
+// pathAttributes are the attributes flattened into metric paths. Every
+// distinct path is a new series (a whisper file, for Graphite), so attributes
+// which take a new value for each build or request, such as build_id, are
+// left out.
+var pathAttributes = map[string]bool{
+	"team_name":      true,
+	"team":           true,
+	"pipeline":       true,
+	"job":            true,
+	"resource":       true,
+	"resource_type":  true,
+	"step_type":      true,
+	"build_status":   true,
+	"worker":         true,
+	"worker_state":   true,
+	"platform":       true,
+	"type":           true,
+	"route":          true,
+	"method":         true,
+	"status":         true,
+	"ConnectionName": true,
+}
+
+// metricPath flattens the event into a dot-separated path suitable for
+// StatsD and Graphite, which have no notion of tags: the sanitized event name
+// is followed by a key and value segment for the host and each of the
+// pathAttributes, sorted by key so that the same attributes always produce
+// the same path.
+func metricPath(prefix string, event metric.Event) string {
+	name := specialChars.ReplaceAllString(strings.Replace(strings.ToLower(event.Name), " ", "_", -1), "")
+
+	segments := []string{}
+	if prefix != "" {
+		segments = append(segments, strings.TrimSuffix(prefix, "."))
+	}
+
+	segments = append(segments, name)
+
+	tags := map[string]string{}
+	for k, v := range event.Attributes {
+		if pathAttributes[k] {
+			tags[k] = v
+		}
+	}
+
+	if event.Host != "" {
+		tags["host"] = event.Host
+	}
+
+	keys := make([]string, 0, len(tags))
+	for k := range tags {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		segments = append(segments, pathSegment(k), pathSegment(tags[k]))
+	}
+
+	return strings.Join(segments, ".")
+}
+
+func pathSegment(s string) string {
+	segment := invalidPathChars.ReplaceAllString(s, "_")
+	if segment == "" {
+		return "_"
+	}
+
+	return segment
+}
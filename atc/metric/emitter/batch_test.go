@@ -0,0 +1,141 @@
+package emitter
+
+import (
+	"bufio"
+	"net"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("lineBatcher", func() {
+	var (
+		listener net.Listener
+		conns    chan net.Conn
+		lines    chan string
+		logger   *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		conns = make(chan net.Conn, 10)
+		lines = make(chan string, 100)
+		logger = lagertest.NewTestLogger("test")
+
+		go func() {
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					return
+				}
+
+				conns <- conn
+
+				go func() {
+					scanner := bufio.NewScanner(conn)
+					for scanner.Scan() {
+						lines <- scanner.Text()
+					}
+				}()
+			}
+		}()
+	})
+
+	AfterEach(func() {
+		Expect(listener.Close()).To(Succeed())
+	})
+
+	Context("when the next line would exceed the maximum batch size", func() {
+		It("sends the batch so far", func() {
+			batcher := newLineBatcher("tcp", listener.Addr().String(), 12, time.Hour)
+
+			batcher.Add(logger, "a.b 1")
+			batcher.Add(logger, "c.d 2")
+			Consistently(lines).ShouldNot(Receive())
+
+			batcher.Add(logger, "e.f 3")
+			Eventually(lines).Should(Receive(Equal("a.b 1")))
+			Eventually(lines).Should(Receive(Equal("c.d 2")))
+			Consistently(lines).ShouldNot(Receive())
+		})
+	})
+
+	Context("when the flush interval passes", func() {
+		It("sends the batch", func() {
+			batcher := newLineBatcher("tcp", listener.Addr().String(), 1024, 10*time.Millisecond)
+
+			batcher.Add(logger, "a.b 1")
+			Eventually(lines).Should(Receive(Equal("a.b 1")))
+		})
+	})
+
+	Context("when a write fails", func() {
+		It("reconnects for the next batch", func() {
+			batcher := newLineBatcher("tcp", listener.Addr().String(), 1, time.Hour)
+
+			batcher.Add(logger, "a.b 1")
+			batcher.Add(logger, "c.d 2")
+			Eventually(lines).Should(Receive(Equal("a.b 1")))
+
+			var conn net.Conn
+			Eventually(conns).Should(Receive(&conn))
+			Expect(conn.Close()).To(Succeed())
+
+			Eventually(func() chan net.Conn {
+				batcher.Add(logger, "e.f 3")
+				return conns
+			}).Should(Receive())
+
+			Eventually(logger.Buffer()).Should(gbytes.Say("failed-to-send-metrics"))
+			Eventually(lines).Should(Receive(Equal("e.f 3")))
+		})
+	})
+})
+
+var _ = Describe("metricPath", func() {
+	It("flattens the host and attributes into the path, sorted by key", func() {
+		path := metricPath("concourse.", metric.Event{
+			Name: "build finished",
+			Host: "web-1",
+			Attributes: map[string]string{
+				"team_name": "main",
+				"pipeline":  "some-pipeline",
+			},
+		})
+
+		Expect(path).To(Equal("concourse.build_finished.host.web-1.pipeline.some-pipeline.team_name.main"))
+	})
+
+	It("escapes characters which are not valid in a path segment", func() {
+		path := metricPath("", metric.Event{
+			Name: "http response time",
+			Attributes: map[string]string{
+				"route":  "GetBuild",
+				"method": "GET /api/v1",
+				"status": "",
+			},
+		})
+
+		Expect(path).To(Equal("http_response_time.method.GET_api_v1.route.GetBuild.status._"))
+	})
+
+	It("leaves out high-cardinality attributes", func() {
+		path := metricPath("", metric.Event{
+			Name: "build started",
+			Attributes: map[string]string{
+				"job":        "some-job",
+				"build_id":   "42",
+				"build_name": "7",
+			},
+		})
+
+		Expect(path).To(Equal("build_started.job.some-job"))
+	})
+})
@@ -0,0 +1,65 @@
+package emitter
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type GraphiteEmitter struct {
+	batcher *lineBatcher
+	prefix  string
+}
+
+type GraphiteConfig struct {
+	Host   string `long:"graphite-host"                   description:"Graphite server address to emit metrics to using the plaintext protocol."`
+	Port   uint16 `long:"graphite-port"   default:"2003"  description:"Port of the Graphite server to emit metrics to."`
+	Prefix string `long:"graphite-prefix" default:"concourse" description:"Prefix for all metric paths sent to the Graphite server."`
+
+	BatchSize     int           `long:"graphite-batch-size"     default:"65536" description:"Maximum size in bytes of a batch of metrics sent to the Graphite server."`
+	FlushInterval time.Duration `long:"graphite-flush-interval" default:"1s"    description:"Interval on which to send batched metrics to the Graphite server."`
+}
+
+func init() {
+	metric.RegisterEmitter(&GraphiteConfig{})
+}
+
+func (config *GraphiteConfig) Description() string { return "Graphite" }
+func (config *GraphiteConfig) IsConfigured() bool  { return config.Host != "" }
+
+func (config *GraphiteConfig) NewEmitter() (metric.Emitter, error) {
+	if config.FlushInterval <= 0 {
+		return nil, fmt.Errorf("graphite flush interval must be positive")
+	}
+
+	return &GraphiteEmitter{
+		batcher: newLineBatcher(
+			"tcp",
+			net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))),
+			config.BatchSize,
+			config.FlushInterval,
+		),
+		prefix: config.Prefix,
+	}, nil
+}
+
+func (emitter *GraphiteEmitter) Emit(logger lager.Logger, event metric.Event) {
+	value, err := getFloatHelper(event.Value)
+	if err != nil {
+		logger.Error("failed-to-convert-metric-for-graphite", err, lager.Data{
+			"metric-name": event.Name,
+		})
+		return
+	}
+
+	emitter.batcher.Add(logger, fmt.Sprintf(
+		"%s %s %d",
+		metricPath(emitter.prefix, event),
+		strconv.FormatFloat(value, 'f', -1, 64),
+		event.Time.Unix(),
+	))
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -42,6 +43,19 @@ type PrometheusEmitter struct {
 
 	resourceChecksVec *prometheus.CounterVec
 
+	tasksWaiting      prometheus.Gauge
+	tasksWaitDuration prometheus.Histogram
+
+	buildSchedulingLatency *prometheus.HistogramVec
+	stepDurations          *prometheus.HistogramVec
+	imageFetchDurations    *prometheus.HistogramVec
+	volumeStreamDurations  *prometheus.HistogramVec
+
+	checkQueueLatency *prometheus.HistogramVec
+	checkDeferrals    *prometheus.HistogramVec
+
+	labels *labelLimiter
+
 	workerLastSeen map[string]time.Time
 	mu             sync.Mutex
 }
@@ -49,6 +63,8 @@ type PrometheusEmitter struct {
 type PrometheusConfig struct {
 	BindIP   string `long:"prometheus-bind-ip" description:"IP to listen on to expose Prometheus metrics."`
 	BindPort string `long:"prometheus-bind-port" description:"Port to listen on to expose Prometheus metrics."`
+
+	MaxLabelCombinations int `long:"prometheus-max-label-combinations" default:"1000" description:"Maximum number of team/pipeline/job label combinations tracked by each build histogram. Further combinations are recorded with the labels set to 'other'. 0 means unlimited."`
 }
 
 func init() {
@@ -256,6 +272,98 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(resourceChecksVec)
 
+	// task metrics
+	tasksWaiting := prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "concourse",
+		Subsystem: "tasks",
+		Name:      "waiting",
+		Help:      "Number of tasks waiting for a worker with capacity to run them",
+	})
+	prometheus.MustRegister(tasksWaiting)
+
+	tasksWaitDuration := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "concourse",
+		Subsystem: "tasks",
+		Name:      "wait_duration_seconds",
+		Help:      "Time tasks spent waiting for a worker with capacity to run them",
+		Buckets:   []float64{1, 15, 30, 60, 120, 180, 240, 300, 600, 1200},
+	})
+	prometheus.MustRegister(tasksWaitDuration)
+
+	// build step metrics
+	buildSchedulingLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "builds",
+			Name:      "scheduling_latency_seconds",
+			Help:      "Time from a pending build being created to it being started",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 3600},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(buildSchedulingLatency)
+
+	stepDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds",
+			Help:      "Time taken to run get, put and task steps",
+			Buckets:   []float64{1, 5, 15, 30, 60, 180, 300, 600, 1200, 1800, 3600, 7200},
+		},
+		[]string{"team", "pipeline", "job", "step_type"},
+	)
+	prometheus.MustRegister(stepDurations)
+
+	imageFetchDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "images",
+			Name:      "fetch_duration_seconds",
+			Help:      "Time taken to fetch the image for a container",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(imageFetchDurations)
+
+	volumeStreamDurations := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "stream_duration_seconds",
+			Help:      "Time taken to stream an input volume to the worker running a container",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"team", "pipeline", "job"},
+	)
+	prometheus.MustRegister(volumeStreamDurations)
+
+	// check metrics
+	checkQueueLatency := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "check_queue_latency_seconds",
+			Help:      "Time queued checks waited to be run",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"team", "pipeline"},
+	)
+	prometheus.MustRegister(checkQueueLatency)
+
+	checkDeferrals := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "check_deferral_seconds",
+			Help:      "Time checks were deferred for by the rate limit of their resource type",
+			Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"team", "pipeline", "resource_type"},
+	)
+	prometheus.MustRegister(checkDeferrals)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -289,6 +397,19 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		resourceChecksVec: resourceChecksVec,
 
+		tasksWaiting:      tasksWaiting,
+		tasksWaitDuration: tasksWaitDuration,
+
+		buildSchedulingLatency: buildSchedulingLatency,
+		stepDurations:          stepDurations,
+		imageFetchDurations:    imageFetchDurations,
+		volumeStreamDurations:  volumeStreamDurations,
+
+		checkQueueLatency: checkQueueLatency,
+		checkDeferrals:    checkDeferrals,
+
+		labels: newLabelLimiter(config.MaxLabelCombinations),
+
 		workerLastSeen: map[string]time.Time{},
 	}
 	go emitter.periodicMetricGC()
@@ -332,6 +453,22 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.databaseMetrics(logger, event)
 	case "resource checked":
 		emitter.resourceMetric(logger, event)
+	case "tasks waiting":
+		emitter.tasksWaitingMetric(logger, event)
+	case "tasks wait duration (ms)":
+		emitter.tasksWaitDurationMetric(logger, event)
+	case "build scheduling latency (ms)":
+		emitter.buildHistogramMetric(logger, event, emitter.buildSchedulingLatency)
+	case "step duration (ms)":
+		emitter.buildHistogramMetric(logger, event, emitter.stepDurations, "step_type")
+	case "image fetch duration (ms)":
+		emitter.buildHistogramMetric(logger, event, emitter.imageFetchDurations)
+	case "volume stream duration (ms)":
+		emitter.buildHistogramMetric(logger, event, emitter.volumeStreamDurations)
+	case "check queue latency (ms)":
+		emitter.checkHistogramMetric(logger, event, emitter.checkQueueLatency)
+	case "check deferred (ms)":
+		emitter.checkHistogramMetric(logger, event, emitter.checkDeferrals, "resource_type")
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -562,6 +699,138 @@ func (emitter *PrometheusEmitter) resourceMetric(logger lager.Logger, event metr
 	emitter.resourceChecksVec.WithLabelValues(team, pipeline).Inc()
 }
 
+func (emitter *PrometheusEmitter) tasksWaitingMetric(logger lager.Logger, event metric.Event) {
+	value, ok := event.Value.(int)
+	if !ok {
+		logger.Error("tasks-waiting-value-type-mismatch", fmt.Errorf("expected event.Value to be a int"))
+		return
+	}
+
+	emitter.tasksWaiting.Set(float64(value))
+}
+
+func (emitter *PrometheusEmitter) tasksWaitDurationMetric(logger lager.Logger, event metric.Event) {
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("tasks-wait-duration-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	// seconds are the standard prometheus base unit for time
+	emitter.tasksWaitDuration.Observe(duration / 1000)
+}
+
+// buildHistogramMetric observes a duration labeled by the team, pipeline and
+// job it was measured for, followed by any extra attributes.
+func (emitter *PrometheusEmitter) buildHistogramMetric(logger lager.Logger, event metric.Event, histogram *prometheus.HistogramVec, extraAttributes ...string) {
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("build-histogram-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	labels := emitter.labels.limit(
+		event.Name,
+		event.Attributes["team_name"],
+		event.Attributes["pipeline"],
+		event.Attributes["job"],
+	)
+
+	for _, attribute := range extraAttributes {
+		value, exists := event.Attributes[attribute]
+		if !exists {
+			logger.Error("failed-to-find-attribute-in-event", fmt.Errorf("expected %s to exist in event.Attributes", attribute))
+			return
+		}
+
+		labels = append(labels, value)
+	}
+
+	// seconds are the standard prometheus base unit for time
+	histogram.WithLabelValues(labels...).Observe(duration / 1000)
+}
+
+// checkHistogramMetric observes a duration labeled by the team and pipeline
+// of the check it was measured for, followed by any extra attributes. The
+// checked resource isn't a label, as there are too many of them.
+func (emitter *PrometheusEmitter) checkHistogramMetric(logger lager.Logger, event metric.Event, histogram *prometheus.HistogramVec, extraAttributes ...string) {
+	duration, ok := event.Value.(float64)
+	if !ok {
+		logger.Error("check-histogram-value-type-mismatch", fmt.Errorf("expected event.Value to be a float64"))
+		return
+	}
+
+	values := []string{
+		event.Attributes["team_name"],
+		event.Attributes["pipeline"],
+	}
+
+	for _, attribute := range extraAttributes {
+		value, exists := event.Attributes[attribute]
+		if !exists {
+			logger.Error("failed-to-find-attribute-in-event", fmt.Errorf("expected %s to exist in event.Attributes", attribute))
+			return
+		}
+
+		values = append(values, value)
+	}
+
+	labels := emitter.labels.limit(event.Name, values...)
+
+	// seconds are the standard prometheus base unit for time
+	histogram.WithLabelValues(labels...).Observe(duration / 1000)
+}
+
+// labelLimiter bounds the number of label combinations tracked per metric,
+// so that a large number of teams, pipelines or jobs can't blow up the number
+// of series exported.
+type labelLimiter struct {
+	max int
+
+	lock sync.Mutex
+	seen map[string]map[string]bool
+}
+
+const otherLabelValue = "other"
+
+func newLabelLimiter(max int) *labelLimiter {
+	return &labelLimiter{
+		max:  max,
+		seen: map[string]map[string]bool{},
+	}
+}
+
+// limit returns the given label values if they are already tracked for the
+// metric or there is room to track them, and "other" for each value
+// otherwise.
+func (limiter *labelLimiter) limit(metric string, values ...string) []string {
+	if limiter.max == 0 {
+		return values
+	}
+
+	limiter.lock.Lock()
+	defer limiter.lock.Unlock()
+
+	seen, found := limiter.seen[metric]
+	if !found {
+		seen = map[string]bool{}
+		limiter.seen[metric] = seen
+	}
+
+	key := strings.Join(values, "\x00")
+	if seen[key] || len(seen) < limiter.max {
+		seen[key] = true
+		return values
+	}
+
+	other := make([]string, len(values))
+	for i := range other {
+		other[i] = otherLabelValue
+	}
+
+	return other
+}
+
 // updateLastSeen tracks for each worker when it last received a metric event.
 func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	emitter.mu.Lock()
@@ -0,0 +1,74 @@
+package emitter
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type StatsDEmitter struct {
+	batcher *lineBatcher
+	prefix  string
+}
+
+type StatsDConfig struct {
+	Host     string `long:"statsd-host"                  description:"StatsD server address to emit metrics to."`
+	Port     uint16 `long:"statsd-port"     default:"8125" description:"Port of the StatsD server to emit metrics to."`
+	Protocol string `long:"statsd-protocol" default:"udp"  choice:"udp" choice:"tcp" description:"Protocol to use when sending metrics to the StatsD server."`
+	Prefix   string `long:"statsd-prefix"   default:"concourse" description:"Prefix for all metric paths sent to the StatsD server."`
+
+	MaxPacketSize int           `long:"statsd-max-packet-size" default:"1432" description:"Maximum size in bytes of a batch of metrics sent to the StatsD server."`
+	FlushInterval time.Duration `long:"statsd-flush-interval"  default:"1s"   description:"Interval on which to send batched metrics to the StatsD server."`
+}
+
+func init() {
+	metric.RegisterEmitter(&StatsDConfig{})
+}
+
+func (config *StatsDConfig) Description() string { return "StatsD" }
+func (config *StatsDConfig) IsConfigured() bool  { return config.Host != "" }
+
+func (config *StatsDConfig) NewEmitter() (metric.Emitter, error) {
+	if config.FlushInterval <= 0 {
+		return nil, fmt.Errorf("statsd flush interval must be positive")
+	}
+
+	return &StatsDEmitter{
+		batcher: newLineBatcher(
+			config.Protocol,
+			net.JoinHostPort(config.Host, strconv.Itoa(int(config.Port))),
+			config.MaxPacketSize,
+			config.FlushInterval,
+		),
+		prefix: config.Prefix,
+	}, nil
+}
+
+func (emitter *StatsDEmitter) Emit(logger lager.Logger, event metric.Event) {
+	value, err := getFloatHelper(event.Value)
+	if err != nil {
+		logger.Error("failed-to-convert-metric-for-statsd", err, lager.Data{
+			"metric-name": event.Name,
+		})
+		return
+	}
+
+	// durations are sent as timers so that the server computes percentiles;
+	// everything else is the latest value of a gauge
+	metricType := "g"
+	if strings.HasSuffix(event.Name, "(ms)") {
+		metricType = "ms"
+	}
+
+	emitter.batcher.Add(logger, fmt.Sprintf(
+		"%s:%s|%s",
+		metricPath(emitter.prefix, event),
+		strconv.FormatFloat(value, 'f', -1, 64),
+		metricType,
+	))
+}
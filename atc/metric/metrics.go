@@ -21,6 +21,8 @@ var FailedVolumes = Meter(0)
 var ContainersDeleted = Meter(0)
 var VolumesDeleted = Meter(0)
 
+var TasksWaiting = &Gauge{}
+
 type SchedulingFullDuration struct {
 	PipelineName string
 	Duration     time.Duration
@@ -298,6 +300,115 @@ func (event GarbageCollectionContainerCollectorJobDropped) Emit(logger lager.Log
 	)
 }
 
+type TasksWaitDuration struct {
+	Duration time.Duration
+}
+
+func (event TasksWaitDuration) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("tasks-wait-duration"),
+		Event{
+			Name:  "tasks wait duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+		},
+	)
+}
+
+type BuildSchedulingLatency struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Duration     time.Duration
+}
+
+func (event BuildSchedulingLatency) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("build-scheduling-latency"),
+		Event{
+			Name:  "build scheduling latency (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+			},
+		},
+	)
+}
+
+type StepFinished struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	StepType     string
+	Duration     time.Duration
+}
+
+func (event StepFinished) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("step-finished"),
+		Event{
+			Name:  "step duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+				"step_type": event.StepType,
+			},
+		},
+	)
+}
+
+type ImageFetched struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Duration     time.Duration
+}
+
+func (event ImageFetched) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("image-fetched"),
+		Event{
+			Name:  "image fetch duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+			},
+		},
+	)
+}
+
+type VolumeStreamed struct {
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Duration     time.Duration
+}
+
+func (event VolumeStreamed) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("volume-streamed"),
+		Event{
+			Name:  "volume stream duration (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"job":       event.JobName,
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	PipelineName string
 	JobName      string
@@ -419,6 +530,54 @@ func (event ResourceCheck) Emit(logger lager.Logger) {
 	)
 }
 
+type CheckQueueLatency struct {
+	TeamName     string
+	PipelineName string
+	Name         string
+	Duration     time.Duration
+}
+
+func (event CheckQueueLatency) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-queue-latency"),
+		Event{
+			Name:  "check queue latency (ms)",
+			Value: ms(event.Duration),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name": event.TeamName,
+				"pipeline":  event.PipelineName,
+				"resource":  event.Name,
+			},
+		},
+	)
+}
+
+type CheckDeferred struct {
+	TeamName     string
+	PipelineName string
+	Name         string
+	ResourceType string
+	Delay        time.Duration
+}
+
+func (event CheckDeferred) Emit(logger lager.Logger) {
+	emit(
+		logger.Session("check-deferred"),
+		Event{
+			Name:  "check deferred (ms)",
+			Value: ms(event.Delay),
+			State: EventStateOK,
+			Attributes: map[string]string{
+				"team_name":     event.TeamName,
+				"pipeline":      event.PipelineName,
+				"resource":      event.Name,
+				"resource_type": event.ResourceType,
+			},
+		},
+	)
+}
+
 var lockTypeNames = map[int]string{
 	lock.LockTypeResourceConfigChecking: "ResourceConfigChecking",
 	lock.LockTypeBuildTracking:          "BuildTracking",
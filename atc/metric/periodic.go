@@ -107,6 +107,15 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("tasks-waiting"),
+		Event{
+			Name:  "tasks waiting",
+			Value: TasksWaiting.Max(),
+			State: EventStateOK,
+		},
+	)
+
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
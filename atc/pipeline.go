@@ -1,14 +1,105 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
 type Pipeline struct {
-	ID       int          `json:"id"`
-	Name     string       `json:"name"`
-	Paused   bool         `json:"paused"`
-	Public   bool         `json:"public"`
-	Groups   GroupConfigs `json:"groups,omitempty"`
-	TeamName string       `json:"team_name"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Archived     bool         `json:"archived"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// InstanceVars distinguish pipelines which share a name, e.g. one instance
+// of a release pipeline per release branch.
+type InstanceVars map[string]interface{}
+
+// String renders the vars as comma-separated key:value pairs, ordered by key.
+func (vars InstanceVars) String() string {
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		var value string
+		switch v := vars[key].(type) {
+		case string:
+			value = v
+		default:
+			payload, _ := json.Marshal(v)
+			value = string(payload)
+		}
+
+		pairs[i] = fmt.Sprintf("%s:%s", key, value)
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// PipelineRef identifies a pipeline within a team by its name and, for
+// instanced pipelines, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	return ref.Name + "/" + ref.InstanceVars.String()
+}
+
+// QueryParams returns the query params which select this pipeline instance on
+// the pipeline-scoped API routes.
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+
+	return url.Values{"vars": []string{string(payload)}}
+}
+
+// InstanceVarsFromQueryParams parses the instance vars given by
+// PipelineRef.QueryParams, returning nil if there are none.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get("vars")
+	if payload == "" {
+		return nil, nil
+	}
+
+	var vars InstanceVars
+	err := json.Unmarshal([]byte(payload), &vars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %s", err)
+	}
+
+	return vars, nil
+}
@@ -0,0 +1,61 @@
+package atc_test
+
+import (
+	"net/url"
+
+	. "github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("is just the name when there are no instance vars", func() {
+			Expect(PipelineRef{Name: "some-pipeline"}.String()).To(Equal("some-pipeline"))
+		})
+
+		It("includes the instance vars, ordered by key", func() {
+			ref := PipelineRef{
+				Name: "release",
+				InstanceVars: InstanceVars{
+					"version": "5.1",
+					"build":   2,
+					"env":     map[string]interface{}{"region": "us"},
+				},
+			}
+
+			Expect(ref.String()).To(Equal(`release/build:2,env:{"region":"us"},version:5.1`))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("is empty when there are no instance vars", func() {
+			Expect(PipelineRef{Name: "some-pipeline"}.QueryParams()).To(BeEmpty())
+		})
+
+		It("round-trips through InstanceVarsFromQueryParams", func() {
+			ref := PipelineRef{
+				Name:         "release",
+				InstanceVars: InstanceVars{"version": "5.1"},
+			}
+
+			vars, err := InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("returns nil when there are no vars", func() {
+			vars, err := InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vars).To(BeNil())
+		})
+
+		It("errors when the vars are malformed", func() {
+			_, err := InstanceVarsFromQueryParams(url.Values{"vars": []string{"{"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -20,11 +20,11 @@ import (
 //go:generate counterfeiter . RadarSchedulerFactory
 
 type RadarSchedulerFactory interface {
-	BuildScanRunnerFactory(dbPipeline db.Pipeline, externalURL string, variables creds.Variables) radar.ScanRunnerFactory
 	BuildScheduler(pipeline db.Pipeline, externalURL string, variables creds.Variables) scheduler.BuildScheduler
 }
 
 type radarSchedulerFactory struct {
+	checkRateLimiter             db.CheckRateLimiter
 	resourceFactory              resource.ResourceFactory
 	resourceConfigFactory        db.ResourceConfigFactory
 	resourceTypeCheckingInterval time.Duration
@@ -33,6 +33,7 @@ type radarSchedulerFactory struct {
 }
 
 func NewRadarSchedulerFactory(
+	checkRateLimiter db.CheckRateLimiter,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	resourceTypeCheckingInterval time.Duration,
@@ -40,6 +41,7 @@ func NewRadarSchedulerFactory(
 	engine engine.Engine,
 ) RadarSchedulerFactory {
 	return &radarSchedulerFactory{
+		checkRateLimiter:             checkRateLimiter,
 		resourceFactory:              resourceFactory,
 		resourceConfigFactory:        resourceConfigFactory,
 		resourceTypeCheckingInterval: resourceTypeCheckingInterval,
@@ -48,14 +50,11 @@ func NewRadarSchedulerFactory(
 	}
 }
 
-func (rsf *radarSchedulerFactory) BuildScanRunnerFactory(dbPipeline db.Pipeline, externalURL string, variables creds.Variables) radar.ScanRunnerFactory {
-	return radar.NewScanRunnerFactory(rsf.resourceFactory, rsf.resourceConfigFactory, rsf.resourceTypeCheckingInterval, rsf.resourceCheckingInterval, dbPipeline, clock.NewClock(), externalURL, variables)
-}
-
 func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalURL string, variables creds.Variables) scheduler.BuildScheduler {
 
 	resourceTypeScanner := radar.NewResourceTypeScanner(
 		clock.NewClock(),
+		rsf.checkRateLimiter,
 		rsf.resourceFactory,
 		rsf.resourceConfigFactory,
 		rsf.resourceTypeCheckingInterval,
@@ -66,6 +65,7 @@ func (rsf *radarSchedulerFactory) BuildScheduler(pipeline db.Pipeline, externalU
 
 	scanner := radar.NewResourceScanner(
 		clock.NewClock(),
+		rsf.checkRateLimiter,
 		rsf.resourceFactory,
 		rsf.resourceConfigFactory,
 		rsf.resourceCheckingInterval,
@@ -4,18 +4,20 @@ type Plan struct {
 	ID       PlanID `json:"id"`
 	Attempts []int  `json:"attempts,omitempty"`
 
-	Aggregate *AggregatePlan `json:"aggregate,omitempty"`
-	Do        *DoPlan        `json:"do,omitempty"`
-	Get       *GetPlan       `json:"get,omitempty"`
-	Put       *PutPlan       `json:"put,omitempty"`
-	Task      *TaskPlan      `json:"task,omitempty"`
-	OnAbort   *OnAbortPlan   `json:"on_abort,ommitempty"`
-	Ensure    *EnsurePlan    `json:"ensure,omitempty"`
-	OnSuccess *OnSuccessPlan `json:"on_success,omitempty"`
-	OnFailure *OnFailurePlan `json:"on_failure,omitempty"`
-	Try       *TryPlan       `json:"try,omitempty"`
-	Timeout   *TimeoutPlan   `json:"timeout,omitempty"`
-	Retry     *RetryPlan     `json:"retry,omitempty"`
+	Aggregate   *AggregatePlan   `json:"aggregate,omitempty"`
+	Do          *DoPlan          `json:"do,omitempty"`
+	Get         *GetPlan         `json:"get,omitempty"`
+	Put         *PutPlan         `json:"put,omitempty"`
+	Task        *TaskPlan        `json:"task,omitempty"`
+	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
+	OnAbort     *OnAbortPlan     `json:"on_abort,ommitempty"`
+	Ensure      *EnsurePlan      `json:"ensure,omitempty"`
+	OnSuccess   *OnSuccessPlan   `json:"on_success,omitempty"`
+	OnFailure   *OnFailurePlan   `json:"on_failure,omitempty"`
+	Try         *TryPlan         `json:"try,omitempty"`
+	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
+	Retry       *RetryPlan       `json:"retry,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 
 	// used for 'fly execute'
 	UserArtifact   *UserArtifactPlan   `json:"user_artifact,omitempty"`
@@ -27,6 +29,67 @@ type Plan struct {
 
 type PlanID string
 
+// Each calls f with the plan and each of the plans it is composed of.
+func (plan *Plan) Each(f func(*Plan)) {
+	f(plan)
+
+	if plan.Aggregate != nil {
+		for i := range *plan.Aggregate {
+			(*plan.Aggregate)[i].Each(f)
+		}
+	}
+
+	if plan.Do != nil {
+		for i := range *plan.Do {
+			(*plan.Do)[i].Each(f)
+		}
+	}
+
+	if plan.OnAbort != nil {
+		plan.OnAbort.Step.Each(f)
+		plan.OnAbort.Next.Each(f)
+	}
+
+	if plan.Ensure != nil {
+		plan.Ensure.Step.Each(f)
+		plan.Ensure.Next.Each(f)
+	}
+
+	if plan.OnSuccess != nil {
+		plan.OnSuccess.Step.Each(f)
+		plan.OnSuccess.Next.Each(f)
+	}
+
+	if plan.OnFailure != nil {
+		plan.OnFailure.Step.Each(f)
+		plan.OnFailure.Next.Each(f)
+	}
+
+	if plan.Try != nil {
+		plan.Try.Step.Each(f)
+	}
+
+	if plan.Timeout != nil {
+		plan.Timeout.Step.Each(f)
+	}
+
+	if plan.Retry != nil {
+		for i := range *plan.Retry {
+			(*plan.Retry)[i].Each(f)
+		}
+	}
+
+	if plan.Across != nil {
+		for i := range plan.Across.Steps {
+			plan.Across.Steps[i].Step.Each(f)
+		}
+
+		if plan.Across.Step != nil {
+			plan.Across.Step.Each(f)
+		}
+	}
+}
+
 type UserArtifactPlan struct {
 	Name string `json:"name"`
 }
@@ -107,12 +170,39 @@ type TaskPlan struct {
 	InputMapping      map[string]string `json:"input_mapping,omitempty"`
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
+	TestReports       []string          `json:"test_reports,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
+type SetPipelinePlan struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	File         string       `json:"file"`
+	Vars         Params       `json:"vars,omitempty"`
+	VarFiles     []string     `json:"var_files,omitempty"`
+}
+
 type RetryPlan []Plan
 
+// AcrossPlan runs a step once for each value, with the value bound to Var.
+// Values given as a list are known up front, so each value's copy of the step
+// is planned in Steps. Values given as a ((var)) are only resolved when the
+// across step runs, so Step is run for each of them instead, with a suffix
+// of the value's index appended to the IDs of its plans.
+type AcrossPlan struct {
+	Var         string          `json:"var"`
+	Steps       []VarScopedPlan `json:"steps,omitempty"`
+	ValuesVar   string          `json:"values_var,omitempty"`
+	Step        *Plan           `json:"step,omitempty"`
+	MaxInFlight int             `json:"max_in_flight,omitempty"`
+}
+
+type VarScopedPlan struct {
+	Step  Plan        `json:"step"`
+	Value interface{} `json:"value"`
+}
+
 type DependentGetPlan struct {
 	Type     string `json:"type"`
 	Name     string `json:"name,omitempty"`
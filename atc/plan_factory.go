@@ -35,6 +35,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Put = &t
 	case TaskPlan:
 		plan.Task = &t
+	case SetPipelinePlan:
+		plan.SetPipeline = &t
 	case OnAbortPlan:
 		plan.OnAbort = &t
 	case EnsurePlan:
@@ -49,6 +51,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case UserArtifactPlan:
 		plan.UserArtifact = &t
 	case ArtifactOutputPlan:
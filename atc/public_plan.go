@@ -11,6 +11,7 @@ func (plan Plan) Public() *json.RawMessage {
 		Get            *json.RawMessage `json:"get,omitempty"`
 		Put            *json.RawMessage `json:"put,omitempty"`
 		Task           *json.RawMessage `json:"task,omitempty"`
+		SetPipeline    *json.RawMessage `json:"set_pipeline,omitempty"`
 		OnAbort        *json.RawMessage `json:"on_abort,omitempty"`
 		Ensure         *json.RawMessage `json:"ensure,omitempty"`
 		OnSuccess      *json.RawMessage `json:"on_success,omitempty"`
@@ -19,6 +20,7 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		UserArtifact   *json.RawMessage `json:"user_artifact,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -45,6 +47,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Task = plan.Task.Public()
 	}
 
+	if plan.SetPipeline != nil {
+		public.SetPipeline = plan.SetPipeline.Public()
+	}
+
 	if plan.OnAbort != nil {
 		public.OnAbort = plan.OnAbort.Public()
 	}
@@ -73,6 +79,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.UserArtifact != nil {
 		public.UserArtifact = plan.UserArtifact.Public()
 	}
@@ -196,6 +206,14 @@ func (plan TaskPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan SetPipelinePlan) Public() *json.RawMessage {
+	return enc(struct {
+		Name string `json:"name"`
+	}{
+		Name: plan.Name,
+	})
+}
+
 func (plan TimeoutPlan) Public() *json.RawMessage {
 	return enc(struct {
 		Step     *json.RawMessage `json:"step"`
@@ -224,6 +242,41 @@ func (plan RetryPlan) Public() *json.RawMessage {
 	return enc(public)
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type scopedPlan struct {
+		Step  *json.RawMessage `json:"step"`
+		Value interface{}      `json:"value"`
+	}
+
+	steps := make([]scopedPlan, len(plan.Steps))
+
+	for i, step := range plan.Steps {
+		steps[i] = scopedPlan{
+			Step:  step.Step.Public(),
+			Value: step.Value,
+		}
+	}
+
+	var step *json.RawMessage
+	if plan.Step != nil {
+		step = plan.Step.Public()
+	}
+
+	return enc(struct {
+		Var         string           `json:"var"`
+		Steps       []scopedPlan     `json:"steps,omitempty"`
+		ValuesVar   string           `json:"values_var,omitempty"`
+		Step        *json.RawMessage `json:"step,omitempty"`
+		MaxInFlight int              `json:"max_in_flight,omitempty"`
+	}{
+		Var:         plan.Var,
+		Steps:       steps,
+		ValuesVar:   plan.ValuesVar,
+		Step:        step,
+		MaxInFlight: plan.MaxInFlight,
+	})
+}
+
 func (plan UserArtifactPlan) Public() *json.RawMessage {
 	return enc(plan)
 }
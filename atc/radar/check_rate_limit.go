@@ -0,0 +1,76 @@
+package radar
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// CheckRateLimitedError is returned when a check that needn't complete is
+// given up on because its resource type is over its rate limit.
+type CheckRateLimitedError struct {
+	ResourceType string
+	Delay        time.Duration
+}
+
+func (err CheckRateLimitedError) Error() string {
+	return fmt.Sprintf("checks of %s are over their rate limit for %s", err.ResourceType, err.Delay)
+}
+
+// limitCheck takes a token for the check from the rate limiter. It's called
+// once the check has its checking lock and is due, so that tokens are only
+// spent on checks that run.
+//
+// A check that must complete waits until a token is available, or the context
+// is done. Any other check is given up on with a CheckRateLimitedError,
+// leaving its resource config due a check again.
+func limitCheck(
+	ctx context.Context,
+	logger lager.Logger,
+	clock clock.Clock,
+	limiter db.CheckRateLimiter,
+	resourceConfig db.ResourceConfig,
+	teamID int,
+	deferred metric.CheckDeferred,
+	mustComplete bool,
+) error {
+	if mustComplete {
+		err := db.WaitForCheckToken(ctx, clock, limiter, teamID, deferred.ResourceType, func(delay time.Duration) {
+			logger.Debug("waiting-for-check-token", lager.Data{"delay": delay.String()})
+
+			deferred.Delay = delay
+			deferred.Emit(logger)
+		})
+		if err != nil {
+			logger.Error("failed-to-take-check-token", err)
+			return err
+		}
+
+		return nil
+	}
+
+	taken, delay, err := limiter.Take(teamID, deferred.ResourceType)
+	if err != nil {
+		logger.Error("failed-to-take-check-token", err)
+		return err
+	}
+
+	if taken {
+		return nil
+	}
+
+	err = resourceConfig.ClearLastChecked()
+	if err != nil {
+		logger.Error("failed-to-clear-last-checked", err)
+	}
+
+	return CheckRateLimitedError{
+		ResourceType: deferred.ResourceType,
+		Delay:        delay,
+	}
+}
@@ -0,0 +1,46 @@
+package radar
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// maxCheckStderr is the most of a check's stderr that's recorded with it, so
+// that a noisy check script can't bloat the checks table on every interval.
+const maxCheckStderr = 64 * 1024
+
+// checkStderr keeps the first bytes written to it, up to its limit, and
+// discards the rest.
+type checkStderr struct {
+	buf       bytes.Buffer
+	limit     int
+	truncated bool
+}
+
+func newCheckStderr(limit int) *checkStderr {
+	return &checkStderr{limit: limit}
+}
+
+func (w *checkStderr) Write(p []byte) (int, error) {
+	// claim to have written everything so that the check script's output
+	// keeps being drained
+	n := len(p)
+
+	room := w.limit - w.buf.Len()
+	if len(p) > room {
+		w.truncated = true
+		p = p[:room]
+	}
+
+	w.buf.Write(p)
+
+	return n, nil
+}
+
+func (w *checkStderr) String() string {
+	if w.truncated {
+		return w.buf.String() + fmt.Sprintf("\n[stderr truncated to %d bytes]\n", w.limit)
+	}
+
+	return w.buf.String()
+}
@@ -2,34 +2,36 @@
 package radarfakes
 
 import (
+	"context"
 	sync "sync"
-	time "time"
 
 	lager "code.cloudfoundry.org/lager"
 	atc "github.com/concourse/concourse/atc"
+	db "github.com/concourse/concourse/atc/db"
 	radar "github.com/concourse/concourse/atc/radar"
 )
 
 type FakeScanner struct {
-	RunStub        func(lager.Logger, string) (time.Duration, error)
+	RunStub        func(lager.Logger, string) (db.CheckResult, error)
 	runMutex       sync.RWMutex
 	runArgsForCall []struct {
 		arg1 lager.Logger
 		arg2 string
 	}
 	runReturns struct {
-		result1 time.Duration
+		result1 db.CheckResult
 		result2 error
 	}
 	runReturnsOnCall map[int]struct {
-		result1 time.Duration
+		result1 db.CheckResult
 		result2 error
 	}
-	ScanStub        func(lager.Logger, string) error
+	ScanStub        func(context.Context, lager.Logger, string) error
 	scanMutex       sync.RWMutex
 	scanArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 string
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
 	}
 	scanReturns struct {
 		result1 error
@@ -54,7 +56,7 @@ type FakeScanner struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeScanner) Run(arg1 lager.Logger, arg2 string) (time.Duration, error) {
+func (fake *FakeScanner) Run(arg1 lager.Logger, arg2 string) (db.CheckResult, error) {
 	fake.runMutex.Lock()
 	ret, specificReturn := fake.runReturnsOnCall[len(fake.runArgsForCall)]
 	fake.runArgsForCall = append(fake.runArgsForCall, struct {
@@ -79,7 +81,7 @@ func (fake *FakeScanner) RunCallCount() int {
 	return len(fake.runArgsForCall)
 }
 
-func (fake *FakeScanner) RunCalls(stub func(lager.Logger, string) (time.Duration, error)) {
+func (fake *FakeScanner) RunCalls(stub func(lager.Logger, string) (db.CheckResult, error)) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = stub
@@ -92,43 +94,44 @@ func (fake *FakeScanner) RunArgsForCall(i int) (lager.Logger, string) {
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeScanner) RunReturns(result1 time.Duration, result2 error) {
+func (fake *FakeScanner) RunReturns(result1 db.CheckResult, result2 error) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = nil
 	fake.runReturns = struct {
-		result1 time.Duration
+		result1 db.CheckResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeScanner) RunReturnsOnCall(i int, result1 time.Duration, result2 error) {
+func (fake *FakeScanner) RunReturnsOnCall(i int, result1 db.CheckResult, result2 error) {
 	fake.runMutex.Lock()
 	defer fake.runMutex.Unlock()
 	fake.RunStub = nil
 	if fake.runReturnsOnCall == nil {
 		fake.runReturnsOnCall = make(map[int]struct {
-			result1 time.Duration
+			result1 db.CheckResult
 			result2 error
 		})
 	}
 	fake.runReturnsOnCall[i] = struct {
-		result1 time.Duration
+		result1 db.CheckResult
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeScanner) Scan(arg1 lager.Logger, arg2 string) error {
+func (fake *FakeScanner) Scan(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.scanMutex.Lock()
 	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
 	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("Scan", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Scan", []interface{}{arg1, arg2, arg3})
 	fake.scanMutex.Unlock()
 	if fake.ScanStub != nil {
-		return fake.ScanStub(arg1, arg2)
+		return fake.ScanStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -143,17 +146,17 @@ func (fake *FakeScanner) ScanCallCount() int {
 	return len(fake.scanArgsForCall)
 }
 
-func (fake *FakeScanner) ScanCalls(stub func(lager.Logger, string) error) {
+func (fake *FakeScanner) ScanCalls(stub func(context.Context, lager.Logger, string) error) {
 	fake.scanMutex.Lock()
 	defer fake.scanMutex.Unlock()
 	fake.ScanStub = stub
 }
 
-func (fake *FakeScanner) ScanArgsForCall(i int) (lager.Logger, string) {
+func (fake *FakeScanner) ScanArgsForCall(i int) (context.Context, lager.Logger, string) {
 	fake.scanMutex.RLock()
 	defer fake.scanMutex.RUnlock()
 	argsForCall := fake.scanArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeScanner) ScanReturns(result1 error) {
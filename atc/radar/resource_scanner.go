@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
@@ -21,6 +22,7 @@ var GlobalResourceCheckTimeout time.Duration
 
 type resourceScanner struct {
 	clock                 clock.Clock
+	checkRateLimiter      db.CheckRateLimiter
 	resourceFactory       resource.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultInterval       time.Duration
@@ -32,6 +34,7 @@ type resourceScanner struct {
 
 func NewResourceScanner(
 	clock clock.Clock,
+	checkRateLimiter db.CheckRateLimiter,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultInterval time.Duration,
@@ -42,6 +45,7 @@ func NewResourceScanner(
 ) Scanner {
 	return &resourceScanner{
 		clock:                 clock,
+		checkRateLimiter:      checkRateLimiter,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultInterval:       defaultInterval,
@@ -54,61 +58,61 @@ func NewResourceScanner(
 
 var ErrFailedToAcquireLock = errors.New("failed to acquire lock")
 
-func (scanner *resourceScanner) Run(logger lager.Logger, resourceName string) (time.Duration, error) {
-	interval, err := scanner.scan(logger.Session("tick"), resourceName, nil, false, false)
+func (scanner *resourceScanner) Run(logger lager.Logger, resourceName string) (db.CheckResult, error) {
+	result, err := scanner.scan(context.Background(), logger.Session("tick"), resourceName, nil, false, false)
 
 	err = swallowErrResourceScriptFailed(err)
 
-	return interval, err
+	return result, err
 }
 
 func (scanner *resourceScanner) ScanFromVersion(logger lager.Logger, resourceName string, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceName, fromVersion, true, true)
+	_, err := scanner.scan(context.Background(), logger, resourceName, fromVersion, true, true)
 
 	return err
 }
 
-func (scanner *resourceScanner) Scan(logger lager.Logger, resourceName string) error {
-	_, err := scanner.scan(logger, resourceName, nil, true, false)
+func (scanner *resourceScanner) Scan(ctx context.Context, logger lager.Logger, resourceName string) error {
+	_, err := scanner.scan(ctx, logger, resourceName, nil, true, false)
 
 	err = swallowErrResourceScriptFailed(err)
 
 	return err
 }
 
-func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceScanner) scan(ctx context.Context, logger lager.Logger, resourceName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (db.CheckResult, error) {
 	lockLogger := logger.Session("lock", lager.Data{
 		"resource": resourceName,
 	})
 
 	savedResource, found, err := scanner.dbPipeline.Resource(resourceName)
 	if err != nil {
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	if !found {
 		logger.Debug("resource-not-found")
-		return 0, db.ResourceNotFoundError{Name: resourceName}
+		return db.CheckResult{}, db.ResourceNotFoundError{Name: resourceName}
 	}
 
 	timeout, err := scanner.parseResourceCheckTimeoutOrDefault(savedResource.CheckTimeout())
 	if err != nil {
 		scanner.setResourceCheckError(logger, savedResource, err)
 		logger.Error("failed-to-read-check-timeout", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	interval, err := scanner.checkInterval(savedResource.CheckEvery())
 	if err != nil {
 		scanner.setResourceCheckError(logger, savedResource, err)
 		logger.Error("failed-to-read-check-interval", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	resourceTypes, err := scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	for _, parentType := range resourceTypes {
@@ -119,18 +123,18 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 			continue
 		}
 
-		err = scanner.typeScanner.Scan(logger.Session("resource-type-scanner"), parentType.Name())
+		err = scanner.typeScanner.Scan(ctx, logger.Session("resource-type-scanner"), parentType.Name())
 		if err != nil {
 			logger.Error("failed-to-scan-parent-resource-type-version", err)
 			scanner.setResourceCheckError(logger, savedResource, err)
-			return 0, err
+			return db.CheckResult{}, err
 		}
 	}
 
 	resourceTypes, err = scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	versionedResourceTypes := creds.NewVersionedResourceTypes(
@@ -142,7 +146,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 	if err != nil {
 		logger.Error("failed-to-evaluate-resource-source", err)
 		scanner.setResourceCheckError(logger, savedResource, err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	resourceConfig, err := savedResource.SetResourceConfig(
@@ -153,7 +157,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 	if err != nil {
 		logger.Error("failed-to-set-resource-config-id-on-resource", err)
 		scanner.setResourceCheckError(logger, savedResource, err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	// Clear out check error on the resource
@@ -169,11 +173,11 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 			if chkErr != nil {
 				logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 			}
-			return 0, err
+			return db.CheckResult{}, err
 		}
 		if found {
 			logger.Info("skipping-check-because-pinned-version-found", lager.Data{"pinned-version": currentVersion})
-			return interval, nil
+			return db.CheckResult{Skipped: true}, nil
 		}
 
 		fromVersion = currentVersion
@@ -192,7 +196,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 				"resource_name":   resourceName,
 				"resource_config": resourceConfig.ID(),
 			})
-			return interval, ErrFailedToAcquireLock
+			return db.CheckResult{}, ErrFailedToAcquireLock
 		}
 
 		if !acquired {
@@ -201,7 +205,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 				scanner.clock.Sleep(time.Second)
 				continue
 			} else {
-				return interval, ErrFailedToAcquireLock
+				return db.CheckResult{}, ErrFailedToAcquireLock
 			}
 		}
 
@@ -214,7 +218,7 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		rcv, found, err := resourceConfig.LatestVersion()
 		if err != nil {
 			logger.Error("failed-to-get-current-version", err)
-			return interval, err
+			return db.CheckResult{}, err
 		}
 
 		if found {
@@ -222,47 +226,78 @@ func (scanner *resourceScanner) scan(logger lager.Logger, resourceName string, f
 		}
 	}
 
-	return interval, scanner.check(
+	return scanner.check(
+		ctx,
 		logger,
 		savedResource,
 		resourceConfig,
 		fromVersion,
 		versionedResourceTypes,
 		source,
+		mustComplete,
 		saveGiven,
 		timeout,
 	)
 }
 
 func (scanner *resourceScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResource db.Resource,
 	resourceConfig db.ResourceConfig,
 	fromVersion atc.Version,
 	resourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
+	mustComplete bool,
 	saveGiven bool,
 	timeout time.Duration,
-) error {
+) (db.CheckResult, error) {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
-		return err
+		return db.CheckResult{}, err
 	}
 
 	if pipelinePaused {
 		logger.Debug("pipeline-paused")
-		return nil
+		return db.CheckResult{Skipped: true}, nil
+	}
+
+	err = limitCheck(
+		ctx,
+		logger,
+		scanner.clock,
+		scanner.checkRateLimiter,
+		resourceConfig,
+		scanner.dbPipeline.TeamID(),
+		metric.CheckDeferred{
+			TeamName:     scanner.dbPipeline.TeamName(),
+			PipelineName: scanner.dbPipeline.Name(),
+			Name:         savedResource.Name(),
+			ResourceType: savedResource.Type(),
+		},
+		mustComplete,
+	)
+	if err != nil {
+		return db.CheckResult{}, err
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "check", tracing.Attrs{
+		"team":     scanner.dbPipeline.TeamName(),
+		"pipeline": scanner.dbPipeline.Name(),
+		"resource": savedResource.Name(),
+		"type":     savedResource.Type(),
+	})
+	defer span.End()
+
 	found, err := scanner.dbPipeline.Reload()
 	if err != nil {
 		logger.Error("failed-to-reload-scannerdb", err)
-		return err
+		return db.CheckResult{}, err
 	}
 	if !found {
 		logger.Info("pipeline-removed")
-		return errPipelineRemoved
+		return db.CheckResult{}, errPipelineRemoved
 	}
 
 	metadata := resource.TrackerMetadata{
@@ -287,7 +322,7 @@ func (scanner *resourceScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		ctx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, ContainerExpiries),
 		db.ContainerMetadata{
@@ -311,21 +346,32 @@ func (scanner *resourceScanner) check(
 			logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 		}
 
-		return err
+		return db.CheckResult{}, err
 	}
 
 	logger.Debug("checking", lager.Data{
 		"from": fromVersion,
 	})
 
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	newVersions, err := res.Check(ctx, source, fromVersion)
+	stderr := newCheckStderr(maxCheckStderr)
+	newVersions, err := res.Check(ctx, source, fromVersion, stderr)
 	if err == context.DeadlineExceeded {
 		err = fmt.Errorf("Timed out after %v while checking for new versions - perhaps increase your resource check timeout?", timeout)
 	}
 
+	if err != nil {
+		span.SetError(err)
+	}
+
+	result := db.CheckResult{
+		FromVersion: fromVersion,
+		NewVersions: countNewVersions(newVersions, fromVersion),
+		Stderr:      stderr.String(),
+	}
+
 	resourceConfig.SetCheckError(err)
 	metric.ResourceCheck{
 		PipelineName: scanner.dbPipeline.Name(),
@@ -337,16 +383,16 @@ func (scanner *resourceScanner) check(
 	if err != nil {
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
 			logger.Info("check-failed", lager.Data{"exit-status": rErr.ExitStatus})
-			return rErr
+			return result, rErr
 		}
 
 		logger.Error("failed-to-check", err)
-		return err
+		return result, err
 	}
 
 	if len(newVersions) == 0 || (!saveGiven && reflect.DeepEqual(newVersions, []atc.Version{fromVersion})) {
 		logger.Debug("no-new-versions")
-		return nil
+		return result, nil
 	}
 
 	logger.Info("versions-found", lager.Data{
@@ -361,7 +407,20 @@ func (scanner *resourceScanner) check(
 		})
 	}
 
-	return nil
+	return result, nil
+}
+
+// countNewVersions returns how many of the versions emitted by a check are
+// not the version it was checked from.
+func countNewVersions(versions []atc.Version, fromVersion atc.Version) int {
+	count := 0
+	for _, version := range versions {
+		if !reflect.DeepEqual(version, fromVersion) {
+			count++
+		}
+	}
+
+	return count
 }
 
 func swallowErrResourceScriptFailed(err error) error {
@@ -3,6 +3,8 @@ package radar_test
 import (
 	"context"
 	"errors"
+	"io"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -34,6 +36,7 @@ var _ = Describe("ResourceScanner", func() {
 		fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
 		fakeDBPipeline            *dbfakes.FakePipeline
 		fakeClock                 *fakeclock.FakeClock
+		fakeCheckRateLimiter      *dbfakes.FakeCheckRateLimiter
 		interval                  time.Duration
 		variables                 creds.Variables
 
@@ -89,6 +92,9 @@ var _ = Describe("ResourceScanner", func() {
 		fakeDBPipeline.TeamIDReturns(teamID)
 		fakeClock = fakeclock.NewFakeClock(epoch)
 
+		fakeCheckRateLimiter = new(dbfakes.FakeCheckRateLimiter)
+		fakeCheckRateLimiter.TakeReturns(true, 0, nil)
+
 		fakeDBPipeline.ReloadReturns(true, nil)
 		fakeDBPipeline.ResourceTypesReturns([]db.ResourceType{fakeResourceType}, nil)
 
@@ -112,6 +118,7 @@ var _ = Describe("ResourceScanner", func() {
 
 		scanner = NewResourceScanner(
 			fakeClock,
+			fakeCheckRateLimiter,
 			fakeResourceFactory,
 			fakeResourceConfigFactory,
 			interval,
@@ -124,9 +131,9 @@ var _ = Describe("ResourceScanner", func() {
 
 	Describe("Run", func() {
 		var (
-			fakeResource   *rfakes.FakeResource
-			actualInterval time.Duration
-			runErr         error
+			fakeResource *rfakes.FakeResource
+			actualResult db.CheckResult
+			runErr       error
 		)
 
 		BeforeEach(func() {
@@ -135,7 +142,7 @@ var _ = Describe("ResourceScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualInterval, runErr = scanner.Run(lagertest.NewTestLogger("test"), "some-resource")
+			actualResult, runErr = scanner.Run(lagertest.NewTestLogger("test"), "some-resource")
 		})
 
 		Context("when the lock cannot be acquired", func() {
@@ -147,9 +154,13 @@ var _ = Describe("ResourceScanner", func() {
 				Expect(fakeResource.CheckCallCount()).To(Equal(0))
 			})
 
-			It("returns the configured interval", func() {
+			It("returns ErrFailedToAcquireLock", func() {
 				Expect(runErr).To(Equal(ErrFailedToAcquireLock))
-				Expect(actualInterval).To(Equal(interval))
+				Expect(actualResult).To(BeZero())
+			})
+
+			It("does not take a check token", func() {
+				Expect(fakeCheckRateLimiter.TakeCallCount()).To(BeZero())
 			})
 		})
 
@@ -162,6 +173,46 @@ var _ = Describe("ResourceScanner", func() {
 				Expect(fakeResource.CheckCallCount()).To(Equal(1))
 			})
 
+			It("takes a check token for the resource's type", func() {
+				Expect(fakeCheckRateLimiter.TakeCallCount()).To(Equal(1))
+				actualTeamID, resourceType := fakeCheckRateLimiter.TakeArgsForCall(0)
+				Expect(actualTeamID).To(Equal(teamID))
+				Expect(resourceType).To(Equal("git"))
+			})
+
+			Context("when the resource's type is over its rate limit", func() {
+				BeforeEach(func() {
+					fakeCheckRateLimiter.TakeReturns(false, 30*time.Second, nil)
+				})
+
+				It("does not check", func() {
+					Expect(fakeResourceFactory.NewResourceCallCount()).To(BeZero())
+					Expect(fakeResource.CheckCallCount()).To(BeZero())
+				})
+
+				It("returns how long until a token is available", func() {
+					Expect(runErr).To(Equal(CheckRateLimitedError{
+						ResourceType: "git",
+						Delay:        30 * time.Second,
+					}))
+				})
+
+				It("leaves the resource config due a check", func() {
+					Expect(fakeResourceConfig.ClearLastCheckedCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when taking a check token fails", func() {
+				BeforeEach(func() {
+					fakeCheckRateLimiter.TakeReturns(false, 0, errors.New("nope"))
+				})
+
+				It("returns the error without checking", func() {
+					Expect(runErr).To(MatchError("nope"))
+					Expect(fakeResource.CheckCallCount()).To(BeZero())
+				})
+			})
+
 			It("constructs the resource of the correct type", func() {
 				Expect(fakeDBResource.SetResourceConfigCallCount()).To(Equal(1))
 				_, resourceSource, resourceTypes := fakeDBResource.SetResourceConfigArgsForCall(0)
@@ -217,10 +268,6 @@ var _ = Describe("ResourceScanner", func() {
 					Eventually(fakeLock.ReleaseCallCount).Should(Equal(1))
 				})
 
-				It("returns configured interval", func() {
-					Expect(actualInterval).To(Equal(10 * time.Millisecond))
-				})
-
 				Context("when the interval cannot be parsed", func() {
 					BeforeEach(func() {
 						fakeDBResource.CheckEveryReturns("bad-value")
@@ -267,7 +314,7 @@ var _ = Describe("ResourceScanner", func() {
 
 					It("scans for new versions of the custom type", func() {
 						Expect(fakeResourceTypeScanner.ScanCallCount()).To(Equal(1))
-						_, scannedResourceType := fakeResourceTypeScanner.ScanArgsForCall(0)
+						_, _, scannedResourceType := fakeResourceTypeScanner.ScanArgsForCall(0)
 						Expect(scannedResourceType).To(Equal("some-custom-resource"))
 					})
 
@@ -294,7 +341,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when there is no current version", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -309,7 +356,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 			})
@@ -333,7 +380,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version, stderr io.Writer) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -342,10 +389,20 @@ var _ = Describe("ResourceScanner", func() {
 						result := checkResults[check]
 						check++
 
+						_, err := io.WriteString(stderr, "some-stderr")
+						Expect(err).NotTo(HaveOccurred())
+
 						return result, nil
 					}
 				})
 
+				It("returns what the check found", func() {
+					Expect(actualResult).To(Equal(db.CheckResult{
+						NewVersions: 3,
+						Stderr:      "some-stderr",
+					}))
+				})
+
 				It("saves them all, in order", func() {
 					Eventually(fakeResourceConfig.SaveVersionsCallCount).Should(Equal(1))
 
@@ -368,6 +425,28 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 
+			Context("when the check writes too much to stderr", func() {
+				BeforeEach(func() {
+					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version, stderr io.Writer) ([]atc.Version, error) {
+						defer GinkgoRecover()
+
+						for i := 0; i < 100; i++ {
+							n, err := io.WriteString(stderr, strings.Repeat("x", 1024))
+							Expect(err).NotTo(HaveOccurred())
+							Expect(n).To(Equal(1024))
+						}
+
+						return nil, nil
+					}
+				})
+
+				It("keeps only the start of it and marks it truncated", func() {
+					Expect(actualResult.Stderr).To(HavePrefix(strings.Repeat("x", 64*1024) + "\n"))
+					Expect(actualResult.Stderr).To(HaveSuffix("[stderr truncated to 65536 bytes]\n"))
+					Expect(len(actualResult.Stderr)).To(BeNumerically("<", 65*1024))
+				})
+			})
+
 			Context("when checking fails internally", func() {
 				disaster := errors.New("nope")
 
@@ -402,8 +481,12 @@ var _ = Describe("ResourceScanner", func() {
 					Expect(fakeResource.CheckCallCount()).To(BeZero())
 				})
 
-				It("returns the default interval", func() {
-					Expect(actualInterval).To(Equal(interval))
+				It("returns a skipped result", func() {
+					Expect(actualResult).To(Equal(db.CheckResult{Skipped: true}))
+				})
+
+				It("does not take a check token", func() {
+					Expect(fakeCheckRateLimiter.TakeCallCount()).To(BeZero())
 				})
 
 				It("does not return an error", func() {
@@ -454,16 +537,19 @@ var _ = Describe("ResourceScanner", func() {
 		var (
 			fakeResource *rfakes.FakeResource
 
+			scanCtx context.Context
 			scanErr error
 		)
 
 		BeforeEach(func() {
 			fakeResource = new(rfakes.FakeResource)
 			fakeResourceFactory.NewResourceReturns(fakeResource, nil)
+
+			scanCtx = context.Background()
 		})
 
 		JustBeforeEach(func() {
-			scanErr = scanner.Scan(lagertest.NewTestLogger("test"), "some-resource")
+			scanErr = scanner.Scan(scanCtx, lagertest.NewTestLogger("test"), "some-resource")
 		})
 
 		Context("if the lock can be acquired", func() {
@@ -489,7 +575,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("fails and returns error", func() {
 					Expect(fakeResourceTypeScanner.ScanCallCount()).To(Equal(1))
-					_, parentTypeName := fakeResourceTypeScanner.ScanArgsForCall(0)
+					_, _, parentTypeName := fakeResourceTypeScanner.ScanArgsForCall(0)
 					Expect(parentTypeName).To(Equal("git"))
 
 					Expect(scanErr).To(HaveOccurred())
@@ -640,7 +726,7 @@ var _ = Describe("ResourceScanner", func() {
 
 				It("times out after the specified timeout", func() {
 					now := time.Now()
-					ctx, _, _ := fakeResource.CheckArgsForCall(0)
+					ctx, _, _, _ := fakeResource.CheckArgsForCall(0)
 					deadline, _ := ctx.Deadline()
 					Expect(deadline).Should(BeTemporally("~", now.Add(10*time.Second), time.Second))
 				})
@@ -690,7 +776,7 @@ var _ = Describe("ResourceScanner", func() {
 					})
 
 					It("checks from the pinned version", func() {
-						_, _, version := fakeResource.CheckArgsForCall(0)
+						_, _, version, _ := fakeResource.CheckArgsForCall(0)
 						Expect(version).To(Equal(atc.Version{"version": "1"}))
 					})
 				})
@@ -748,6 +834,50 @@ var _ = Describe("ResourceScanner", func() {
 				})
 			})
 
+			Context("when the resource's type is over its rate limit", func() {
+				BeforeEach(func() {
+					taken := make(chan bool, 2)
+					taken <- false
+					taken <- true
+					close(taken)
+
+					fakeCheckRateLimiter.TakeStub = func(int, string) (bool, time.Duration, error) {
+						if <-taken {
+							return true, 0, nil
+						}
+
+						// allow the sleep to continue
+						go fakeClock.WaitForWatcherAndIncrement(10 * time.Second)
+						return false, 10 * time.Second, nil
+					}
+				})
+
+				It("waits for a token and checks", func() {
+					Expect(fakeCheckRateLimiter.TakeCallCount()).To(Equal(2))
+					Expect(fakeResource.CheckCallCount()).To(Equal(1))
+					Expect(scanErr).NotTo(HaveOccurred())
+				})
+
+				It("does not leave the resource config due a check", func() {
+					Expect(fakeResourceConfig.ClearLastCheckedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the context is done while waiting for a check token", func() {
+				BeforeEach(func() {
+					ctx, cancel := context.WithCancel(scanCtx)
+					cancel()
+					scanCtx = ctx
+
+					fakeCheckRateLimiter.TakeReturns(false, 10*time.Second, nil)
+				})
+
+				It("returns the context's error without checking", func() {
+					Expect(scanErr).To(Equal(context.Canceled))
+					Expect(fakeResource.CheckCallCount()).To(BeZero())
+				})
+			})
+
 			It("clears the resource's check error", func() {
 				Expect(fakeResourceConfig.SetCheckErrorCallCount()).To(Equal(1))
 
@@ -761,7 +891,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -794,7 +924,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
@@ -828,7 +958,7 @@ var _ = Describe("ResourceScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version, stderr io.Writer) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(resourceConfig.Source))
@@ -918,7 +1048,7 @@ var _ = Describe("ResourceScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -931,7 +1061,7 @@ var _ = Describe("ResourceScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
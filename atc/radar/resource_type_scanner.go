@@ -10,12 +10,15 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
+	"github.com/concourse/concourse/atc/tracing"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 type resourceTypeScanner struct {
 	clock                 clock.Clock
+	checkRateLimiter      db.CheckRateLimiter
 	resourceFactory       resource.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 	defaultInterval       time.Duration
@@ -26,6 +29,7 @@ type resourceTypeScanner struct {
 
 func NewResourceTypeScanner(
 	clock clock.Clock,
+	checkRateLimiter db.CheckRateLimiter,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	defaultInterval time.Duration,
@@ -35,6 +39,7 @@ func NewResourceTypeScanner(
 ) Scanner {
 	return &resourceTypeScanner{
 		clock:                 clock,
+		checkRateLimiter:      checkRateLimiter,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 		defaultInterval:       defaultInterval,
@@ -44,21 +49,21 @@ func NewResourceTypeScanner(
 	}
 }
 
-func (scanner *resourceTypeScanner) Run(logger lager.Logger, resourceTypeName string) (time.Duration, error) {
-	return scanner.scan(logger.Session("tick"), resourceTypeName, nil, false, false)
+func (scanner *resourceTypeScanner) Run(logger lager.Logger, resourceTypeName string) (db.CheckResult, error) {
+	return scanner.scan(context.Background(), logger.Session("tick"), resourceTypeName, nil, false, false)
 }
 
 func (scanner *resourceTypeScanner) ScanFromVersion(logger lager.Logger, resourceTypeName string, fromVersion atc.Version) error {
-	_, err := scanner.scan(logger, resourceTypeName, fromVersion, true, true)
+	_, err := scanner.scan(context.Background(), logger, resourceTypeName, fromVersion, true, true)
 	return err
 }
 
-func (scanner *resourceTypeScanner) Scan(logger lager.Logger, resourceTypeName string) error {
-	_, err := scanner.scan(logger, resourceTypeName, nil, true, false)
+func (scanner *resourceTypeScanner) Scan(ctx context.Context, logger lager.Logger, resourceTypeName string) error {
+	_, err := scanner.scan(ctx, logger, resourceTypeName, nil, true, false)
 	return err
 }
 
-func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (time.Duration, error) {
+func (scanner *resourceTypeScanner) scan(ctx context.Context, logger lager.Logger, resourceTypeName string, fromVersion atc.Version, mustComplete bool, saveGiven bool) (db.CheckResult, error) {
 	lockLogger := logger.Session("lock", lager.Data{
 		"resource-type": resourceTypeName,
 	})
@@ -66,23 +71,23 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 	savedResourceType, found, err := scanner.dbPipeline.ResourceType(resourceTypeName)
 	if err != nil {
 		logger.Error("failed-to-find-resource-type-in-db", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	if !found {
-		return 0, db.ResourceTypeNotFoundError{Name: resourceTypeName}
+		return db.CheckResult{}, db.ResourceTypeNotFoundError{Name: resourceTypeName}
 	}
 
 	interval, err := scanner.checkInterval(savedResourceType.CheckEvery())
 	if err != nil {
 		scanner.setCheckError(logger, savedResourceType, err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	resourceTypes, err := scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	for _, parentType := range resourceTypes {
@@ -96,17 +101,17 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 			continue
 		}
 
-		if err = scanner.Scan(logger, parentType.Name()); err != nil {
+		if err = scanner.Scan(ctx, logger, parentType.Name()); err != nil {
 			logger.Error("failed-to-scan-parent-resource-type-version", err)
 			scanner.setCheckError(logger, savedResourceType, err)
-			return 0, err
+			return db.CheckResult{}, err
 		}
 	}
 
 	resourceTypes, err = scanner.dbPipeline.ResourceTypes()
 	if err != nil {
 		logger.Error("failed-to-get-resource-types", err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	versionedResourceTypes := creds.NewVersionedResourceTypes(
@@ -118,7 +123,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 	if err != nil {
 		logger.Error("failed-to-evaluate-resource-type-source", err)
 		scanner.setCheckError(logger, savedResourceType, err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	resourceConfig, err := savedResourceType.SetResourceConfig(
@@ -129,7 +134,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 	if err != nil {
 		logger.Error("failed-to-set-resource-config-id-on-resource-type", err)
 		scanner.setCheckError(logger, savedResourceType, err)
-		return 0, err
+		return db.CheckResult{}, err
 	}
 
 	// Clear out the check error on the resource type
@@ -148,7 +153,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 				"resource-type":      resourceTypeName,
 				"resource-config-id": resourceConfig.ID(),
 			})
-			return interval, ErrFailedToAcquireLock
+			return db.CheckResult{}, ErrFailedToAcquireLock
 		}
 
 		if !acquired {
@@ -157,7 +162,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 				scanner.clock.Sleep(time.Second)
 				continue
 			} else {
-				return interval, ErrFailedToAcquireLock
+				return db.CheckResult{}, ErrFailedToAcquireLock
 			}
 		}
 
@@ -170,7 +175,7 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 		rcv, found, err := resourceConfig.LatestVersion()
 		if err != nil {
 			logger.Error("failed-to-get-current-version", err)
-			return interval, err
+			return db.CheckResult{}, err
 		}
 
 		if found {
@@ -178,37 +183,68 @@ func (scanner *resourceTypeScanner) scan(logger lager.Logger, resourceTypeName s
 		}
 	}
 
-	return interval, scanner.check(
+	return scanner.check(
+		ctx,
 		logger,
 		savedResourceType,
 		resourceConfig,
 		fromVersion,
 		versionedResourceTypes,
 		source,
+		mustComplete,
 		saveGiven,
 	)
 }
 
 func (scanner *resourceTypeScanner) check(
+	ctx context.Context,
 	logger lager.Logger,
 	savedResourceType db.ResourceType,
 	resourceConfig db.ResourceConfig,
 	fromVersion atc.Version,
 	versionedResourceTypes creds.VersionedResourceTypes,
 	source atc.Source,
+	mustComplete bool,
 	saveGiven bool,
-) error {
+) (db.CheckResult, error) {
 	pipelinePaused, err := scanner.dbPipeline.CheckPaused()
 	if err != nil {
 		logger.Error("failed-to-check-if-pipeline-paused", err)
-		return err
+		return db.CheckResult{}, err
 	}
 
 	if pipelinePaused {
 		logger.Debug("pipeline-paused")
-		return nil
+		return db.CheckResult{Skipped: true}, nil
+	}
+
+	err = limitCheck(
+		ctx,
+		logger,
+		scanner.clock,
+		scanner.checkRateLimiter,
+		resourceConfig,
+		scanner.dbPipeline.TeamID(),
+		metric.CheckDeferred{
+			TeamName:     scanner.dbPipeline.TeamName(),
+			PipelineName: scanner.dbPipeline.Name(),
+			Name:         savedResourceType.Name(),
+			ResourceType: savedResourceType.Type(),
+		},
+		mustComplete,
+	)
+	if err != nil {
+		return db.CheckResult{}, err
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "check", tracing.Attrs{
+		"team":          scanner.dbPipeline.TeamName(),
+		"pipeline":      scanner.dbPipeline.Name(),
+		"resource-type": savedResourceType.Name(),
+		"type":          savedResourceType.Type(),
+	})
+	defer span.End()
+
 	containerSpec := worker.ContainerSpec{
 		ImageSpec: worker.ImageSpec{
 			ResourceType: savedResourceType.Type(),
@@ -224,7 +260,7 @@ func (scanner *resourceTypeScanner) check(
 	}
 
 	res, err := scanner.resourceFactory.NewResource(
-		context.Background(),
+		ctx,
 		logger,
 		db.NewResourceConfigCheckSessionContainerOwner(resourceConfig, ContainerExpiries),
 		db.ContainerMetadata{
@@ -247,24 +283,33 @@ func (scanner *resourceTypeScanner) check(
 			logger.Error("failed-to-set-check-error-on-resource-config", chkErr)
 		}
 
-		return err
+		return db.CheckResult{}, err
+	}
+
+	stderr := newCheckStderr(maxCheckStderr)
+	newVersions, err := res.Check(ctx, source, fromVersion, stderr)
+	result := db.CheckResult{
+		FromVersion: fromVersion,
+		NewVersions: countNewVersions(newVersions, fromVersion),
+		Stderr:      stderr.String(),
 	}
 
-	newVersions, err := res.Check(context.TODO(), source, fromVersion)
 	resourceConfig.SetCheckError(err)
 	if err != nil {
+		span.SetError(err)
+
 		if rErr, ok := err.(resource.ErrResourceScriptFailed); ok {
 			logger.Info("check-failed", lager.Data{"exit-status": rErr.ExitStatus})
-			return rErr
+			return result, rErr
 		}
 
 		logger.Error("failed-to-check", err)
-		return err
+		return result, err
 	}
 
 	if len(newVersions) == 0 || (!saveGiven && reflect.DeepEqual(newVersions, []atc.Version{fromVersion})) {
 		logger.Debug("no-new-versions")
-		return nil
+		return result, nil
 	}
 
 	logger.Info("versions-found", lager.Data{
@@ -277,10 +322,10 @@ func (scanner *resourceTypeScanner) check(
 		logger.Error("failed-to-save-resource-config-versions", err, lager.Data{
 			"versions": newVersions,
 		})
-		return err
+		return result, err
 	}
 
-	return nil
+	return result, nil
 }
 
 func (scanner *resourceTypeScanner) checkInterval(checkEvery string) (time.Duration, error) {
@@ -3,6 +3,7 @@ package radar_test
 import (
 	"context"
 	"errors"
+	"io"
 	"time"
 
 	"code.cloudfoundry.org/clock/fakeclock"
@@ -33,6 +34,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		fakeDBPipeline            *dbfakes.FakePipeline
 		fakeResourceConfig        *dbfakes.FakeResourceConfig
 		fakeClock                 *fakeclock.FakeClock
+		fakeCheckRateLimiter      *dbfakes.FakeCheckRateLimiter
 		interval                  time.Duration
 		variables                 creds.Variables
 
@@ -64,6 +66,9 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 		fakeClock = fakeclock.NewFakeClock(epoch)
 
+		fakeCheckRateLimiter = new(dbfakes.FakeCheckRateLimiter)
+		fakeCheckRateLimiter.TakeReturns(true, 0, nil)
+
 		fakeResourceFactory = new(rfakes.FakeResourceFactory)
 		fakeResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 		fakeResourceType = new(dbfakes.FakeResourceType)
@@ -88,6 +93,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 		scanner = NewResourceTypeScanner(
 			fakeClock,
+			fakeCheckRateLimiter,
 			fakeResourceFactory,
 			fakeResourceConfigFactory,
 			interval,
@@ -99,9 +105,9 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 	Describe("Run", func() {
 		var (
-			fakeResource   *rfakes.FakeResource
-			actualInterval time.Duration
-			runErr         error
+			fakeResource *rfakes.FakeResource
+			actualResult db.CheckResult
+			runErr       error
 		)
 
 		BeforeEach(func() {
@@ -110,7 +116,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualInterval, runErr = scanner.Run(lagertest.NewTestLogger("test"), fakeResourceType.Name())
+			actualResult, runErr = scanner.Run(lagertest.NewTestLogger("test"), fakeResourceType.Name())
 		})
 
 		Context("when the lock cannot be acquired", func() {
@@ -122,9 +128,9 @@ var _ = Describe("ResourceTypeScanner", func() {
 				Expect(fakeResource.CheckCallCount()).To(Equal(0))
 			})
 
-			It("returns the configured interval", func() {
+			It("returns ErrFailedToAcquireLock", func() {
 				Expect(runErr).To(Equal(ErrFailedToAcquireLock))
-				Expect(actualInterval).To(Equal(interval))
+				Expect(actualResult).To(BeZero())
 			})
 		})
 
@@ -137,6 +143,31 @@ var _ = Describe("ResourceTypeScanner", func() {
 				Expect(fakeResource.CheckCallCount()).To(Equal(1))
 			})
 
+			It("takes a check token for the resource type's type", func() {
+				Expect(fakeCheckRateLimiter.TakeCallCount()).To(Equal(1))
+				actualTeamID, resourceType := fakeCheckRateLimiter.TakeArgsForCall(0)
+				Expect(actualTeamID).To(Equal(teamID))
+				Expect(resourceType).To(Equal("registry-image"))
+			})
+
+			Context("when the resource type's type is over its rate limit", func() {
+				BeforeEach(func() {
+					fakeCheckRateLimiter.TakeReturns(false, time.Second, nil)
+				})
+
+				It("returns how long until a token is available without checking", func() {
+					Expect(fakeResource.CheckCallCount()).To(BeZero())
+					Expect(runErr).To(Equal(CheckRateLimitedError{
+						ResourceType: "registry-image",
+						Delay:        time.Second,
+					}))
+				})
+
+				It("leaves the resource config due a check", func() {
+					Expect(fakeResourceConfig.ClearLastCheckedCallCount()).To(Equal(1))
+				})
+			})
+
 			It("constructs the resource of the correct type", func() {
 				Expect(fakeResourceType.SetResourceConfigCallCount()).To(Equal(1))
 				_, resourceSource, resourceTypes := fakeResourceType.SetResourceConfigArgsForCall(0)
@@ -233,10 +264,6 @@ var _ = Describe("ResourceTypeScanner", func() {
 					Eventually(fakeLock.ReleaseCallCount()).Should(Equal(1))
 				})
 
-				It("returns configured interval", func() {
-					Expect(actualInterval).To(Equal(10 * time.Millisecond))
-				})
-
 				Context("when the interval cannot be parsed", func() {
 					BeforeEach(func() {
 						fakeResourceType.CheckEveryReturns("bad-value")
@@ -272,7 +299,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -288,7 +315,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				It("checks with it", func() {
 					Expect(fakeResource.CheckCallCount()).To(Equal(1))
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "42"}))
 				})
 			})
@@ -312,7 +339,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version, stderr io.Writer) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -362,8 +389,8 @@ var _ = Describe("ResourceTypeScanner", func() {
 					Expect(fakeResource.CheckCallCount()).To(BeZero())
 				})
 
-				It("returns the default interval", func() {
-					Expect(actualInterval).To(Equal(interval))
+				It("returns a skipped result", func() {
+					Expect(actualResult).To(Equal(db.CheckResult{Skipped: true}))
 				})
 
 				It("does not return an error", func() {
@@ -385,7 +412,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 		})
 
 		JustBeforeEach(func() {
-			runErr = scanner.Scan(lagertest.NewTestLogger("test"), fakeResourceType.Name())
+			runErr = scanner.Scan(context.TODO(), lagertest.NewTestLogger("test"), fakeResourceType.Name())
 		})
 
 		Context("when the lock can be acquired", func() {
@@ -606,7 +633,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from nil", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(BeNil())
 				})
 			})
@@ -622,7 +649,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 				It("checks with it", func() {
 					Expect(fakeResource.CheckCallCount()).To(Equal(1))
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "42"}))
 				})
 			})
@@ -646,7 +673,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 					}
 
 					check := 0
-					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version) ([]atc.Version, error) {
+					fakeResource.CheckStub = func(ctx context.Context, source atc.Source, from atc.Version, stderr io.Writer) ([]atc.Version, error) {
 						defer GinkgoRecover()
 
 						Expect(source).To(Equal(atc.Source{"custom": "some-secret-sauce"}))
@@ -772,7 +799,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 
 			Context("when fromVersion is nil", func() {
 				It("checks from the current version", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"custom": "version"}))
 				})
 			})
@@ -785,7 +812,7 @@ var _ = Describe("ResourceTypeScanner", func() {
 				})
 
 				It("checks from it", func() {
-					_, _, version := fakeResource.CheckArgsForCall(0)
+					_, _, version, _ := fakeResource.CheckArgsForCall(0)
 					Expect(version).To(Equal(atc.Version{"version": "1"}))
 				})
 
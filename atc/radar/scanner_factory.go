@@ -1,24 +1,36 @@
 package radar
 
 import (
+	"context"
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/resource"
 )
 
+//go:generate counterfeiter . Scanner
+
+type Scanner interface {
+	Run(lager.Logger, string) (db.CheckResult, error)
+	Scan(context.Context, lager.Logger, string) error
+	ScanFromVersion(lager.Logger, string, atc.Version) error
+}
+
 // ScannerFactory is the same interface as resourceserver/server.go
 // They are in two places because there would be cyclic dependencies otherwise
 
-// go:generate counterfeiter . ScannerFactory
+//go:generate counterfeiter . ScannerFactory
 type ScannerFactory interface {
 	NewResourceScanner(dbPipeline db.Pipeline) Scanner
 	NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
 }
 
 type scannerFactory struct {
+	checkRateLimiter             db.CheckRateLimiter
 	resourceFactory              resource.ResourceFactory
 	resourceConfigFactory        db.ResourceConfigFactory
 	resourceTypeCheckingInterval time.Duration
@@ -34,6 +46,7 @@ var ContainerExpiries = db.ContainerOwnerExpiries{
 }
 
 func NewScannerFactory(
+	checkRateLimiter db.CheckRateLimiter,
 	resourceFactory resource.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	resourceTypeCheckingInterval time.Duration,
@@ -42,6 +55,7 @@ func NewScannerFactory(
 	variablesFactory creds.VariablesFactory,
 ) ScannerFactory {
 	return &scannerFactory{
+		checkRateLimiter:             checkRateLimiter,
 		resourceFactory:              resourceFactory,
 		resourceConfigFactory:        resourceConfigFactory,
 		resourceCheckingInterval:     resourceCheckingInterval,
@@ -56,6 +70,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 
 	resourceTypeScanner := NewResourceTypeScanner(
 		clock.NewClock(),
+		f.checkRateLimiter,
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceTypeCheckingInterval,
@@ -66,6 +81,7 @@ func (f *scannerFactory) NewResourceScanner(dbPipeline db.Pipeline) Scanner {
 
 	return NewResourceScanner(
 		clock.NewClock(),
+		f.checkRateLimiter,
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceCheckingInterval,
@@ -81,6 +97,7 @@ func (f *scannerFactory) NewResourceTypeScanner(dbPipeline db.Pipeline) Scanner
 
 	return NewResourceTypeScanner(
 		clock.NewClock(),
+		f.checkRateLimiter,
 		f.resourceFactory,
 		f.resourceConfigFactory,
 		f.resourceTypeCheckingInterval,
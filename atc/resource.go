@@ -1,11 +1,12 @@
 package atc
 
 type Resource struct {
-	Name         string `json:"name"`
-	PipelineName string `json:"pipeline_name"`
-	TeamName     string `json:"team_name"`
-	Type         string `json:"type"`
-	LastChecked  int64  `json:"last_checked,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Type                 string       `json:"type"`
+	LastChecked          int64        `json:"last_checked,omitempty"`
 
 	Paused bool `json:"paused,omitempty"`
 
@@ -16,3 +17,10 @@ type Resource struct {
 	PinnedVersion  Version `json:"pinned_version,omitempty"`
 	PinnedInConfig bool    `json:"pinned_in_config,omitempty"`
 }
+
+func (r Resource) PipelineRef() PipelineRef {
+	return PipelineRef{
+		Name:         r.PipelineName,
+		InstanceVars: r.PipelineInstanceVars,
+	}
+}
@@ -1,7 +1,9 @@
 package resource
 
 import (
+	"bytes"
 	"context"
+	"io"
 
 	"github.com/concourse/concourse/atc"
 )
@@ -11,19 +13,33 @@ type checkRequest struct {
 	Version atc.Version `json:"version"`
 }
 
-func (resource *resource) Check(ctx context.Context, source atc.Source, fromVersion atc.Version) ([]atc.Version, error) {
+// Check runs the check script, writing its stderr to the given writer if
+// there is one. The stderr is included in the error if the script fails
+// either way.
+func (resource *resource) Check(ctx context.Context, source atc.Source, fromVersion atc.Version, stderr io.Writer) ([]atc.Version, error) {
 	var versions []atc.Version
 
+	var logDest io.Writer
+	logs := new(bytes.Buffer)
+	if stderr != nil {
+		logDest = io.MultiWriter(stderr, logs)
+	}
+
 	err := resource.runScript(
 		ctx,
 		"/opt/resource/check",
 		nil,
 		checkRequest{source, fromVersion},
 		&versions,
-		nil,
+		logDest,
 		false,
 	)
 	if err != nil {
+		if scriptErr, ok := err.(ErrResourceScriptFailed); ok && logDest != nil {
+			scriptErr.Stderr = logs.String()
+			return nil, scriptErr
+		}
+
 		return nil, err
 	}
 
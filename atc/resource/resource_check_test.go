@@ -1,6 +1,7 @@
 package resource_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io/ioutil"
@@ -8,6 +9,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/tracing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -15,6 +17,8 @@ import (
 
 var _ = Describe("Resource Check", func() {
 	var (
+		ctx context.Context
+
 		source  atc.Source
 		version atc.Version
 
@@ -25,11 +29,15 @@ var _ = Describe("Resource Check", func() {
 
 		checkScriptProcess *gardenfakes.FakeProcess
 
+		stderr *bytes.Buffer
+
 		checkResult []atc.Version
 		checkErr    error
 	)
 
 	BeforeEach(func() {
+		ctx = context.TODO()
+
 		source = atc.Source{"some": "source"}
 		version = atc.Version{"some": "version"}
 
@@ -43,6 +51,8 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptExitStatus, nil
 		}
 
+		stderr = nil
+
 		checkResult = nil
 		checkErr = nil
 	})
@@ -62,7 +72,11 @@ var _ = Describe("Resource Check", func() {
 			return checkScriptProcess, nil
 		}
 
-		checkResult, checkErr = resourceForContainer.Check(context.TODO(), source, version)
+		if stderr != nil {
+			checkResult, checkErr = resourceForContainer.Check(ctx, source, version, stderr)
+		} else {
+			checkResult, checkErr = resourceForContainer.Check(ctx, source, version, nil)
+		}
 	})
 
 	It("runs /opt/resource/check the request on stdin", func() {
@@ -78,6 +92,24 @@ var _ = Describe("Resource Check", func() {
 		Expect(string(request)).To(Equal(`{"source":{"some":"source"},"version":{"some":"version"}}`))
 	})
 
+	Context("when the check is traced", func() {
+		var span *tracing.Span
+
+		BeforeEach(func() {
+			tracing.ConfigureExporter(tracing.NewJSONExporter(ioutil.Discard))
+			ctx, span = tracing.StartSpan(ctx, "check", nil)
+		})
+
+		AfterEach(func() {
+			tracing.ConfigureExporter(nil)
+		})
+
+		It("passes the trace context on to the script", func() {
+			spec, _ := fakeContainer.RunArgsForCall(0)
+			Expect(spec.Env).To(ConsistOf("TRACEPARENT=" + span.Context().Traceparent()))
+		})
+	})
+
 	Context("when /check outputs versions", func() {
 		BeforeEach(func() {
 			checkScriptStdout = `[{"ver":"abc"}, {"ver":"def"}, {"ver":"ghi"}]`
@@ -119,6 +151,29 @@ var _ = Describe("Resource Check", func() {
 			Expect(checkErr.Error()).To(ContainSubstring("exit status 9"))
 			Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
 		})
+
+		Context("when a stderr writer is given", func() {
+			BeforeEach(func() {
+				stderr = new(bytes.Buffer)
+			})
+
+			It("writes stderr to it and still includes it in the error", func() {
+				Expect(stderr.String()).To(Equal("some-stderr"))
+				Expect(checkErr.Error()).To(ContainSubstring("some-stderr"))
+			})
+		})
+	})
+
+	Context("when a stderr writer is given", func() {
+		BeforeEach(func() {
+			checkScriptStderr = "some-warnings"
+			stderr = new(bytes.Buffer)
+		})
+
+		It("writes the script's stderr to it", func() {
+			Expect(checkErr).NotTo(HaveOccurred())
+			Expect(stderr.String()).To(Equal("some-warnings"))
+		})
 	})
 
 	Context("when the output of /opt/resource/check is malformed", func() {
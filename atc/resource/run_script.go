@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const resourceResultPropertyName = "concourse:resource-result"
@@ -81,6 +82,7 @@ func (resource *resource) runScript(
 				ID:   TaskProcessID,
 				Path: path,
 				Args: args,
+				Env:  tracing.Env(ctx),
 			}, processIO)
 			if err != nil {
 				return err
@@ -90,6 +92,7 @@ func (resource *resource) runScript(
 		process, err = resource.container.Run(garden.ProcessSpec{
 			Path: path,
 			Args: args,
+			Env:  tracing.Env(ctx),
 		}, processIO)
 		if err != nil {
 			return err
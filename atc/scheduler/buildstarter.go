@@ -1,18 +1,24 @@
 package scheduler
 
 import (
+	"context"
+	"time"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/engine"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper"
 	"github.com/concourse/concourse/atc/scheduler/maxinflight"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 //go:generate counterfeiter . BuildStarter
 
 type BuildStarter interface {
 	TryStartPendingBuildsForJob(
+		ctx context.Context,
 		logger lager.Logger,
 		job db.Job,
 		resources db.Resources,
@@ -55,14 +61,20 @@ type buildStarter struct {
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
+	ctx context.Context,
 	logger lager.Logger,
 	job db.Job,
 	resources db.Resources,
 	resourceTypes atc.VersionedResourceTypes,
 	nextPendingBuildsForJob []db.Build,
 ) error {
+	ctx, span := tracing.StartSpan(ctx, "try-start-pending-builds", tracing.Attrs{
+		"job": job.Name(),
+	})
+	defer span.End()
+
 	for _, nextPendingBuild := range nextPendingBuildsForJob {
-		started, err := s.tryStartNextPendingBuild(logger, nextPendingBuild, job, resources, resourceTypes)
+		started, err := s.tryStartNextPendingBuild(ctx, logger, nextPendingBuild, job, resources, resourceTypes)
 		if err != nil {
 			return err
 		}
@@ -76,6 +88,7 @@ func (s *buildStarter) TryStartPendingBuildsForJob(
 }
 
 func (s *buildStarter) tryStartNextPendingBuild(
+	ctx context.Context,
 	logger lager.Logger,
 	nextPendingBuild db.Build,
 	job db.Job,
@@ -95,7 +108,11 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
-	if nextPendingBuild.IsManuallyTriggered() {
+	// reruns use exactly the inputs of the build they rerun, which were saved
+	// when the rerun was created, so the job's input mapping is left alone
+	isRerun := nextPendingBuild.RerunOf() != 0
+
+	if nextPendingBuild.IsManuallyTriggered() && !isRerun {
 		jobBuildInputs := job.Config().Inputs()
 		for _, input := range jobBuildInputs {
 			scanLog := logger.Session("scan", lager.Data{
@@ -103,7 +120,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 				"resource": input.Resource,
 			})
 
-			err := s.scanner.Scan(scanLog, input.Resource)
+			err := s.scanner.Scan(ctx, scanLog, input.Resource)
 			if err != nil {
 				return false, err
 			}
@@ -115,7 +132,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 			return false, err
 		}
 
-		_, err = s.inputMapper.SaveNextInputMapping(logger, versions, job, resources)
+		_, err = s.inputMapper.SaveNextInputMapping(ctx, logger, versions, job, resources)
 		if err != nil {
 			return false, err
 		}
@@ -127,13 +144,23 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		resourceTypes = dbResourceTypes.Deserialize()
 	}
 
-	buildInputs, found, err := job.GetNextBuildInputs()
-	if err != nil {
-		logger.Error("failed-to-get-next-build-inputs", err)
-		return false, err
-	}
-	if !found {
-		return false, nil
+	var buildInputs []db.BuildInput
+	if isRerun {
+		buildInputs, err = nextPendingBuild.Inputs()
+		if err != nil {
+			logger.Error("failed-to-get-rerun-build-inputs", err)
+			return false, err
+		}
+	} else {
+		var found bool
+		buildInputs, found, err = job.GetNextBuildInputs()
+		if err != nil {
+			logger.Error("failed-to-get-next-build-inputs", err)
+			return false, err
+		}
+		if !found {
+			return false, nil
+		}
 	}
 
 	pipelinePaused, err := s.pipeline.CheckPaused()
@@ -160,9 +187,11 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
-	err = nextPendingBuild.UseInputs(buildInputs)
-	if err != nil {
-		return false, err
+	if !isRerun {
+		err = nextPendingBuild.UseInputs(buildInputs)
+		if err != nil {
+			return false, err
+		}
 	}
 
 	resourceConfigs := atc.ResourceConfigs{}
@@ -191,9 +220,42 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		return false, nil
 	}
 
+	schedulableTime := s.schedulableTime(logger, job, nextPendingBuild, isRerun)
+	if !schedulableTime.IsZero() {
+		metric.BuildSchedulingLatency{
+			TeamName:     nextPendingBuild.TeamName(),
+			PipelineName: nextPendingBuild.PipelineName(),
+			JobName:      nextPendingBuild.JobName(),
+			Duration:     time.Since(schedulableTime),
+		}.Emit(logger)
+	}
+
 	logger.Info("starting")
 
 	go createdBuild.Resume(logger)
 
 	return true, nil
 }
+
+// schedulableTime returns since when the pending build could have been
+// started: when it was created, or when the job's inputs were determined if
+// the build had to wait for them. Reruns reuse the inputs of the build they
+// rerun, so they could be started as soon as they were created.
+func (s *buildStarter) schedulableTime(logger lager.Logger, job db.Job, build db.Build, isRerun bool) time.Time {
+	schedulableTime := build.CreateTime()
+	if isRerun {
+		return schedulableTime
+	}
+
+	determinedTime, found, err := job.NextBuildInputsDeterminedTime()
+	if err != nil {
+		logger.Error("failed-to-get-next-build-inputs-determined-time", err)
+		return schedulableTime
+	}
+
+	if found && determinedTime.After(schedulableTime) {
+		return determinedTime
+	}
+
+	return schedulableTime
+}
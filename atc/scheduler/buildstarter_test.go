@@ -1,6 +1,7 @@
 package scheduler_test
 
 import (
+	"context"
 	"errors"
 
 	"code.cloudfoundry.org/lager"
@@ -82,6 +83,7 @@ var _ = Describe("BuildStarter", func() {
 
 			JustBeforeEach(func() {
 				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					context.TODO(),
 					lagertest.NewTestLogger("test"),
 					job,
 					db.Resources{resource},
@@ -132,7 +134,7 @@ var _ = Describe("BuildStarter", func() {
 
 				Context("when resource checking succeeds", func() {
 					BeforeEach(func() {
-						fakeScanner.ScanStub = func(lager.Logger, string) error {
+						fakeScanner.ScanStub = func(context.Context, lager.Logger, string) error {
 							defer GinkgoRecover()
 							Expect(fakePipeline.LoadVersionsDBCallCount()).To(BeZero())
 							return nil
@@ -172,8 +174,8 @@ var _ = Describe("BuildStarter", func() {
 
 						It("checked for the right resources", func() {
 							Expect(fakeScanner.ScanCallCount()).To(Equal(2))
-							_, resource1 := fakeScanner.ScanArgsForCall(0)
-							_, resource2 := fakeScanner.ScanArgsForCall(1)
+							_, _, resource1 := fakeScanner.ScanArgsForCall(0)
+							_, _, resource2 := fakeScanner.ScanArgsForCall(1)
 							Expect([]string{resource1, resource2}).To(ConsistOf("input-1", "input-2"))
 						})
 
@@ -236,7 +238,7 @@ var _ = Describe("BuildStarter", func() {
 
 							It("saved the next input mapping for the right job and versions", func() {
 								Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(Equal(1))
-								_, actualVersionsDB, actualJob, _ := fakeInputMapper.SaveNextInputMappingArgsForCall(0)
+								_, _, actualVersionsDB, actualJob, _ := fakeInputMapper.SaveNextInputMappingArgsForCall(0)
 								Expect(actualVersionsDB).To(Equal(versionsDB))
 								Expect(actualJob.Name()).To(Equal(job.Name()))
 							})
@@ -244,7 +246,7 @@ var _ = Describe("BuildStarter", func() {
 
 						Context("when saving the next input mapping succeeds", func() {
 							BeforeEach(func() {
-								fakeInputMapper.SaveNextInputMappingStub = func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error) {
+								fakeInputMapper.SaveNextInputMappingStub = func(context.Context, lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error) {
 									defer GinkgoRecover()
 									return nil, nil
 								}
@@ -294,6 +296,7 @@ var _ = Describe("BuildStarter", func() {
 
 			JustBeforeEach(func() {
 				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					context.TODO(),
 					lagertest.NewTestLogger("test"),
 					job,
 					db.Resources{resource},
@@ -538,6 +541,10 @@ var _ = Describe("BuildStarter", func() {
 
 									itUpdatedMaxInFlightForAllBuilds()
 
+									It("measures the scheduling latency from when the inputs were determined", func() {
+										Expect(job.NextBuildInputsDeterminedTimeCallCount()).To(Equal(3))
+									})
+
 									It("created the engine build with the right build and plan", func() {
 										Expect(fakeEngine.CreateBuildCallCount()).To(Equal(3))
 										_, actualBuild, actualPlan := fakeEngine.CreateBuildArgsForCall(0)
@@ -627,5 +634,76 @@ var _ = Describe("BuildStarter", func() {
 				})
 			})
 		})
+
+		Context("when the build is a rerun", func() {
+			var rerunInputs []db.BuildInput
+			var engineBuild *enginefakes.FakeBuild
+
+			BeforeEach(func() {
+				job = new(dbfakes.FakeJob)
+				job.NameReturns("some-job")
+				job.ConfigReturns(atc.JobConfig{Plan: atc.PlanSequence{{Get: "input-1"}}})
+
+				rerunInputs = []db.BuildInput{{Name: "input-1", ResourceID: 1, Version: atc.Version{"ref": "old"}}}
+
+				createdBuild.RerunOfReturns(42)
+				createdBuild.InputsReturns(rerunInputs, nil)
+				createdBuild.ScheduleReturns(true, nil)
+
+				fakeUpdater.UpdateMaxInFlightReachedReturns(false, nil)
+				fakeFactory.CreateReturns(atc.Plan{Get: &atc.GetPlan{Name: "input-1"}}, nil)
+
+				engineBuild = new(enginefakes.FakeBuild)
+				fakeEngine.CreateBuildReturns(engineBuild, nil)
+			})
+
+			JustBeforeEach(func() {
+				tryStartErr = buildStarter.TryStartPendingBuildsForJob(
+					context.TODO(),
+					lagertest.NewTestLogger("test"),
+					job,
+					db.Resources{resource},
+					versionedResourceTypes,
+					pendingBuilds,
+				)
+			})
+
+			It("doesn't check resources or use the job's input mapping", func() {
+				Expect(fakeScanner.ScanCallCount()).To(BeZero())
+				Expect(fakeInputMapper.SaveNextInputMappingCallCount()).To(BeZero())
+				Expect(job.GetNextBuildInputsCallCount()).To(BeZero())
+				Expect(job.NextBuildInputsDeterminedTimeCallCount()).To(BeZero())
+			})
+
+			It("creates the build plan with the inputs of the build it reruns", func() {
+				Expect(tryStartErr).NotTo(HaveOccurred())
+				Expect(fakeFactory.CreateCallCount()).To(Equal(1))
+				_, _, _, actualInputs := fakeFactory.CreateArgsForCall(0)
+				Expect(actualInputs).To(Equal(rerunInputs))
+			})
+
+			It("doesn't replace the build's inputs", func() {
+				Expect(createdBuild.UseInputsCallCount()).To(BeZero())
+			})
+
+			It("starts the build", func() {
+				Expect(fakeEngine.CreateBuildCallCount()).To(Equal(1))
+				Eventually(engineBuild.ResumeCallCount).Should(Equal(1))
+			})
+
+			Context("when getting the build's inputs fails", func() {
+				BeforeEach(func() {
+					createdBuild.InputsReturns(nil, disaster)
+				})
+
+				It("returns the error", func() {
+					Expect(tryStartErr).To(Equal(disaster))
+				})
+
+				It("doesn't mark the build as scheduled", func() {
+					Expect(createdBuild.ScheduleCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 })
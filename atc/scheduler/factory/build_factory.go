@@ -99,6 +99,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if planConfig.Across != nil {
+		return factory.across(planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -131,6 +135,58 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+func (factory *buildFactory) across(
+	planConfig atc.PlanConfig,
+	resources atc.ResourceConfigs,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	across := *planConfig.Across
+	planConfig.Across = nil
+
+	acrossPlan := atc.AcrossPlan{
+		Var:         across.Var,
+		MaxInFlight: across.MaxInFlight,
+	}
+
+	if name, ok := across.ValuesVar(); ok {
+		step, err := factory.constructPlanFromConfig(
+			planConfig,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		acrossPlan.ValuesVar = name
+		acrossPlan.Step = &step
+
+		return factory.planFactory.NewPlan(acrossPlan), nil
+	}
+
+	values, _ := across.StaticValues()
+	for _, value := range values {
+		step, err := factory.constructPlanFromConfig(
+			planConfig,
+			resources,
+			resourceTypes,
+			inputs,
+		)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		acrossPlan.Steps = append(acrossPlan.Steps, atc.VarScopedPlan{
+			Step:  step,
+			Value: value,
+		})
+	}
+
+	return factory.planFactory.NewPlan(acrossPlan), nil
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	planConfig atc.PlanConfig,
 	resources atc.ResourceConfigs,
@@ -244,9 +300,20 @@ func (factory *buildFactory) constructUnhookedPlan(
 			InputMapping:      planConfig.InputMapping,
 			OutputMapping:     planConfig.OutputMapping,
 			ImageArtifactName: planConfig.ImageArtifactName,
+			TestReports:       planConfig.TestReports,
 
 			VersionedResourceTypes: resourceTypes,
 		})
+
+	case planConfig.SetPipeline != "":
+		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
+			Name:         planConfig.SetPipeline,
+			InstanceVars: planConfig.InstanceVars,
+			File:         planConfig.TaskConfigPath,
+			Vars:         planConfig.TaskVars,
+			VarFiles:     planConfig.VarFiles,
+		})
+
 	case planConfig.Try != nil:
 		nextStep, err := factory.constructPlanFromConfig(
 			*planConfig.Try,
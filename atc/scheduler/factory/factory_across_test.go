@@ -0,0 +1,167 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(42, actualPlanFactory)
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("when there is a task annotated with 'across'", func() {
+		It("builds a copy of the task for each value, leaving the var to be bound when it runs", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "unit",
+						TaskConfigPath: "ci/unit.yml",
+						Params:         atc.Params{"GO_VERSION": "((go_version))"},
+						Across: &atc.AcrossConfig{
+							Var:         "go_version",
+							Values:      []interface{}{"1.10", "1.11"},
+							MaxInFlight: 1,
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			first := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "unit",
+				ConfigPath:             "ci/unit.yml",
+				Params:                 atc.Params{"GO_VERSION": "((go_version))"},
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			second := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "unit",
+				ConfigPath:             "ci/unit.yml",
+				Params:                 atc.Params{"GO_VERSION": "((go_version))"},
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var:         "go_version",
+				MaxInFlight: 1,
+				Steps: []atc.VarScopedPlan{
+					{Step: first, Value: "1.10"},
+					{Step: second, Value: "1.11"},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when there is a task annotated with 'across' with its values given by a var", func() {
+		It("builds the task once, to be run for each value of the var", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:           "unit",
+						TaskConfigPath: "ci/unit.yml",
+						Params:         atc.Params{"GO_VERSION": "((go_version))"},
+						Across: &atc.AcrossConfig{
+							Var:         "go_version",
+							Values:      "((go_versions))",
+							MaxInFlight: 1,
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			step := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "unit",
+				ConfigPath:             "ci/unit.yml",
+				Params:                 atc.Params{"GO_VERSION": "((go_version))"},
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var:         "go_version",
+				ValuesVar:   "go_versions",
+				Step:        &step,
+				MaxInFlight: 1,
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when there is a task annotated with 'across', 'attempts' and 'on_success'", func() {
+		It("applies the other modifiers to each copy", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task:     "unit",
+						Attempts: 2,
+						Success: &atc.PlanConfig{
+							Task: "report-((go_version))",
+						},
+						Across: &atc.AcrossConfig{
+							Var:    "go_version",
+							Values: []interface{}{"1.11"},
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			retry := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "unit",
+					VersionedResourceTypes: resourceTypes,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "unit",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			onSuccess := expectedPlanFactory.NewPlan(atc.OnSuccessPlan{
+				Step: retry,
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "report-((go_version))",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Var: "go_version",
+				Steps: []atc.VarScopedPlan{
+					{Step: onSuccess, Value: "1.11"},
+				},
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
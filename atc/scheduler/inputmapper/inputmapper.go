@@ -1,17 +1,21 @@
 package inputmapper
 
 import (
+	"context"
+
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/algorithm"
 	"github.com/concourse/concourse/atc/scheduler/inputmapper/inputconfig"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 //go:generate counterfeiter . InputMapper
 
 type InputMapper interface {
 	SaveNextInputMapping(
+		ctx context.Context,
 		logger lager.Logger,
 		versions *algorithm.VersionsDB,
 		job db.Job,
@@ -29,6 +33,7 @@ type inputMapper struct {
 }
 
 func (i *inputMapper) SaveNextInputMapping(
+	ctx context.Context,
 	logger lager.Logger,
 	versions *algorithm.VersionsDB,
 	job db.Job,
@@ -36,6 +41,11 @@ func (i *inputMapper) SaveNextInputMapping(
 ) (algorithm.InputMapping, error) {
 	logger = logger.Session("save-next-input-mapping")
 
+	_, span := tracing.StartSpan(ctx, "save-next-input-mapping", tracing.Attrs{
+		"job": job.Name(),
+	})
+	defer span.End()
+
 	inputConfigs := job.Config().Inputs()
 
 	for i, inputConfig := range inputConfigs {
@@ -1,6 +1,7 @@
 package inputmapper_test
 
 import (
+	"context"
 	"errors"
 
 	"code.cloudfoundry.org/lager/lagertest"
@@ -68,6 +69,7 @@ var _ = Describe("Inputmapper", func() {
 
 		JustBeforeEach(func() {
 			inputMapping, mappingErr = inputMapper.SaveNextInputMapping(
+				context.TODO(),
 				lagertest.NewTestLogger("test"),
 				versionsDB,
 				fakeJob,
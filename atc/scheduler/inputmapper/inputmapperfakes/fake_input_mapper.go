@@ -2,6 +2,7 @@
 package inputmapperfakes
 
 import (
+	"context"
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
@@ -11,13 +12,14 @@ import (
 )
 
 type FakeInputMapper struct {
-	SaveNextInputMappingStub        func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)
+	SaveNextInputMappingStub        func(context.Context, lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)
 	saveNextInputMappingMutex       sync.RWMutex
 	saveNextInputMappingArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 *algorithm.VersionsDB
-		arg3 db.Job
-		arg4 db.Resources
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *algorithm.VersionsDB
+		arg4 db.Job
+		arg5 db.Resources
 	}
 	saveNextInputMappingReturns struct {
 		result1 algorithm.InputMapping
@@ -31,19 +33,20 @@ type FakeInputMapper struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeInputMapper) SaveNextInputMapping(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 db.Job, arg4 db.Resources) (algorithm.InputMapping, error) {
+func (fake *FakeInputMapper) SaveNextInputMapping(arg1 context.Context, arg2 lager.Logger, arg3 *algorithm.VersionsDB, arg4 db.Job, arg5 db.Resources) (algorithm.InputMapping, error) {
 	fake.saveNextInputMappingMutex.Lock()
 	ret, specificReturn := fake.saveNextInputMappingReturnsOnCall[len(fake.saveNextInputMappingArgsForCall)]
 	fake.saveNextInputMappingArgsForCall = append(fake.saveNextInputMappingArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 *algorithm.VersionsDB
-		arg3 db.Job
-		arg4 db.Resources
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SaveNextInputMapping", []interface{}{arg1, arg2, arg3, arg4})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *algorithm.VersionsDB
+		arg4 db.Job
+		arg5 db.Resources
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SaveNextInputMapping", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.saveNextInputMappingMutex.Unlock()
 	if fake.SaveNextInputMappingStub != nil {
-		return fake.SaveNextInputMappingStub(arg1, arg2, arg3, arg4)
+		return fake.SaveNextInputMappingStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -58,17 +61,17 @@ func (fake *FakeInputMapper) SaveNextInputMappingCallCount() int {
 	return len(fake.saveNextInputMappingArgsForCall)
 }
 
-func (fake *FakeInputMapper) SaveNextInputMappingCalls(stub func(lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)) {
+func (fake *FakeInputMapper) SaveNextInputMappingCalls(stub func(context.Context, lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) (algorithm.InputMapping, error)) {
 	fake.saveNextInputMappingMutex.Lock()
 	defer fake.saveNextInputMappingMutex.Unlock()
 	fake.SaveNextInputMappingStub = stub
 }
 
-func (fake *FakeInputMapper) SaveNextInputMappingArgsForCall(i int) (lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) {
+func (fake *FakeInputMapper) SaveNextInputMappingArgsForCall(i int) (context.Context, lager.Logger, *algorithm.VersionsDB, db.Job, db.Resources) {
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	argsForCall := fake.saveNextInputMappingArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeInputMapper) SaveNextInputMappingReturns(result1 algorithm.InputMapping, result2 error) {
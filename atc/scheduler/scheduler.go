@@ -1,6 +1,7 @@
 package scheduler
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -21,10 +22,11 @@ type Scheduler struct {
 //go:generate counterfeiter . Scanner
 
 type Scanner interface {
-	Scan(lager.Logger, string) error
+	Scan(context.Context, lager.Logger, string) error
 }
 
 func (s *Scheduler) Schedule(
+	ctx context.Context,
 	logger lager.Logger,
 	versions *algorithm.VersionsDB,
 	jobs []db.Job,
@@ -35,7 +37,7 @@ func (s *Scheduler) Schedule(
 
 	for _, job := range jobs {
 		jStart := time.Now()
-		err := s.ensurePendingBuildExists(logger, versions, job, resources)
+		err := s.ensurePendingBuildExists(ctx, logger, versions, job, resources)
 		jobSchedulingTime[job.Name()] = time.Since(jStart)
 
 		if err != nil {
@@ -56,7 +58,7 @@ func (s *Scheduler) Schedule(
 			continue
 		}
 
-		err := s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuildsForJob)
+		err := s.BuildStarter.TryStartPendingBuildsForJob(ctx, logger, job, resources, resourceTypes, nextPendingBuildsForJob)
 		jobSchedulingTime[job.Name()] = jobSchedulingTime[job.Name()] + time.Since(jStart)
 
 		if err != nil {
@@ -68,12 +70,13 @@ func (s *Scheduler) Schedule(
 }
 
 func (s *Scheduler) ensurePendingBuildExists(
+	ctx context.Context,
 	logger lager.Logger,
 	versions *algorithm.VersionsDB,
 	job db.Job,
 	resources db.Resources,
 ) error {
-	inputMapping, err := s.InputMapper.SaveNextInputMapping(logger, versions, job, resources)
+	inputMapping, err := s.InputMapper.SaveNextInputMapping(ctx, logger, versions, job, resources)
 	if err != nil {
 		return err
 	}
@@ -125,7 +128,7 @@ func (s *Scheduler) TriggerImmediately(
 			return
 		}
 
-		err = s.BuildStarter.TryStartPendingBuildsForJob(logger, job, resources, resourceTypes, nextPendingBuilds)
+		err = s.BuildStarter.TryStartPendingBuildsForJob(context.Background(), logger, job, resources, resourceTypes, nextPendingBuilds)
 		if err != nil {
 			logger.Error("failed-to-start-next-pending-build-for-job", err, lager.Data{"job-name": job.Name()})
 			return
@@ -142,6 +145,6 @@ func (s *Scheduler) SaveNextInputMapping(logger lager.Logger, job db.Job, resour
 		return err
 	}
 
-	_, err = s.InputMapper.SaveNextInputMapping(logger, versions, job, resources)
+	_, err = s.InputMapper.SaveNextInputMapping(context.Background(), logger, versions, job, resources)
 	return err
 }
@@ -2,6 +2,7 @@
 package schedulerfakes
 
 import (
+	"context"
 	sync "sync"
 	time "time"
 
@@ -26,14 +27,15 @@ type FakeBuildScheduler struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
-	ScheduleStub        func(lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) (map[string]time.Duration, error)
+	ScheduleStub        func(context.Context, lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) (map[string]time.Duration, error)
 	scheduleMutex       sync.RWMutex
 	scheduleArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 *algorithm.VersionsDB
-		arg3 []db.Job
-		arg4 db.Resources
-		arg5 atc.VersionedResourceTypes
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *algorithm.VersionsDB
+		arg4 []db.Job
+		arg5 db.Resources
+		arg6 atc.VersionedResourceTypes
 	}
 	scheduleReturns struct {
 		result1 map[string]time.Duration
@@ -127,25 +129,26 @@ func (fake *FakeBuildScheduler) SaveNextInputMappingReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeBuildScheduler) Schedule(arg1 lager.Logger, arg2 *algorithm.VersionsDB, arg3 []db.Job, arg4 db.Resources, arg5 atc.VersionedResourceTypes) (map[string]time.Duration, error) {
-	var arg3Copy []db.Job
-	if arg3 != nil {
-		arg3Copy = make([]db.Job, len(arg3))
-		copy(arg3Copy, arg3)
+func (fake *FakeBuildScheduler) Schedule(arg1 context.Context, arg2 lager.Logger, arg3 *algorithm.VersionsDB, arg4 []db.Job, arg5 db.Resources, arg6 atc.VersionedResourceTypes) (map[string]time.Duration, error) {
+	var arg4Copy []db.Job
+	if arg4 != nil {
+		arg4Copy = make([]db.Job, len(arg4))
+		copy(arg4Copy, arg4)
 	}
 	fake.scheduleMutex.Lock()
 	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
 	fake.scheduleArgsForCall = append(fake.scheduleArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 *algorithm.VersionsDB
-		arg3 []db.Job
-		arg4 db.Resources
-		arg5 atc.VersionedResourceTypes
-	}{arg1, arg2, arg3Copy, arg4, arg5})
-	fake.recordInvocation("Schedule", []interface{}{arg1, arg2, arg3Copy, arg4, arg5})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *algorithm.VersionsDB
+		arg4 []db.Job
+		arg5 db.Resources
+		arg6 atc.VersionedResourceTypes
+	}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
+	fake.recordInvocation("Schedule", []interface{}{arg1, arg2, arg3, arg4Copy, arg5, arg6})
 	fake.scheduleMutex.Unlock()
 	if fake.ScheduleStub != nil {
-		return fake.ScheduleStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.ScheduleStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -160,17 +163,17 @@ func (fake *FakeBuildScheduler) ScheduleCallCount() int {
 	return len(fake.scheduleArgsForCall)
 }
 
-func (fake *FakeBuildScheduler) ScheduleCalls(stub func(lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) (map[string]time.Duration, error)) {
+func (fake *FakeBuildScheduler) ScheduleCalls(stub func(context.Context, lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) (map[string]time.Duration, error)) {
 	fake.scheduleMutex.Lock()
 	defer fake.scheduleMutex.Unlock()
 	fake.ScheduleStub = stub
 }
 
-func (fake *FakeBuildScheduler) ScheduleArgsForCall(i int) (lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) {
+func (fake *FakeBuildScheduler) ScheduleArgsForCall(i int) (context.Context, lager.Logger, *algorithm.VersionsDB, []db.Job, db.Resources, atc.VersionedResourceTypes) {
 	fake.scheduleMutex.RLock()
 	defer fake.scheduleMutex.RUnlock()
 	argsForCall := fake.scheduleArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeBuildScheduler) ScheduleReturns(result1 map[string]time.Duration, result2 error) {
@@ -2,6 +2,7 @@
 package schedulerfakes
 
 import (
+	"context"
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
@@ -11,14 +12,15 @@ import (
 )
 
 type FakeBuildStarter struct {
-	TryStartPendingBuildsForJobStub        func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) error
+	TryStartPendingBuildsForJobStub        func(context.Context, lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) error
 	tryStartPendingBuildsForJobMutex       sync.RWMutex
 	tryStartPendingBuildsForJobArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 db.Job
-		arg3 db.Resources
-		arg4 atc.VersionedResourceTypes
-		arg5 []db.Build
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+		arg6 []db.Build
 	}
 	tryStartPendingBuildsForJobReturns struct {
 		result1 error
@@ -30,25 +32,26 @@ type FakeBuildStarter struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeBuildStarter) TryStartPendingBuildsForJob(arg1 lager.Logger, arg2 db.Job, arg3 db.Resources, arg4 atc.VersionedResourceTypes, arg5 []db.Build) error {
-	var arg5Copy []db.Build
-	if arg5 != nil {
-		arg5Copy = make([]db.Build, len(arg5))
-		copy(arg5Copy, arg5)
+func (fake *FakeBuildStarter) TryStartPendingBuildsForJob(arg1 context.Context, arg2 lager.Logger, arg3 db.Job, arg4 db.Resources, arg5 atc.VersionedResourceTypes, arg6 []db.Build) error {
+	var arg6Copy []db.Build
+	if arg6 != nil {
+		arg6Copy = make([]db.Build, len(arg6))
+		copy(arg6Copy, arg6)
 	}
 	fake.tryStartPendingBuildsForJobMutex.Lock()
 	ret, specificReturn := fake.tryStartPendingBuildsForJobReturnsOnCall[len(fake.tryStartPendingBuildsForJobArgsForCall)]
 	fake.tryStartPendingBuildsForJobArgsForCall = append(fake.tryStartPendingBuildsForJobArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 db.Job
-		arg3 db.Resources
-		arg4 atc.VersionedResourceTypes
-		arg5 []db.Build
-	}{arg1, arg2, arg3, arg4, arg5Copy})
-	fake.recordInvocation("TryStartPendingBuildsForJob", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 db.Job
+		arg4 db.Resources
+		arg5 atc.VersionedResourceTypes
+		arg6 []db.Build
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
+	fake.recordInvocation("TryStartPendingBuildsForJob", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy})
 	fake.tryStartPendingBuildsForJobMutex.Unlock()
 	if fake.TryStartPendingBuildsForJobStub != nil {
-		return fake.TryStartPendingBuildsForJobStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.TryStartPendingBuildsForJobStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1
@@ -63,17 +66,17 @@ func (fake *FakeBuildStarter) TryStartPendingBuildsForJobCallCount() int {
 	return len(fake.tryStartPendingBuildsForJobArgsForCall)
 }
 
-func (fake *FakeBuildStarter) TryStartPendingBuildsForJobCalls(stub func(lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) error) {
+func (fake *FakeBuildStarter) TryStartPendingBuildsForJobCalls(stub func(context.Context, lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) error) {
 	fake.tryStartPendingBuildsForJobMutex.Lock()
 	defer fake.tryStartPendingBuildsForJobMutex.Unlock()
 	fake.TryStartPendingBuildsForJobStub = stub
 }
 
-func (fake *FakeBuildStarter) TryStartPendingBuildsForJobArgsForCall(i int) (lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) {
+func (fake *FakeBuildStarter) TryStartPendingBuildsForJobArgsForCall(i int) (context.Context, lager.Logger, db.Job, db.Resources, atc.VersionedResourceTypes, []db.Build) {
 	fake.tryStartPendingBuildsForJobMutex.RLock()
 	defer fake.tryStartPendingBuildsForJobMutex.RUnlock()
 	argsForCall := fake.tryStartPendingBuildsForJobArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakeBuildStarter) TryStartPendingBuildsForJobReturns(result1 error) {
@@ -2,6 +2,7 @@
 package schedulerfakes
 
 import (
+	"context"
 	sync "sync"
 
 	lager "code.cloudfoundry.org/lager"
@@ -9,11 +10,12 @@ import (
 )
 
 type FakeScanner struct {
-	ScanStub        func(lager.Logger, string) error
+	ScanStub        func(context.Context, lager.Logger, string) error
 	scanMutex       sync.RWMutex
 	scanArgsForCall []struct {
-		arg1 lager.Logger
-		arg2 string
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
 	}
 	scanReturns struct {
 		result1 error
@@ -25,17 +27,18 @@ type FakeScanner struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeScanner) Scan(arg1 lager.Logger, arg2 string) error {
+func (fake *FakeScanner) Scan(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.scanMutex.Lock()
 	ret, specificReturn := fake.scanReturnsOnCall[len(fake.scanArgsForCall)]
 	fake.scanArgsForCall = append(fake.scanArgsForCall, struct {
-		arg1 lager.Logger
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("Scan", []interface{}{arg1, arg2})
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Scan", []interface{}{arg1, arg2, arg3})
 	fake.scanMutex.Unlock()
 	if fake.ScanStub != nil {
-		return fake.ScanStub(arg1, arg2)
+		return fake.ScanStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -50,17 +53,17 @@ func (fake *FakeScanner) ScanCallCount() int {
 	return len(fake.scanArgsForCall)
 }
 
-func (fake *FakeScanner) ScanCalls(stub func(lager.Logger, string) error) {
+func (fake *FakeScanner) ScanCalls(stub func(context.Context, lager.Logger, string) error) {
 	fake.scanMutex.Lock()
 	defer fake.scanMutex.Unlock()
 	fake.ScanStub = stub
 }
 
-func (fake *FakeScanner) ScanArgsForCall(i int) (lager.Logger, string) {
+func (fake *FakeScanner) ScanArgsForCall(i int) (context.Context, lager.Logger, string) {
 	fake.scanMutex.RLock()
 	defer fake.scanMutex.RUnlock()
 	argsForCall := fake.scanArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeScanner) ScanReturns(result1 error) {
@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"io/ioutil"
+	"strconv"
 	"time"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/event"
@@ -27,16 +29,18 @@ type drainer struct {
 	transport    string `yaml:"transport"`
 	address      string `yaml:"address"`
 	caCerts      []string
+	format       Format
 	buildFactory db.BuildFactory
 }
 
-func NewDrainer(transport string, address string, hostname string, caCerts []string, buildFactory db.BuildFactory) Drainer {
+func NewDrainer(transport string, address string, hostname string, caCerts []string, format Format, buildFactory db.BuildFactory) Drainer {
 	return &drainer{
 		hostname:     hostname,
 		transport:    transport,
 		address:      address,
 		buildFactory: buildFactory,
 		caCerts:      caCerts,
+		format:       format,
 	}
 }
 
@@ -71,6 +75,10 @@ func (d *drainer) Run(ctx context.Context) error {
 			}
 		}
 
+		if d.format.RFC5424 {
+			return d.drainRFC5424(logger, certpool, builds)
+		}
+
 		syslog, err := sl.Dial(
 			d.hostname,
 			d.transport,
@@ -142,3 +150,121 @@ func (d *drainer) Run(ctx context.Context) error {
 	}
 	return nil
 }
+
+func (d *drainer) drainRFC5424(logger lager.Logger, certpool *x509.CertPool, builds []db.Build) error {
+	conn, err := dialRFC5424(d.transport, d.address, certpool)
+	if err != nil {
+		logger.Error("Syslog drainer connecting to server error.", err)
+		return err
+	}
+
+	defer conn.Close()
+
+	for _, build := range builds {
+		events, err := build.Events(0)
+		if err != nil {
+			logger.Error("Syslog drainer getting build events error.", err)
+			return err
+		}
+
+		steps := stepNames(build.PublicPlan())
+
+		for {
+			ev, err := events.Next()
+			if err != nil {
+				if err == db.ErrEndOfBuildEventStream {
+					break
+				}
+				logger.Error("Syslog drainer getting next event error.", err)
+				return err
+			}
+
+			if ev.Event != event.EventTypeLog {
+				continue
+			}
+
+			var log event.Log
+			err = json.Unmarshal(*ev.Data, &log)
+			if err != nil {
+				logger.Error("Syslog drainer unmarshalling log error.", err)
+				return err
+			}
+
+			err = conn.Send(rfc5424Message{
+				Priority:  int(sl.LogUser<<3 | sl.SevInfo),
+				Timestamp: time.Unix(log.Time, 0),
+				Hostname:  d.hostname,
+				AppName:   d.format.AppName,
+				MsgID:     d.format.MsgID,
+
+				SDID: d.format.SDID,
+				SDParams: []sdParam{
+					{"team", build.TeamName()},
+					{"pipeline", build.PipelineName()},
+					{"job", build.JobName()},
+					{"build_name", build.Name()},
+					{"build_id", strconv.Itoa(build.ID())},
+					{"step", steps[log.Origin.ID]},
+					{"source", string(log.Origin.Source)},
+				},
+
+				Message: log.Payload,
+			})
+			if err != nil {
+				logger.Error("Syslog drainer sending to server error.", err)
+				return err
+			}
+		}
+
+		err = build.SetDrained(true)
+		if err != nil {
+			logger.Error("Syslog drainer setting drained on build error.", err)
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stepNames maps the IDs of the get, put and task steps in a public plan to
+// their names, which is what log events refer to as their origin.
+func stepNames(publicPlan *json.RawMessage) map[event.OriginID]string {
+	names := map[event.OriginID]string{}
+	if publicPlan == nil {
+		return names
+	}
+
+	var plan interface{}
+	err := json.Unmarshal(*publicPlan, &plan)
+	if err != nil {
+		return names
+	}
+
+	collectStepNames(plan, names)
+
+	return names
+}
+
+func collectStepNames(node interface{}, names map[event.OriginID]string) {
+	switch node := node.(type) {
+	case map[string]interface{}:
+		if id, ok := node["id"].(string); ok {
+			for _, stepType := range []string{"get", "put", "task"} {
+				if step, ok := node[stepType].(map[string]interface{}); ok {
+					if name, ok := step["name"].(string); ok {
+						names[event.OriginID(id)] = name
+					}
+				}
+			}
+		}
+
+		for _, child := range node {
+			collectStepNames(child, names)
+		}
+
+	case []interface{}:
+		for _, child := range node {
+			collectStepNames(child, names)
+		}
+	}
+}
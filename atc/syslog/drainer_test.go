@@ -105,6 +105,30 @@ func newFakeBuild(id int) db.Build {
 	return fakeBuild
 }
 
+func newFakePipelineBuild(id int) db.Build {
+	fakeBuild := newFakeBuild(id).(*dbfakes.FakeBuild)
+
+	fakeEventSource := new(dbfakes.FakeEventSource)
+
+	msg := json.RawMessage(`{"time":1533744538,"origin":{"id":"some-plan-id","source":"stderr"},"payload":"build ` + strconv.Itoa(id) + ` log"}`)
+	fakeEventSource.NextReturnsOnCall(0, event.Envelope{
+		Data:  &msg,
+		Event: "log",
+	}, nil)
+	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	fakeBuild.EventsReturns(fakeEventSource, nil)
+	fakeBuild.NameReturns("42")
+	fakeBuild.TeamNameReturns("main")
+	fakeBuild.PipelineNameReturns(`some-"pipeline"`)
+	fakeBuild.JobNameReturns("some-job")
+
+	plan := json.RawMessage(`{"id":"some-do-id","do":[{"id":"some-plan-id","task":{"name":"unit","privileged":false}}]}`)
+	fakeBuild.PublicPlanReturns(&plan)
+
+	return fakeBuild
+}
+
 var _ = Describe("Drainer", func() {
 	var fakeBuildFactory *dbfakes.FakeBuildFactory
 	var s *testServer
@@ -130,7 +154,7 @@ var _ = Describe("Drainer", func() {
 
 			It("connects to remote server given correct cert", func() {
 
-				testDrainer := syslog.NewDrainer("tls", s.Addr, "test", []string{"testdata/cert.pem"}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tls", s.Addr, "test", []string{"testdata/cert.pem"}, syslog.Format{}, fakeBuildFactory)
 
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
@@ -138,7 +162,7 @@ var _ = Describe("Drainer", func() {
 			})
 
 			It("fails connects to remote server given incorrect cert", func() {
-				testDrainer := syslog.NewDrainer("tls", s.Addr, "test", []string{"testdata/client.pem"}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tls", s.Addr, "test", []string{"testdata/client.pem"}, syslog.Format{}, fakeBuildFactory)
 
 				err := testDrainer.Run(context.TODO())
 				Expect(err).To(HaveOccurred())
@@ -156,7 +180,7 @@ var _ = Describe("Drainer", func() {
 			It("drains all build events by tcp", func() {
 
 				defer GinkgoRecover()
-				testDrainer := syslog.NewDrainer("tcp", s.Addr, "test", []string{}, fakeBuildFactory)
+				testDrainer := syslog.NewDrainer("tcp", s.Addr, "test", []string{}, syslog.Format{}, fakeBuildFactory)
 				err := testDrainer.Run(context.TODO())
 				Expect(err).NotTo(HaveOccurred())
 
@@ -167,5 +191,80 @@ var _ = Describe("Drainer", func() {
 				Expect(got).NotTo(ContainSubstring("build 345 status"))
 			}, 0.2)
 		})
+
+		Context("when the rfc5424 format is configured", func() {
+			var format syslog.Format
+
+			BeforeEach(func() {
+				format = syslog.Format{
+					RFC5424: true,
+					AppName: "concourse",
+					MsgID:   "build-log",
+					SDID:    "concourse@32473",
+				}
+
+				fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{newFakePipelineBuild(123)}, nil)
+			})
+
+			Context("over tcp", func() {
+				JustBeforeEach(func() {
+					s = newTestServer(true)
+				})
+
+				It("sends the build metadata as structured data", func() {
+					testDrainer := syslog.NewDrainer("tcp", s.Addr, "test", []string{}, format, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					got := <-s.Messages
+					Expect(got).To(MatchRegexp(`^\d+ <14>1 `))
+					Expect(got).To(ContainSubstring(
+						`test concourse - build-log [concourse@32473 team="main" pipeline="some-\"pipeline\"" job="some-job" build_name="42" build_id="123" step="unit" source="stderr"] build 123 log`,
+					))
+				})
+
+				It("marks the builds as drained", func() {
+					build := newFakePipelineBuild(123)
+					fakeBuildFactory.GetDrainableBuildsReturns([]db.Build{build}, nil)
+
+					testDrainer := syslog.NewDrainer("tcp", s.Addr, "test", []string{}, format, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(build.(*dbfakes.FakeBuild).SetDrainedCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("over udp", func() {
+				var packetConn net.PacketConn
+
+				BeforeEach(func() {
+					var err error
+					packetConn, err = net.ListenPacket("udp", "127.0.0.1:0")
+					Expect(err).NotTo(HaveOccurred())
+
+					// satisfy the tcp server teardown
+					s = &testServer{Close: make(chan bool, 1)}
+				})
+
+				AfterEach(func() {
+					packetConn.Close()
+				})
+
+				It("sends a message per datagram", func() {
+					testDrainer := syslog.NewDrainer("udp", packetConn.LocalAddr().String(), "test", []string{}, format, fakeBuildFactory)
+					err := testDrainer.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					buf := make([]byte, 1024)
+					Expect(packetConn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+					n, _, err := packetConn.ReadFrom(buf)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(string(buf[:n])).To(HavePrefix("<14>1 "))
+					Expect(string(buf[:n])).To(HaveSuffix(`build_id="123" step="unit" source="stderr"] build 123 log`))
+				})
+			})
+		})
 	})
 })
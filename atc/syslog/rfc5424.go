@@ -0,0 +1,165 @@
+package syslog
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Format configures the messages sent by the drainer.
+type Format struct {
+	// RFC5424 sends messages with the build metadata as structured data,
+	// rather than in the app-name of an otherwise empty header.
+	RFC5424 bool
+
+	AppName string
+	MsgID   string
+
+	// SDID is the ID of the structured data element holding the build
+	// metadata, which must be of the form name@private-enterprise-number.
+	SDID string
+}
+
+// sdParam is a name and value pair within a structured data element.
+type sdParam struct {
+	Name  string
+	Value string
+}
+
+type rfc5424Message struct {
+	Priority  int
+	Timestamp time.Time
+	Hostname  string
+	AppName   string
+	MsgID     string
+
+	SDID     string
+	SDParams []sdParam
+
+	Message string
+}
+
+const rfc5424Time = "2006-01-02T15:04:05.999999Z07:00"
+
+// String formats the message as described in RFC 5424 section 6.
+func (msg rfc5424Message) String() string {
+	return fmt.Sprintf(
+		"<%d>1 %s %s %s - %s %s %s",
+		msg.Priority,
+		msg.Timestamp.Format(rfc5424Time),
+		headerField(msg.Hostname, 255),
+		headerField(msg.AppName, 48),
+		headerField(msg.MsgID, 32),
+		msg.structuredData(),
+		msg.Message,
+	)
+}
+
+func (msg rfc5424Message) structuredData() string {
+	if msg.SDID == "" {
+		return "-"
+	}
+
+	element := "[" + sdName(msg.SDID)
+	for _, param := range msg.SDParams {
+		if param.Value == "" {
+			continue
+		}
+
+		element += " " + sdName(param.Name) + `="` + sdValueEscaper.Replace(param.Value) + `"`
+	}
+
+	return element + "]"
+}
+
+// headerField returns the value as printable US-ASCII without spaces,
+// truncated to the maximum length allowed for the field, or the nil value if
+// it is empty.
+func headerField(value string, max int) string {
+	field := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return '_'
+		}
+
+		return r
+	}, value)
+
+	if field == "" {
+		return "-"
+	}
+
+	if len(field) > max {
+		field = field[:max]
+	}
+
+	return field
+}
+
+// sdName returns the value stripped of the characters not allowed in
+// SD-IDs and PARAM-NAMEs.
+func sdName(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' || r == ' ' {
+			return -1
+		}
+
+		return r
+	}, value)
+}
+
+var sdValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// rfc5424Conn sends messages over UDP, one per datagram, or over TCP or TLS
+// with octet-counted framing as described in RFC 6587 and RFC 5425.
+type rfc5424Conn struct {
+	transport string
+	conn      net.Conn
+}
+
+func dialRFC5424(transport string, address string, rootCAs *x509.CertPool) (*rfc5424Conn, error) {
+	var conn net.Conn
+	var err error
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+
+	switch transport {
+	case "tls":
+		conn, err = tls.DialWithDialer(dialer, "tcp", address, &tls.Config{
+			RootCAs: rootCAs,
+		})
+	case "tcp", "udp":
+		conn, err = dialer.Dial(transport, address)
+	default:
+		err = fmt.Errorf("unsupported syslog transport: %s", transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &rfc5424Conn{
+		transport: transport,
+		conn:      conn,
+	}, nil
+}
+
+func (c *rfc5424Conn) Send(msg rfc5424Message) error {
+	line := msg.String()
+	if c.transport != "udp" {
+		line = fmt.Sprintf("%d %s", len(line), line)
+	}
+
+	err := c.conn.SetWriteDeadline(time.Now().Add(30 * time.Second))
+	if err != nil {
+		return err
+	}
+
+	_, err = c.conn.Write([]byte(line))
+	return err
+}
+
+func (c *rfc5424Conn) Close() error {
+	return c.conn.Close()
+}
@@ -4,6 +4,8 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	DisableSecretRedaction bool `json:"disable_secret_redaction,omitempty"`
 }
 
 type TeamAuth map[string]map[string][]string
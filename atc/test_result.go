@@ -0,0 +1,42 @@
+package atc
+
+type TestStatus string
+
+const (
+	TestPassed  TestStatus = "passed"
+	TestFailed  TestStatus = "failed"
+	TestErrored TestStatus = "errored"
+	TestSkipped TestStatus = "skipped"
+)
+
+// TestResult is the outcome of a single test case, as collected from the
+// test reports of a build's tasks.
+type TestResult struct {
+	Suite    string     `json:"suite"`
+	Name     string     `json:"name"`
+	Status   TestStatus `json:"status"`
+	Duration float64    `json:"duration,omitempty"`
+	Message  string     `json:"message,omitempty"`
+}
+
+type TestSummary struct {
+	Passed  int `json:"passed"`
+	Failed  int `json:"failed"`
+	Errored int `json:"errored"`
+	Skipped int `json:"skipped"`
+}
+
+func (summary TestSummary) Total() int {
+	return summary.Passed + summary.Failed + summary.Errored + summary.Skipped
+}
+
+// FlakyTest is a test that both passed and failed across a job's recent
+// builds.
+type FlakyTest struct {
+	Suite  string `json:"suite"`
+	Name   string `json:"name"`
+	Passed int    `json:"passed"`
+	Failed int    `json:"failed"`
+
+	LastFailedBuild string `json:"last_failed_build"`
+}
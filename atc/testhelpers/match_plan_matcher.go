@@ -133,6 +133,20 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		ids = append(ids, subIDs...)
 	}
 
+	if plan.Across != nil {
+		for i, p := range plan.Across.Steps {
+			plan.Across.Steps[i].Step, subIDs = stripIDs(p.Step)
+			ids = append(ids, subIDs...)
+		}
+
+		if plan.Across.Step != nil {
+			var step atc.Plan
+			step, subIDs = stripIDs(*plan.Across.Step)
+			plan.Across.Step = &step
+			ids = append(ids, subIDs...)
+		}
+	}
+
 	if plan.Get != nil {
 		if plan.Get.VersionFrom != nil {
 			planID := atc.PlanID("<stripped>")
@@ -0,0 +1,100 @@
+package testreport
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type junitSuite struct {
+	XMLName xml.Name
+
+	Name   string       `xml:"name,attr"`
+	Suites []junitSuite `xml:"testsuite"`
+	Cases  []junitCase  `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string `xml:"name,attr"`
+	Classname string `xml:"classname,attr"`
+	Time      string `xml:"time,attr"`
+
+	Failure *junitMessage `xml:"failure"`
+	Error   *junitMessage `xml:"error"`
+	Skipped *junitMessage `xml:"skipped"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Body    string `xml:",chardata"`
+}
+
+func (message junitMessage) String() string {
+	if message.Message != "" {
+		return message.Message
+	}
+
+	return message.Body
+}
+
+// ParseJUnit parses a JUnit XML report. Both a top-level <testsuites> and a
+// lone <testsuite> are accepted, and suites may be nested.
+func ParseJUnit(r io.Reader) ([]atc.TestResult, error) {
+	var root junitSuite
+	err := xml.NewDecoder(r).Decode(&root)
+	if err != nil {
+		return nil, fmt.Errorf("parse junit report: %s", err)
+	}
+
+	switch root.XMLName.Local {
+	case "testsuites", "testsuite":
+	default:
+		return nil, fmt.Errorf("parse junit report: unexpected root element <%s>", root.XMLName.Local)
+	}
+
+	results := []atc.TestResult{}
+	collectJUnitResults(root, &results)
+	return results, nil
+}
+
+func collectJUnitResults(suite junitSuite, results *[]atc.TestResult) {
+	for _, testCase := range suite.Cases {
+		result := atc.TestResult{
+			Suite:  testCase.Classname,
+			Name:   testCase.Name,
+			Status: atc.TestPassed,
+		}
+
+		if result.Suite == "" {
+			result.Suite = suite.Name
+		}
+
+		if testCase.Time != "" {
+			duration, err := strconv.ParseFloat(testCase.Time, 64)
+			if err == nil {
+				result.Duration = duration
+			}
+		}
+
+		switch {
+		case testCase.Error != nil:
+			result.Status = atc.TestErrored
+			result.Message = truncateMessage(testCase.Error.String())
+		case testCase.Failure != nil:
+			result.Status = atc.TestFailed
+			result.Message = truncateMessage(testCase.Failure.String())
+		case testCase.Skipped != nil:
+			result.Status = atc.TestSkipped
+			result.Message = truncateMessage(testCase.Skipped.String())
+		}
+
+		*results = append(*results, result)
+	}
+
+	for _, child := range suite.Suites {
+		collectJUnitResults(child, results)
+	}
+}
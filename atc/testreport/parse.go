@@ -0,0 +1,52 @@
+// Package testreport parses the JUnit XML and TAP reports written by tasks
+// into per-test results.
+package testreport
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// MaxMessageLength bounds the failure message kept for each test, so that a
+// stack trace dumped into a report doesn't bloat the database.
+const MaxMessageLength = 4096
+
+type UnknownFormatError struct {
+	Path string
+}
+
+func (err UnknownFormatError) Error() string {
+	return fmt.Sprintf("unknown test report format: %s (expected .xml or .tap)", err.Path)
+}
+
+// Parse reads the report at the given path, picking the format by its
+// extension.
+func Parse(reportPath string, r io.Reader) ([]atc.TestResult, error) {
+	switch strings.ToLower(path.Ext(reportPath)) {
+	case ".xml":
+		return ParseJUnit(r)
+	case ".tap":
+		return ParseTAP(strings.TrimSuffix(path.Base(reportPath), path.Ext(reportPath)), r)
+	default:
+		return nil, UnknownFormatError{reportPath}
+	}
+}
+
+func truncateMessage(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) > MaxMessageLength {
+		// back off to a rune boundary so the result stays valid UTF-8
+		end := MaxMessageLength
+		for end > 0 && !utf8.RuneStart(message[end]) {
+			end--
+		}
+		return message[:end]
+	}
+
+	return message
+}
@@ -0,0 +1,162 @@
+package testreport_test
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/testreport"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Parse", func() {
+	var (
+		reportPath string
+		report     string
+
+		results  []atc.TestResult
+		parseErr error
+	)
+
+	JustBeforeEach(func() {
+		results, parseErr = testreport.Parse(reportPath, strings.NewReader(report))
+	})
+
+	Context("with a JUnit report", func() {
+		BeforeEach(func() {
+			reportPath = "results/junit.xml"
+		})
+
+		Context("with nested test suites", func() {
+			BeforeEach(func() {
+				report = `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites>
+  <testsuite name="api" tests="2">
+    <testcase name="lists builds" classname="api.BuildsTest" time="0.25"/>
+    <testcase name="aborts builds" classname="api.BuildsTest" time="1.5">
+      <failure message="expected 204, got 500">stack trace</failure>
+    </testcase>
+    <testsuite name="api.auth">
+      <testcase name="rejects tokens" time="bogus">
+        <error>connection refused</error>
+      </testcase>
+      <testcase name="refreshes tokens">
+        <skipped message="flaky upstream"/>
+      </testcase>
+    </testsuite>
+  </testsuite>
+</testsuites>`
+			})
+
+			It("returns every test case", func() {
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(results).To(Equal([]atc.TestResult{
+					{Suite: "api.BuildsTest", Name: "lists builds", Status: atc.TestPassed, Duration: 0.25},
+					{Suite: "api.BuildsTest", Name: "aborts builds", Status: atc.TestFailed, Duration: 1.5, Message: "expected 204, got 500"},
+					{Suite: "api.auth", Name: "rejects tokens", Status: atc.TestErrored, Message: "connection refused"},
+					{Suite: "api.auth", Name: "refreshes tokens", Status: atc.TestSkipped, Message: "flaky upstream"},
+				}))
+			})
+		})
+
+		Context("with a single test suite", func() {
+			BeforeEach(func() {
+				report = `<testsuite name="unit"><testcase name="adds"/></testsuite>`
+			})
+
+			It("names the suite after it", func() {
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(results).To(Equal([]atc.TestResult{
+					{Suite: "unit", Name: "adds", Status: atc.TestPassed},
+				}))
+			})
+		})
+
+		Context("when the failure message is huge", func() {
+			BeforeEach(func() {
+				report = `<testsuite name="unit"><testcase name="adds"><failure>` +
+					strings.Repeat("x", testreport.MaxMessageLength*2) +
+					`</failure></testcase></testsuite>`
+			})
+
+			It("truncates it", func() {
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(results).To(HaveLen(1))
+				Expect(results[0].Message).To(HaveLen(testreport.MaxMessageLength))
+			})
+		})
+
+		Context("when the huge failure message is not ASCII", func() {
+			BeforeEach(func() {
+				report = `<testsuite name="unit"><testcase name="adds"><failure>` +
+					"x" + strings.Repeat("é", testreport.MaxMessageLength) +
+					`</failure></testcase></testsuite>`
+			})
+
+			It("truncates it on a rune boundary", func() {
+				Expect(parseErr).ToNot(HaveOccurred())
+				Expect(results).To(HaveLen(1))
+				Expect(utf8.ValidString(results[0].Message)).To(BeTrue())
+				Expect(results[0].Message).To(HaveLen(testreport.MaxMessageLength - 1))
+			})
+		})
+
+		Context("when the root element is not a test suite", func() {
+			BeforeEach(func() {
+				report = `<html></html>`
+			})
+
+			It("returns an error", func() {
+				Expect(parseErr).To(MatchError(ContainSubstring("unexpected root element <html>")))
+			})
+		})
+
+		Context("when the report is malformed", func() {
+			BeforeEach(func() {
+				report = `<testsuite`
+			})
+
+			It("returns an error", func() {
+				Expect(parseErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("with a TAP report", func() {
+		BeforeEach(func() {
+			reportPath = "results/unit.tap"
+			report = `TAP version 13
+1..5
+ok 1 - adds numbers
+not ok 2 - subtracts numbers
+# expected 1
+# got 2
+ok 3 - multiplies numbers # SKIP no multiplier
+not ok 4 divides numbers # TODO not implemented
+ok 5
+`
+		})
+
+		It("returns every test, attributed to the report's name", func() {
+			Expect(parseErr).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]atc.TestResult{
+				{Suite: "unit", Name: "adds numbers", Status: atc.TestPassed},
+				{Suite: "unit", Name: "subtracts numbers", Status: atc.TestFailed, Message: "expected 1\ngot 2"},
+				{Suite: "unit", Name: "multiplies numbers", Status: atc.TestSkipped, Message: "no multiplier"},
+				{Suite: "unit", Name: "divides numbers", Status: atc.TestSkipped, Message: "not implemented"},
+				{Suite: "unit", Name: "test 5", Status: atc.TestPassed},
+			}))
+		})
+	})
+
+	Context("with an unknown extension", func() {
+		BeforeEach(func() {
+			reportPath = "results/report.json"
+		})
+
+		It("returns an error", func() {
+			Expect(parseErr).To(Equal(testreport.UnknownFormatError{Path: "results/report.json"}))
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package testreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var tapTestLine = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(\S+)\s*(.*))?$`)
+
+// ParseTAP parses a TAP report. TAP has no notion of suites, so every
+// result is attributed to the given suite name. Diagnostic lines following
+// a failed test are kept as its message.
+func ParseTAP(suite string, r io.Reader) ([]atc.TestResult, error) {
+	results := []atc.TestResult{}
+
+	var diagnostics []string
+	flushDiagnostics := func() {
+		if len(diagnostics) == 0 {
+			return
+		}
+
+		last := &results[len(results)-1]
+		if last.Status == atc.TestFailed {
+			last.Message = truncateMessage(strings.Join(diagnostics, "\n"))
+		}
+
+		diagnostics = nil
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+
+		if len(results) > 0 && strings.HasPrefix(trimmed, "#") {
+			diagnostics = append(diagnostics, strings.TrimSpace(strings.TrimPrefix(trimmed, "#")))
+			continue
+		}
+
+		match := tapTestLine.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		flushDiagnostics()
+
+		result := atc.TestResult{
+			Suite:  suite,
+			Name:   match[3],
+			Status: atc.TestPassed,
+		}
+
+		if result.Name == "" {
+			result.Name = "test " + match[2]
+		}
+
+		if match[1] == "not ok" {
+			result.Status = atc.TestFailed
+		}
+
+		switch strings.ToUpper(match[4]) {
+		case "SKIP":
+			result.Status = atc.TestSkipped
+			result.Message = truncateMessage(match[5])
+		case "TODO":
+			// failures of tests marked TODO are expected, so they don't count
+			// against the build
+			if result.Status == atc.TestFailed {
+				result.Status = atc.TestSkipped
+				result.Message = truncateMessage(match[5])
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("parse tap report: %s", err)
+	}
+
+	flushDiagnostics()
+
+	return results, nil
+}
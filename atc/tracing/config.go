@@ -0,0 +1,34 @@
+package tracing
+
+import (
+	"errors"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/flag"
+)
+
+type Config struct {
+	ServiceName string   `long:"tracing-service-name" default:"concourse-web" description:"Service name to attach to traces."`
+	ZipkinURL   flag.URL `long:"tracing-zipkin-url"   description:"Endpoint of a collector accepting spans in the Zipkin v2 JSON format, e.g. http://127.0.0.1:9411/api/v2/spans."`
+	Stdout      bool     `long:"tracing-stdout"       description:"Write spans to stdout as JSON, one per line."`
+}
+
+// Prepare configures the exporter according to the config, leaving tracing
+// disabled if no exporter is configured.
+func (config Config) Prepare(logger lager.Logger) error {
+	if config.ZipkinURL.URL != nil && config.Stdout {
+		return errors.New("only one tracing exporter may be configured")
+	}
+
+	switch {
+	case config.ZipkinURL.URL != nil:
+		ConfigureExporter(NewZipkinExporter(logger.Session("zipkin"), config.ZipkinURL.String(), config.ServiceName))
+	case config.Stdout:
+		ConfigureExporter(NewJSONExporter(os.Stdout))
+	default:
+		ConfigureExporter(nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+// JSONExporter writes each span to the writer as a line of JSON. It is meant
+// for debugging and for tests rather than production use.
+type JSONExporter struct {
+	lock sync.Mutex
+	enc  *json.Encoder
+}
+
+func NewJSONExporter(w io.Writer) *JSONExporter {
+	return &JSONExporter{
+		enc: json.NewEncoder(w),
+	}
+}
+
+func (exporter *JSONExporter) ExportSpan(span SpanData) {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+
+	_ = exporter.enc.Encode(span)
+}
@@ -0,0 +1,231 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// TraceparentEnv is the environment variable through which the current span
+// is passed to processes run by Concourse, in the W3C Trace Context format.
+const TraceparentEnv = "TRACEPARENT"
+
+var traceparentRegexp = regexp.MustCompile(`^00-([0-9a-f]{32})-([0-9a-f]{16})-[0-9a-f]{2}$`)
+
+// Attrs are attached to a span to describe what it covers, e.g. the team,
+// pipeline and build it belongs to.
+type Attrs map[string]string
+
+// SpanContext identifies a span within a trace.
+type SpanContext struct {
+	TraceID string
+	SpanID  string
+}
+
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != "" && sc.SpanID != ""
+}
+
+// Traceparent formats the span context as a W3C traceparent header value.
+func (sc SpanContext) Traceparent() string {
+	return fmt.Sprintf("00-%s-%s-01", sc.TraceID, sc.SpanID)
+}
+
+// ParseTraceparent parses a W3C traceparent header value.
+func ParseTraceparent(traceparent string) (SpanContext, bool) {
+	matches := traceparentRegexp.FindStringSubmatch(traceparent)
+	if matches == nil {
+		return SpanContext{}, false
+	}
+
+	return SpanContext{TraceID: matches[1], SpanID: matches[2]}, true
+}
+
+// SpanData is what is handed to the exporter once a span has ended.
+type SpanData struct {
+	Name       string    `json:"name"`
+	TraceID    string    `json:"trace_id"`
+	SpanID     string    `json:"span_id"`
+	ParentID   string    `json:"parent_id,omitempty"`
+	StartTime  time.Time `json:"start_time"`
+	EndTime    time.Time `json:"end_time"`
+	Attributes Attrs     `json:"attributes,omitempty"`
+	Error      string    `json:"error,omitempty"`
+}
+
+func (data SpanData) Duration() time.Duration {
+	return data.EndTime.Sub(data.StartTime)
+}
+
+// Exporter ships ended spans off to wherever they are collected. ExportSpan
+// is called synchronously when a span ends, so it must not block.
+type Exporter interface {
+	ExportSpan(SpanData)
+}
+
+var exporter Exporter
+
+// Configured is true once an exporter has been configured. Tracing is a
+// no-op otherwise.
+var Configured bool
+
+// ConfigureExporter sets the exporter to which all spans are sent. Passing
+// nil disables tracing.
+func ConfigureExporter(e Exporter) {
+	exporter = e
+	Configured = e != nil
+}
+
+// Span measures a single unit of work. A nil *Span is valid and does
+// nothing, which is what StartSpan returns when tracing is not configured.
+type Span struct {
+	exporter Exporter
+
+	lock  sync.Mutex
+	data  SpanData
+	ended bool
+}
+
+type spanKey struct{}
+type remoteParentKey struct{}
+
+// StartSpan starts a span as a child of the span in the given context, or of
+// a remote parent set with ContextWithRemoteParent, and returns a context
+// carrying the new span.
+//
+// The span must be ended with End or Span.End.
+func StartSpan(ctx context.Context, name string, attrs Attrs) (context.Context, *Span) {
+	if !Configured {
+		return ctx, nil
+	}
+
+	span := &Span{
+		exporter: exporter,
+		data: SpanData{
+			Name:       name,
+			SpanID:     randomID(8),
+			StartTime:  time.Now(),
+			Attributes: Attrs{},
+		},
+	}
+
+	for k, v := range attrs {
+		span.data.Attributes[k] = v
+	}
+
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.data.TraceID = parent.data.TraceID
+		span.data.ParentID = parent.data.SpanID
+	} else if remote, ok := ctx.Value(remoteParentKey{}).(SpanContext); ok && remote.IsValid() {
+		span.data.TraceID = remote.TraceID
+		span.data.ParentID = remote.SpanID
+	} else {
+		span.data.TraceID = randomID(16)
+	}
+
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// SpanFromContext returns the span carried by the context, if any.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// ContextWithRemoteParent makes spans started from the returned context
+// children of a span started elsewhere, e.g. in another process.
+func ContextWithRemoteParent(ctx context.Context, parent SpanContext) context.Context {
+	return context.WithValue(ctx, remoteParentKey{}, parent)
+}
+
+// Env returns the environment variables that pass the span in the context
+// on to a child process, or nil if there is no span.
+func Env(ctx context.Context) []string {
+	span := SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+
+	return []string{TraceparentEnv + "=" + span.Context().Traceparent()}
+}
+
+// End ends the span, recording the error if there was one.
+func End(span *Span, err error) {
+	if err != nil {
+		span.SetError(err)
+	}
+
+	span.End()
+}
+
+func (span *Span) Context() SpanContext {
+	if span == nil {
+		return SpanContext{}
+	}
+
+	return SpanContext{
+		TraceID: span.data.TraceID,
+		SpanID:  span.data.SpanID,
+	}
+}
+
+func (span *Span) SetAttribute(key string, value string) {
+	if span == nil {
+		return
+	}
+
+	span.lock.Lock()
+	span.data.Attributes[key] = value
+	span.lock.Unlock()
+}
+
+func (span *Span) SetError(err error) {
+	if span == nil {
+		return
+	}
+
+	span.lock.Lock()
+	span.data.Error = err.Error()
+	span.lock.Unlock()
+}
+
+// End ends the span and exports it. Ending a span more than once has no
+// effect.
+func (span *Span) End() {
+	if span == nil {
+		return
+	}
+
+	span.lock.Lock()
+	if span.ended {
+		span.lock.Unlock()
+		return
+	}
+
+	span.ended = true
+	span.data.EndTime = time.Now()
+
+	data := span.data
+	data.Attributes = Attrs{}
+	for k, v := range span.data.Attributes {
+		data.Attributes[k] = v
+	}
+	span.lock.Unlock()
+
+	span.exporter.ExportSpan(data)
+}
+
+func randomID(bytes int) string {
+	id := make([]byte, bytes)
+
+	_, err := rand.Read(id)
+	if err != nil {
+		panic("failed to generate span id: " + err.Error())
+	}
+
+	return hex.EncodeToString(id)
+}
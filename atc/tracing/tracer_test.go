@@ -0,0 +1,151 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/concourse/concourse/atc/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type recordingExporter struct {
+	lock  sync.Mutex
+	spans []tracing.SpanData
+}
+
+func (exporter *recordingExporter) ExportSpan(span tracing.SpanData) {
+	exporter.lock.Lock()
+	exporter.spans = append(exporter.spans, span)
+	exporter.lock.Unlock()
+}
+
+func (exporter *recordingExporter) Spans() []tracing.SpanData {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+	return append([]tracing.SpanData{}, exporter.spans...)
+}
+
+var _ = Describe("Tracer", func() {
+	var exporter *recordingExporter
+
+	BeforeEach(func() {
+		exporter = &recordingExporter{}
+		tracing.ConfigureExporter(exporter)
+	})
+
+	AfterEach(func() {
+		tracing.ConfigureExporter(nil)
+	})
+
+	Describe("StartSpan", func() {
+		It("exports the span once it ends", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", tracing.Attrs{"team": "main"})
+			Expect(exporter.Spans()).To(BeEmpty())
+
+			span.End()
+
+			spans := exporter.Spans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].Name).To(Equal("some-span"))
+			Expect(spans[0].Attributes).To(Equal(tracing.Attrs{"team": "main"}))
+			Expect(spans[0].TraceID).To(HaveLen(32))
+			Expect(spans[0].SpanID).To(HaveLen(16))
+			Expect(spans[0].ParentID).To(BeEmpty())
+			Expect(spans[0].EndTime).ToNot(BeTemporally("<", spans[0].StartTime))
+		})
+
+		It("only exports the span once", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			span.End()
+			span.End()
+
+			Expect(exporter.Spans()).To(HaveLen(1))
+		})
+
+		It("makes spans started from its context its children", func() {
+			ctx, parent := tracing.StartSpan(context.Background(), "parent", nil)
+			_, child := tracing.StartSpan(ctx, "child", nil)
+			child.End()
+			parent.End()
+
+			spans := exporter.Spans()
+			Expect(spans).To(HaveLen(2))
+			Expect(spans[0].TraceID).To(Equal(spans[1].TraceID))
+			Expect(spans[0].ParentID).To(Equal(spans[1].SpanID))
+		})
+
+		It("continues a trace from a remote parent", func() {
+			remote := tracing.SpanContext{
+				TraceID: "0af7651916cd43dd8448eb211c80319c",
+				SpanID:  "b7ad6b7169203331",
+			}
+
+			_, span := tracing.StartSpan(tracing.ContextWithRemoteParent(context.Background(), remote), "some-span", nil)
+			span.End()
+
+			Expect(exporter.Spans()[0].TraceID).To(Equal(remote.TraceID))
+			Expect(exporter.Spans()[0].ParentID).To(Equal(remote.SpanID))
+		})
+
+		Context("when tracing is not configured", func() {
+			BeforeEach(func() {
+				tracing.ConfigureExporter(nil)
+			})
+
+			It("returns a span which does nothing", func() {
+				ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+				Expect(span).To(BeNil())
+				Expect(tracing.SpanFromContext(ctx)).To(BeNil())
+
+				span.SetAttribute("some", "attribute")
+				tracing.End(span, errors.New("nope"))
+
+				Expect(exporter.Spans()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("End", func() {
+		It("records the error", func() {
+			_, span := tracing.StartSpan(context.Background(), "some-span", nil)
+			tracing.End(span, errors.New("nope"))
+
+			Expect(exporter.Spans()[0].Error).To(Equal("nope"))
+		})
+	})
+
+	Describe("Env", func() {
+		It("passes on the span in the context as a traceparent", func() {
+			ctx, span := tracing.StartSpan(context.Background(), "some-span", nil)
+
+			env := tracing.Env(ctx)
+			Expect(env).To(Equal([]string{
+				"TRACEPARENT=00-" + span.Context().TraceID + "-" + span.Context().SpanID + "-01",
+			}))
+		})
+
+		It("is empty without a span", func() {
+			Expect(tracing.Env(context.Background())).To(BeNil())
+		})
+	})
+
+	Describe("ParseTraceparent", func() {
+		It("parses what Traceparent formats", func() {
+			sc := tracing.SpanContext{
+				TraceID: "0af7651916cd43dd8448eb211c80319c",
+				SpanID:  "b7ad6b7169203331",
+			}
+
+			parsed, ok := tracing.ParseTraceparent(sc.Traceparent())
+			Expect(ok).To(BeTrue())
+			Expect(parsed).To(Equal(sc))
+		})
+
+		It("rejects malformed values", func() {
+			_, ok := tracing.ParseTraceparent("00-nope-nope-01")
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
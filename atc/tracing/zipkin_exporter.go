@@ -0,0 +1,142 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// ZipkinFlushInterval is how often the ZipkinExporter sends the spans it has
+// buffered to the collector.
+var ZipkinFlushInterval = time.Second
+
+const zipkinBatchSize = 100
+const zipkinBufferSize = 10000
+
+// ZipkinExporter sends spans in batches to a collector speaking the Zipkin v2
+// JSON API, which is supported by Zipkin itself, Jaeger, and the
+// OpenTelemetry collector.
+//
+// Spans are dropped if the collector can't keep up, rather than slowing
+// down the work being traced.
+type ZipkinExporter struct {
+	logger      lager.Logger
+	url         string
+	serviceName string
+	client      *http.Client
+
+	spans chan SpanData
+}
+
+func NewZipkinExporter(logger lager.Logger, url string, serviceName string) *ZipkinExporter {
+	exporter := &ZipkinExporter{
+		logger:      logger,
+		url:         url,
+		serviceName: serviceName,
+		client:      &http.Client{Timeout: 10 * time.Second},
+
+		spans: make(chan SpanData, zipkinBufferSize),
+	}
+
+	go exporter.flushLoop()
+
+	return exporter
+}
+
+func (exporter *ZipkinExporter) ExportSpan(span SpanData) {
+	select {
+	case exporter.spans <- span:
+	default:
+		exporter.logger.Debug("dropped-span", lager.Data{"name": span.Name})
+	}
+}
+
+func (exporter *ZipkinExporter) flushLoop() {
+	ticker := time.NewTicker(ZipkinFlushInterval)
+	defer ticker.Stop()
+
+	batch := []SpanData{}
+
+	for {
+		select {
+		case span := <-exporter.spans:
+			batch = append(batch, span)
+			if len(batch) < zipkinBatchSize {
+				continue
+			}
+		case <-ticker.C:
+			if len(batch) == 0 {
+				continue
+			}
+		}
+
+		err := exporter.send(batch)
+		if err != nil {
+			exporter.logger.Error("failed-to-send-spans", err, lager.Data{"spans": len(batch)})
+		}
+
+		batch = []SpanData{}
+	}
+}
+
+type zipkinEndpoint struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type zipkinSpan struct {
+	TraceID       string            `json:"traceId"`
+	ID            string            `json:"id"`
+	ParentID      string            `json:"parentId,omitempty"`
+	Name          string            `json:"name"`
+	Timestamp     int64             `json:"timestamp"`
+	Duration      int64             `json:"duration"`
+	LocalEndpoint zipkinEndpoint    `json:"localEndpoint"`
+	Tags          map[string]string `json:"tags,omitempty"`
+}
+
+func (exporter *ZipkinExporter) send(batch []SpanData) error {
+	spans := make([]zipkinSpan, len(batch))
+	for i, span := range batch {
+		tags := map[string]string{}
+		for k, v := range span.Attributes {
+			tags[k] = v
+		}
+
+		if span.Error != "" {
+			tags["error"] = span.Error
+		}
+
+		spans[i] = zipkinSpan{
+			TraceID:       span.TraceID,
+			ID:            span.SpanID,
+			ParentID:      span.ParentID,
+			Name:          span.Name,
+			Timestamp:     span.StartTime.UnixNano() / int64(time.Microsecond),
+			Duration:      int64(span.Duration() / time.Microsecond),
+			LocalEndpoint: zipkinEndpoint{ServiceName: exporter.serviceName},
+			Tags:          tags,
+		}
+	}
+
+	payload, err := json.Marshal(spans)
+	if err != nil {
+		return err
+	}
+
+	response, err := exporter.client.Post(exporter.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode >= 300 {
+		return fmt.Errorf("collector responded with status %d", response.StatusCode)
+	}
+
+	return nil
+}
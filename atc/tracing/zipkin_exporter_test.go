@@ -0,0 +1,83 @@
+package tracing_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ZipkinExporter", func() {
+	var (
+		server   *ghttp.Server
+		exporter *tracing.ZipkinExporter
+
+		originalFlushInterval time.Duration
+	)
+
+	BeforeEach(func() {
+		originalFlushInterval = tracing.ZipkinFlushInterval
+		tracing.ZipkinFlushInterval = 10 * time.Millisecond
+
+		server = ghttp.NewServer()
+		exporter = tracing.NewZipkinExporter(lagertest.NewTestLogger("zipkin"), server.URL()+"/api/v2/spans", "concourse-web")
+	})
+
+	AfterEach(func() {
+		tracing.ZipkinFlushInterval = originalFlushInterval
+		server.Close()
+	})
+
+	It("sends the spans to the collector", func() {
+		received := make(chan []map[string]interface{}, 1)
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", "/api/v2/spans"),
+			ghttp.VerifyContentType("application/json"),
+			func(w http.ResponseWriter, r *http.Request) {
+				payload, err := ioutil.ReadAll(r.Body)
+				Expect(err).ToNot(HaveOccurred())
+
+				var spans []map[string]interface{}
+				Expect(json.Unmarshal(payload, &spans)).To(Succeed())
+
+				received <- spans
+			},
+			ghttp.RespondWith(http.StatusAccepted, nil),
+		))
+
+		start := time.Unix(1, 0)
+		exporter.ExportSpan(tracing.SpanData{
+			Name:       "task",
+			TraceID:    "0af7651916cd43dd8448eb211c80319c",
+			SpanID:     "b7ad6b7169203331",
+			ParentID:   "00f067aa0ba902b7",
+			StartTime:  start,
+			EndTime:    start.Add(2 * time.Millisecond),
+			Attributes: tracing.Attrs{"name": "unit"},
+			Error:      "nope",
+		})
+
+		var spans []map[string]interface{}
+		Eventually(received).Should(Receive(&spans))
+
+		Expect(spans).To(Equal([]map[string]interface{}{
+			{
+				"traceId":       "0af7651916cd43dd8448eb211c80319c",
+				"id":            "b7ad6b7169203331",
+				"parentId":      "00f067aa0ba902b7",
+				"name":          "task",
+				"timestamp":     float64(1000000),
+				"duration":      float64(2000),
+				"localEndpoint": map[string]interface{}{"serviceName": "concourse-web"},
+				"tags":          map[string]interface{}{"name": "unit", "error": "nope"},
+			},
+		}))
+	})
+})
@@ -3,6 +3,7 @@ package atc
 import (
 	"errors"
 	"fmt"
+	"path"
 	"sort"
 	"strings"
 	"time"
@@ -120,6 +121,10 @@ func validateResources(c Config) error {
 		if resource.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resource.Webhook != nil {
+			errorMessages = append(errorMessages, validateWebhook(identifier, *resource.Webhook)...)
+		}
 	}
 
 	errorMessages = append(errorMessages, validateResourcesUnused(c)...)
@@ -127,6 +132,35 @@ func validateResources(c Config) error {
 	return compositeErr(errorMessages)
 }
 
+func validateWebhook(identifier string, webhook WebhookConfig) []string {
+	errorMessages := []string{}
+
+	switch webhook.Provider {
+	case WebhookProviderGitHub, WebhookProviderGitLab, WebhookProviderBitbucket, WebhookProviderHMAC:
+	case "":
+		errorMessages = append(errorMessages, identifier+" has a webhook with no provider")
+	default:
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has a webhook with an unknown provider ('%s')", identifier, webhook.Provider))
+	}
+
+	if webhook.Secret == "" {
+		errorMessages = append(errorMessages, identifier+" has a webhook with no secret")
+	}
+
+	if webhook.Header != "" && webhook.Provider != WebhookProviderHMAC {
+		errorMessages = append(errorMessages, fmt.Sprintf("%s has a webhook header, which only applies to the '%s' provider", identifier, WebhookProviderHMAC))
+	}
+
+	for _, pattern := range webhook.Branches {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has a webhook with an invalid branch pattern ('%s')", identifier, pattern))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateResourceTypes(c Config) error {
 	errorMessages := []string{}
 
@@ -224,6 +258,16 @@ func validateJobs(c Config) ([]Warning, error) {
 			)
 		}
 
+		if job.BuildTimeout != "" {
+			_, err := time.ParseDuration(job.BuildTimeout)
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has a build_timeout that could not be parsed ('%s')", job.BuildTimeout),
+				)
+			}
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -319,6 +363,10 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		foundTypes.Find("task")
 	}
 
+	if plan.SetPipeline != "" {
+		foundTypes.Find("set_pipeline")
+	}
+
 	if plan.Do != nil {
 		foundTypes.Find("do")
 	}
@@ -359,7 +407,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.get.%s", identifier, plan.Get)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"privileged", "config", "file"},
+			[]string{"privileged", "config", "file", "test_reports"},
 			plan, identifier)...,
 		)
 
@@ -434,7 +482,7 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		identifier = fmt.Sprintf("%s.put.%s", identifier, plan.Put)
 
 		errorMessages = append(errorMessages, validateInapplicableFields(
-			[]string{"passed", "trigger", "privileged", "config", "file"},
+			[]string{"passed", "trigger", "privileged", "config", "file", "test_reports"},
 			plan, identifier)...,
 		)
 
@@ -495,6 +543,18 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 			plan, identifier)...,
 		)
 
+	case plan.SetPipeline != "":
+		identifier = fmt.Sprintf("%s.set_pipeline.%s", identifier, plan.SetPipeline)
+
+		if plan.TaskConfigPath == "" {
+			errorMessages = append(errorMessages, identifier+" does not specify any pipeline configuration file")
+		}
+
+		errorMessages = append(errorMessages, validateInapplicableFields(
+			[]string{"resource", "passed", "trigger", "privileged", "config", "test_reports"},
+			plan, identifier)...,
+		)
+
 	case plan.Try != nil:
 		subIdentifier := fmt.Sprintf("%s.try", identifier)
 		planWarnings, planErrMessages := validatePlan(c, subIdentifier, *plan.Try)
@@ -543,6 +603,25 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]Warning, []st
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if plan.Across != nil {
+		subIdentifier := fmt.Sprintf("%s.across", identifier)
+
+		if plan.Across.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" does not specify a var")
+		}
+
+		values, static := plan.Across.StaticValues()
+		if plan.Across.Values == nil || (static && len(values) == 0) {
+			errorMessages = append(errorMessages, subIdentifier+" does not specify any values")
+		} else if _, ok := plan.Across.ValuesVar(); !static && !ok {
+			errorMessages = append(errorMessages, subIdentifier+" must specify its values as a list or a ((var))")
+		}
+
+		if plan.Across.MaxInFlight < 0 {
+			errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid max_in_flight (%d)", plan.Across.MaxInFlight))
+		}
+	}
+
 	return warnings, errorMessages
 }
 
@@ -576,6 +655,10 @@ func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, id
 			if plan.TaskConfigPath != "" {
 				foundInapplicableFields = append(foundInapplicableFields, field)
 			}
+		case "test_reports":
+			if len(plan.TestReports) != 0 {
+				foundInapplicableFields = append(foundInapplicableFields, field)
+			}
 		}
 	}
 
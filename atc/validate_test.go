@@ -190,6 +190,64 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource has a webhook", func() {
+			BeforeEach(func() {
+				config.Resources[0].Webhook = &WebhookConfig{
+					Provider: WebhookProviderGitHub,
+					Secret:   "((webhook-secret))",
+					Branches: []string{"master", "release/*"},
+				}
+			})
+
+			It("returns no error", func() {
+				Expect(errorMessages).To(BeEmpty())
+			})
+
+			Context("with an unknown provider", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Provider = "bogus"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook with an unknown provider ('bogus')"))
+				})
+			})
+
+			Context("with no secret", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Secret = ""
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook with no secret"))
+				})
+			})
+
+			Context("with a header for a provider other than hmac", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Header = "X-Some-Signature"
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook header, which only applies to the 'hmac' provider"))
+				})
+			})
+
+			Context("with an invalid branch pattern", func() {
+				BeforeEach(func() {
+					config.Resources[0].Webhook.Branches = []string{"[master"}
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("resources.some-resource has a webhook with an invalid branch pattern ('[master')"))
+				})
+			})
+		})
+
 		Context("when two resources have the same name", func() {
 			BeforeEach(func() {
 				config.Resources = append(config.Resources, config.Resources...)
@@ -459,6 +517,30 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has an invalid build_timeout", func() {
+			BeforeEach(func() {
+				job.BuildTimeout = "nope"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has a build_timeout that could not be parsed ('nope')"))
+			})
+		})
+
+		Context("when a job has a valid build_timeout", func() {
+			BeforeEach(func() {
+				job.BuildTimeout = "1h30m"
+				config.Jobs = append(config.Jobs, job)
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(BeEmpty())
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.Plan = append(job.Plan, PlanConfig{
@@ -616,6 +698,23 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a get plan has test reports specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:         "some-resource",
+						TestReports: []string{"results/junit.xml"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource has invalid fields specified (test_reports)"))
+				})
+			})
+
 			Context("when a task plan has invalid fields specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -652,6 +751,41 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a set_pipeline plan has no file set", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline: "lol",
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.lol does not specify any pipeline configuration file"))
+				})
+			})
+
+			Context("when a set_pipeline plan has invalid fields specified", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						SetPipeline:    "lol",
+						TaskConfigPath: "pipeline.yml",
+						Privileged:     true,
+						Trigger:        true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].set_pipeline.lol has invalid fields specified (trigger, privileged)"))
+				})
+			})
+
 			Context("when a task plan has config path and config specified", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -1116,6 +1250,80 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when an across step does not specify a var or any values", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:    "some-resource",
+						Across: &AcrossConfig{},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across does not specify a var"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across does not specify any values"))
+				})
+			})
+
+			Context("when an across step specifies its values as a ((var))", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: &AcrossConfig{
+							Var:    "some-var",
+							Values: "((some-values))",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(BeEmpty())
+				})
+			})
+
+			Context("when an across step specifies its values as neither a list nor a ((var))", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: &AcrossConfig{
+							Var:    "some-var",
+							Values: "some-values",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across must specify its values as a list or a ((var))"))
+				})
+			})
+
+			Context("when an across step has a negative max_in_flight", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: &AcrossConfig{
+							Var:         "some-var",
+							Values:      []interface{}{"a", "b"},
+							MaxInFlight: -1,
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does return an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across has an invalid max_in_flight (-1)"))
+				})
+			})
+
 			Context("when a put plan has a custom name but refers to a resource that does not exist", func() {
 				BeforeEach(func() {
 					job.Plan = append(job.Plan, PlanConfig{
@@ -26,6 +26,13 @@ type Client interface {
 		creds.VersionedResourceTypes,
 	) (Container, error)
 
+	FindOrChooseWorker(
+		lager.Logger,
+		db.ContainerOwner,
+		ContainerSpec,
+		WorkerSpec,
+	) (Worker, error)
+
 	FindContainerByHandle(lager.Logger, int, string) (Container, bool, error)
 
 	LookupVolume(lager.Logger, string) (Volume, bool, error)
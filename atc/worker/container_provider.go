@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/tracing"
 )
 
 const creatingContainerRetryDelay = 1 * time.Second
@@ -200,17 +201,33 @@ func (p *containerProvider) FindOrCreateContainer(
 
 			logger.Debug("fetching-image")
 
-			fetchedImage, err := image.FetchForContainer(ctx, logger, creatingContainer)
+			fetchCtx, span := tracing.StartSpan(ctx, "fetch-image", tracing.Attrs{
+				"worker": p.worker.Name(),
+			})
+
+			fetchStart := time.Now()
+
+			fetchedImage, err := image.FetchForContainer(fetchCtx, logger, creatingContainer)
+			tracing.End(span, err)
 			if err != nil {
 				creatingContainer.Failed()
 				logger.Error("failed-to-fetch-image-for-container", err)
 				return nil, err
 			}
 
+			metric.ImageFetched{
+				TeamName:     metadata.TeamName,
+				PipelineName: metadata.PipelineName,
+				JobName:      metadata.JobName,
+				Duration:     time.Since(fetchStart),
+			}.Emit(logger)
+
 			logger.Debug("creating-container-in-garden")
 
 			gardenContainer, err = p.createGardenContainer(
+				ctx,
 				logger,
+				metadata,
 				creatingContainer,
 				containerSpec,
 				fetchedImage,
@@ -322,7 +339,9 @@ func (p *containerProvider) constructGardenWorkerContainer(
 }
 
 func (p *containerProvider) createGardenContainer(
+	ctx context.Context,
 	logger lager.Logger,
+	metadata db.ContainerMetadata,
 	creatingContainer db.CreatingContainer,
 	spec ContainerSpec,
 	fetchedImage FetchedImage,
@@ -429,10 +448,25 @@ func (p *containerProvider) createGardenContainer(
 				"dest-volume": inputVolume.Handle(),
 				"dest-worker": inputVolume.WorkerName(),
 			}
+			_, span := tracing.StartSpan(ctx, "stream-to", tracing.Attrs{
+				"destination": cleanedInputPath,
+				"dest-worker": inputVolume.WorkerName(),
+			})
+
+			streamStart := time.Now()
+
 			err = inputSource.Source().StreamTo(logger.Session("stream-to", destData), inputVolume)
+			tracing.End(span, err)
 			if err != nil {
 				return nil, err
 			}
+
+			metric.VolumeStreamed{
+				TeamName:     metadata.TeamName,
+				PipelineName: metadata.PipelineName,
+				JobName:      metadata.JobName,
+				Duration:     time.Since(streamStart),
+			}.Emit(logger)
 		}
 
 		ioVolumeMounts = append(ioVolumeMounts, VolumeMount{
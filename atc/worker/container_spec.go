@@ -17,6 +17,10 @@ type WorkerSpec struct {
 	TeamID int
 
 	ResourceTypes creds.VersionedResourceTypes
+
+	// MaxActiveTasks excludes workers already running this many tasks. Zero
+	// means no limit.
+	MaxActiveTasks int
 }
 
 type ContainerSpec struct {
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
@@ -54,6 +55,7 @@ type imageResourceFetcherFactory struct {
 	resourceFetcherFactory  resource.FetcherFactory
 	dbResourceCacheFactory  db.ResourceCacheFactory
 	dbResourceConfigFactory db.ResourceConfigFactory
+	checkRateLimiter        db.CheckRateLimiter
 	clock                   clock.Clock
 }
 
@@ -61,12 +63,14 @@ func NewImageResourceFetcherFactory(
 	resourceFetcherFactory resource.FetcherFactory,
 	dbResourceCacheFactory db.ResourceCacheFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
+	checkRateLimiter db.CheckRateLimiter,
 	clock clock.Clock,
 ) ImageResourceFetcherFactory {
 	return &imageResourceFetcherFactory{
 		resourceFetcherFactory:  resourceFetcherFactory,
 		dbResourceCacheFactory:  dbResourceCacheFactory,
 		dbResourceConfigFactory: dbResourceConfigFactory,
+		checkRateLimiter:        checkRateLimiter,
 		clock:                   clock,
 	}
 }
@@ -85,6 +89,7 @@ func (f *imageResourceFetcherFactory) NewImageResourceFetcher(
 		resourceFactory:         resourceFactory,
 		dbResourceCacheFactory:  f.dbResourceCacheFactory,
 		dbResourceConfigFactory: f.dbResourceConfigFactory,
+		checkRateLimiter:        f.checkRateLimiter,
 		clock:                   f.clock,
 
 		worker:                worker,
@@ -102,6 +107,7 @@ type imageResourceFetcher struct {
 	resourceFactory         resource.ResourceFactory
 	dbResourceCacheFactory  db.ResourceCacheFactory
 	dbResourceConfigFactory db.ResourceConfigFactory
+	checkRateLimiter        db.CheckRateLimiter
 	clock                   clock.Clock
 
 	imageResource         worker.ImageResource
@@ -256,7 +262,12 @@ func (i *imageResourceFetcher) ensureVersionOfType(
 		return err
 	}
 
-	versions, err := checkResourceType.Check(context.TODO(), source, nil)
+	err = i.takeCheckToken(ctx, logger, resourceType.Type)
+	if err != nil {
+		return err
+	}
+
+	versions, err := checkResourceType.Check(context.TODO(), source, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -321,7 +332,12 @@ func (i *imageResourceFetcher) getLatestVersion(
 		return nil, err
 	}
 
-	versions, err := checkingResource.Check(context.TODO(), source, nil)
+	err = i.takeCheckToken(ctx, logger, i.imageResource.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := checkingResource.Check(context.TODO(), source, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -333,6 +349,20 @@ func (i *imageResourceFetcher) getLatestVersion(
 	return versions[0], nil
 }
 
+// takeCheckToken waits until the check rate limiter lets a check of the
+// resource type run, as image checks can't be deferred.
+func (i *imageResourceFetcher) takeCheckToken(ctx context.Context, logger lager.Logger, resourceType string) error {
+	err := db.WaitForCheckToken(ctx, i.clock, i.checkRateLimiter, i.teamID, resourceType, func(delay time.Duration) {
+		logger.Debug("waiting-for-check-token", lager.Data{"delay": delay.String()})
+	})
+	if err != nil {
+		logger.Error("failed-to-take-check-token", err)
+		return err
+	}
+
+	return nil
+}
+
 type readCloser struct {
 	io.Reader
 	io.Closer
@@ -36,6 +36,7 @@ var _ = Describe("Image", func() {
 	var fakeResourceFetcher *resourcefakes.FakeFetcher
 	var fakeResourceCacheFactory *dbfakes.FakeResourceCacheFactory
 	var fakeResourceConfigFactory *dbfakes.FakeResourceConfigFactory
+	var fakeCheckRateLimiter *dbfakes.FakeCheckRateLimiter
 	var fakeCreatingContainer *dbfakes.FakeCreatingContainer
 
 	var imageResourceFetcher image.ImageResourceFetcher
@@ -64,6 +65,8 @@ var _ = Describe("Image", func() {
 		fakeResourceFetcherFactory = new(resourcefakes.FakeFetcherFactory)
 		fakeResourceFetcher = new(resourcefakes.FakeFetcher)
 		fakeResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
+		fakeCheckRateLimiter = new(dbfakes.FakeCheckRateLimiter)
+		fakeCheckRateLimiter.TakeReturns(true, 0, nil)
 		fakeResourceFetcherFactory.FetcherForReturns(fakeResourceFetcher)
 		fakeCreatingContainer = new(dbfakes.FakeCreatingContainer)
 		fakeClock = fakeclock.NewFakeClock(time.Now())
@@ -116,6 +119,7 @@ var _ = Describe("Image", func() {
 			fakeResourceFetcherFactory,
 			fakeResourceCacheFactory,
 			fakeResourceConfigFactory,
+			fakeCheckRateLimiter,
 			fakeClock,
 		).NewImageResourceFetcher(
 			fakeWorker,
@@ -200,6 +204,13 @@ var _ = Describe("Image", func() {
 						Expect(fakeCheckResourceType.CheckCallCount()).To(Equal(1))
 					})
 
+					It("takes a check token for the resource type's type", func() {
+						Expect(fakeCheckRateLimiter.TakeCallCount()).To(BeNumerically(">=", 1))
+						actualTeamID, resourceType := fakeCheckRateLimiter.TakeArgsForCall(0)
+						Expect(actualTeamID).To(Equal(teamID))
+						Expect(resourceType).To(Equal("base-type"))
+					})
+
 					Context("when a version of the custom resource type is found", func() {
 						BeforeEach(func() {
 							fakeCheckResourceType.CheckReturns([]atc.Version{{"some": "version"}}, nil)
@@ -352,7 +363,7 @@ var _ = Describe("Image", func() {
 
 							It("ran 'check' with the right config", func() {
 								Expect(fakeCheckResource.CheckCallCount()).To(Equal(1))
-								_, checkSource, checkVersion := fakeCheckResource.CheckArgsForCall(0)
+								_, checkSource, checkVersion, _ := fakeCheckResource.CheckArgsForCall(0)
 								Expect(checkVersion).To(BeNil())
 								Expect(checkSource).To(Equal(atc.Source{"some": "super-secret-sauce"}))
 							})
@@ -438,6 +449,62 @@ var _ = Describe("Image", func() {
 				})
 			})
 
+			Context("when the image resource's type is over its rate limit", func() {
+				BeforeEach(func() {
+					fakeCheckResource.CheckReturns([]atc.Version{}, nil)
+
+					taken := make(chan bool, 2)
+					taken <- false
+					taken <- true
+					close(taken)
+
+					fakeCheckRateLimiter.TakeStub = func(int, string) (bool, time.Duration, error) {
+						if <-taken {
+							return true, 0, nil
+						}
+
+						// allow the sleep to continue
+						go fakeClock.WaitForWatcherAndIncrement(10 * time.Second)
+						return false, 10 * time.Second, nil
+					}
+				})
+
+				It("waits for a token before checking", func() {
+					Expect(fakeCheckRateLimiter.TakeCallCount()).To(Equal(2))
+					actualTeamID, resourceType := fakeCheckRateLimiter.TakeArgsForCall(1)
+					Expect(actualTeamID).To(Equal(teamID))
+					Expect(resourceType).To(Equal("docker"))
+
+					Expect(fakeCheckResource.CheckCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the context is done while waiting for a check token", func() {
+				BeforeEach(func() {
+					cancelledCtx, cancel := context.WithCancel(ctx)
+					cancel()
+					ctx = cancelledCtx
+
+					fakeCheckRateLimiter.TakeReturns(false, time.Minute, nil)
+				})
+
+				It("returns the context's error without checking", func() {
+					Expect(fetchErr).To(Equal(context.Canceled))
+					Expect(fakeCheckResource.CheckCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when taking a check token fails", func() {
+				BeforeEach(func() {
+					fakeCheckRateLimiter.TakeReturns(false, 0, errors.New("nope"))
+				})
+
+				It("returns the error without checking", func() {
+					Expect(fetchErr).To(MatchError("nope"))
+					Expect(fakeCheckResource.CheckCallCount()).To(BeZero())
+				})
+			})
+
 			Context("when check returns no versions", func() {
 				BeforeEach(func() {
 					fakeCheckResource.CheckReturns([]atc.Version{}, nil)
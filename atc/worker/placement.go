@@ -1,27 +1,97 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 )
 
+var ErrNoCandidateWorkers = errors.New("no candidate workers left after container placement")
+
 type ContainerPlacementStrategy interface {
 	Choose(lager.Logger, []Worker, ContainerSpec) (Worker, error)
 }
 
+//go:generate counterfeiter . ContainerPlacementFilter
+
+// ContainerPlacementFilter narrows down the workers on which a container may
+// be placed, returning the ones it prefers. Filters can be chained with
+// NewChainedPlacementStrategy, each operating on the workers preferred by the
+// previous one.
+type ContainerPlacementFilter interface {
+	Filter(lager.Logger, []Worker, ContainerSpec) ([]Worker, error)
+}
+
+// NewContainerPlacementStrategy chains the named strategies in the order
+// given.
+func NewContainerPlacementStrategy(names []string) (ContainerPlacementStrategy, error) {
+	filters := []ContainerPlacementFilter{}
+	for _, name := range names {
+		switch name {
+		case "volume-locality":
+			filters = append(filters, NewVolumeLocalityPlacementStrategy())
+		case "random":
+			filters = append(filters, NewRandomPlacementStrategy())
+		case "least-build-containers":
+			filters = append(filters, NewLeastBuildContainersPlacementStrategy())
+		case "fewest-active-tasks":
+			filters = append(filters, NewFewestActiveTasksPlacementStrategy())
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy: %s", name)
+		}
+	}
+
+	return NewChainedPlacementStrategy(filters...), nil
+}
+
+type ChainedPlacementStrategy struct {
+	filters []ContainerPlacementFilter
+	rand    *rand.Rand
+}
+
+func NewChainedPlacementStrategy(filters ...ContainerPlacementFilter) *ChainedPlacementStrategy {
+	return &ChainedPlacementStrategy{
+		filters: filters,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *ChainedPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := workers
+
+	for _, filter := range strategy.filters {
+		var err error
+		candidates, err = filter.Filter(logger, candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidateWorkers
+	}
+
+	return candidates[strategy.rand.Intn(len(candidates))], nil
+}
+
 type VolumeLocalityPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+func NewVolumeLocalityPlacementStrategy() *VolumeLocalityPlacementStrategy {
 	return &VolumeLocalityPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	return chooseRandomly(strategy.rand, strategy, logger, workers, spec)
+}
+
+func (strategy *VolumeLocalityPlacementStrategy) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -45,22 +115,24 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+	return workersByCount[highestCount], nil
 }
 
 type LeastBuildContainersPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewLeastBuildContainersPlacementStrategy() ContainerPlacementStrategy {
+func NewLeastBuildContainersPlacementStrategy() *LeastBuildContainersPlacementStrategy {
 	return &LeastBuildContainersPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
 }
 
 func (strategy *LeastBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	return chooseRandomly(strategy.rand, strategy, logger, workers, spec)
+}
+
+func (strategy *LeastBuildContainersPlacementStrategy) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	var minWork int
 	for i, w := range workers {
@@ -71,15 +143,51 @@ func (strategy *LeastBuildContainersPlacementStrategy) Choose(logger lager.Logge
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minWork], nil
+}
+
+// FewestActiveTasksPlacementStrategy prefers the workers running the fewest
+// task containers, as tracked in the database. Unlike
+// LeastBuildContainersPlacementStrategy, this disregards check and get/put
+// containers, which are comparatively cheap.
+type FewestActiveTasksPlacementStrategy struct {
+	rand *rand.Rand
+}
+
+func NewFewestActiveTasksPlacementStrategy() *FewestActiveTasksPlacementStrategy {
+	return &FewestActiveTasksPlacementStrategy{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (strategy *FewestActiveTasksPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	return chooseRandomly(strategy.rand, strategy, logger, workers, spec)
+}
+
+func (strategy *FewestActiveTasksPlacementStrategy) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	workersByTasks := map[int][]Worker{}
+	var minTasks int
+	for i, w := range workers {
+		tasks, err := w.ActiveTasks()
+		if err != nil {
+			logger.Error("failed-to-get-active-tasks", err, lager.Data{"worker": w.Name()})
+			return nil, err
+		}
+
+		workersByTasks[tasks] = append(workersByTasks[tasks], w)
+		if i == 0 || tasks < minTasks {
+			minTasks = tasks
+		}
+	}
+
+	return workersByTasks[minTasks], nil
 }
 
 type RandomPlacementStrategy struct {
 	rand *rand.Rand
 }
 
-func NewRandomPlacementStrategy() ContainerPlacementStrategy {
+func NewRandomPlacementStrategy() *RandomPlacementStrategy {
 	return &RandomPlacementStrategy{
 		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
 	}
@@ -88,3 +196,24 @@ func NewRandomPlacementStrategy() ContainerPlacementStrategy {
 func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
 	return workers[strategy.rand.Intn(len(workers))], nil
 }
+
+func (strategy *RandomPlacementStrategy) Filter(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	if len(workers) == 0 {
+		return workers, nil
+	}
+
+	return []Worker{workers[strategy.rand.Intn(len(workers))]}, nil
+}
+
+func chooseRandomly(r *rand.Rand, filter ContainerPlacementFilter, logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates, err := filter.Filter(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidateWorkers
+	}
+
+	return candidates[r.Intn(len(candidates))], nil
+}
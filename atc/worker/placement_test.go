@@ -1,6 +1,8 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/concourse/concourse/atc/worker"
@@ -299,3 +301,148 @@ var _ = Describe("RandomPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("FewestActiveTasksPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("fewest-active-tasks-placement-test")
+			strategy = NewFewestActiveTasksPlacementStrategy()
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{},
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(
+				logger,
+				workers,
+				spec,
+			)
+		})
+
+		Context("when one worker has the fewest active tasks", func() {
+			BeforeEach(func() {
+				compatibleWorker1.ActiveTasksReturns(3, nil)
+				compatibleWorker2.ActiveTasksReturns(1, nil)
+				compatibleWorker3.ActiveTasksReturns(2, nil)
+			})
+
+			It("picks that worker", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker2))
+			})
+		})
+
+		Context("when getting the active tasks fails", func() {
+			disaster := errors.New("nope")
+
+			BeforeEach(func() {
+				compatibleWorker2.ActiveTasksReturns(0, disaster)
+			})
+
+			It("returns the error", func() {
+				Expect(chooseErr).To(Equal(disaster))
+			})
+		})
+	})
+})
+
+var _ = Describe("ChainedPlacementStrategy", func() {
+	var (
+		filter1 *workerfakes.FakeContainerPlacementFilter
+		filter2 *workerfakes.FakeContainerPlacementFilter
+
+		worker1 *workerfakes.FakeWorker
+		worker2 *workerfakes.FakeWorker
+		worker3 *workerfakes.FakeWorker
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("chained-placement-test")
+
+		filter1 = new(workerfakes.FakeContainerPlacementFilter)
+		filter2 = new(workerfakes.FakeContainerPlacementFilter)
+
+		worker1 = new(workerfakes.FakeWorker)
+		worker2 = new(workerfakes.FakeWorker)
+		worker3 = new(workerfakes.FakeWorker)
+
+		workers = []Worker{worker1, worker2, worker3}
+
+		strategy = NewChainedPlacementStrategy(filter1, filter2)
+	})
+
+	JustBeforeEach(func() {
+		chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+	})
+
+	Context("when the filters leave a single worker", func() {
+		BeforeEach(func() {
+			filter1.FilterReturns([]Worker{worker2, worker3}, nil)
+			filter2.FilterReturns([]Worker{worker3}, nil)
+		})
+
+		It("applies each filter to the workers left by the previous one", func() {
+			_, filterWorkers, _ := filter1.FilterArgsForCall(0)
+			Expect(filterWorkers).To(Equal(workers))
+
+			_, filterWorkers, _ = filter2.FilterArgsForCall(0)
+			Expect(filterWorkers).To(Equal([]Worker{worker2, worker3}))
+		})
+
+		It("picks that worker", func() {
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(worker3))
+		})
+	})
+
+	Context("when a filter leaves no workers", func() {
+		BeforeEach(func() {
+			filter1.FilterReturns([]Worker{}, nil)
+			filter2.FilterReturns([]Worker{}, nil)
+		})
+
+		It("returns ErrNoCandidateWorkers", func() {
+			Expect(chooseErr).To(Equal(ErrNoCandidateWorkers))
+		})
+	})
+
+	Context("when a filter fails", func() {
+		disaster := errors.New("nope")
+
+		BeforeEach(func() {
+			filter1.FilterReturns(nil, disaster)
+		})
+
+		It("returns the error without applying the rest", func() {
+			Expect(chooseErr).To(Equal(disaster))
+			Expect(filter2.FilterCallCount()).To(BeZero())
+		})
+	})
+})
+
+var _ = Describe("NewContainerPlacementStrategy", func() {
+	It("accepts a chain of known strategies", func() {
+		_, err := NewContainerPlacementStrategy([]string{"fewest-active-tasks", "volume-locality", "random"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("rejects unknown strategies", func() {
+		_, err := NewContainerPlacementStrategy([]string{"bogus"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -42,6 +42,7 @@ type WorkerProvider interface {
 var (
 	ErrNoWorkers       = errors.New("no workers")
 	ErrNoGlobalWorkers = errors.New("no global workers available")
+	ErrAllWorkersBusy  = errors.New("all workers are running the maximum number of active tasks")
 )
 
 type NoCompatibleWorkersError struct {
@@ -116,16 +117,15 @@ func (pool *pool) Satisfying(logger lager.Logger, spec WorkerSpec) (Worker, erro
 	return randomWorker, nil
 }
 
-func (pool *pool) FindOrCreateContainer(
-	ctx context.Context,
+// FindOrChooseWorker returns the worker already running a container for the
+// owner, or otherwise chooses one of the compatible workers using the
+// placement strategy.
+func (pool *pool) FindOrChooseWorker(
 	logger lager.Logger,
-	delegate ImageFetchingDelegate,
 	owner db.ContainerOwner,
-	metadata db.ContainerMetadata,
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
-	resourceTypes creds.VersionedResourceTypes,
-) (Container, error) {
+) (Worker, error) {
 	worker, found, err := pool.provider.FindWorkerForContainerByOwner(
 		logger.Session("find-worker"),
 		workerSpec.TeamID,
@@ -135,16 +135,59 @@ func (pool *pool) FindOrCreateContainer(
 		return nil, err
 	}
 
-	if !found {
-		compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
+	if found {
+		return worker, nil
+	}
+
+	compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if workerSpec.MaxActiveTasks > 0 {
+		compatibleWorkers, err = pool.withTaskCapacity(logger, compatibleWorkers, workerSpec.MaxActiveTasks)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		worker, err = pool.strategy.Choose(logger, compatibleWorkers, containerSpec)
+	return pool.strategy.Choose(logger, compatibleWorkers, containerSpec)
+}
+
+func (pool *pool) withTaskCapacity(logger lager.Logger, workers []Worker, maxActiveTasks int) ([]Worker, error) {
+	available := []Worker{}
+	for _, worker := range workers {
+		activeTasks, err := worker.ActiveTasks()
 		if err != nil {
+			logger.Error("failed-to-get-active-tasks", err, lager.Data{"worker": worker.Name()})
 			return nil, err
 		}
+
+		if activeTasks < maxActiveTasks {
+			available = append(available, worker)
+		}
+	}
+
+	if len(available) == 0 {
+		return nil, ErrAllWorkersBusy
+	}
+
+	return available, nil
+}
+
+func (pool *pool) FindOrCreateContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	delegate ImageFetchingDelegate,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+	resourceTypes creds.VersionedResourceTypes,
+) (Container, error) {
+	worker, err := pool.FindOrChooseWorker(logger, owner, containerSpec, workerSpec)
+	if err != nil {
+		return nil, err
 	}
 
 	return worker.FindOrCreateContainer(
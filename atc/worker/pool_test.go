@@ -400,6 +400,37 @@ var _ = Describe("Pool", func() {
 						}))
 					})
 				})
+
+				Context("when the active tasks are limited", func() {
+					BeforeEach(func() {
+						workerSpec.MaxActiveTasks = 2
+					})
+
+					Context("when some workers are below the limit", func() {
+						BeforeEach(func() {
+							workerA.ActiveTasksReturns(2, nil)
+							workerB.ActiveTasksReturns(1, nil)
+							fakeStrategy.ChooseReturns(workerB, nil)
+						})
+
+						It("only considers the workers below the limit", func() {
+							_, satisfyingWorkers, _ := fakeStrategy.ChooseArgsForCall(0)
+							Expect(satisfyingWorkers).To(ConsistOf(workerB))
+						})
+					})
+
+					Context("when every worker is at the limit", func() {
+						BeforeEach(func() {
+							workerA.ActiveTasksReturns(2, nil)
+							workerB.ActiveTasksReturns(3, nil)
+						})
+
+						It("returns ErrAllWorkersBusy", func() {
+							Expect(createErr).To(Equal(ErrAllWorkersBusy))
+							Expect(fakeStrategy.ChooseCallCount()).To(BeZero())
+						})
+					})
+				})
 			})
 
 			Context("when team workers and general workers satisfy the spec", func() {
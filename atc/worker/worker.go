@@ -34,6 +34,12 @@ type Worker interface {
 	ActiveVolumes() int
 	BuildContainers() int
 
+	HasContainer(lager.Logger, db.ContainerOwner) (bool, error)
+
+	ActiveTasks() (int, error)
+	IncreaseActiveTasks(max int) (bool, error)
+	DecreaseActiveTasks() error
+
 	Description() string
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
@@ -59,6 +65,8 @@ type gardenWorker struct {
 
 	clock clock.Clock
 
+	dbWorker db.Worker
+
 	activeContainers int
 	activeVolumes    int
 	buildContainers  int
@@ -92,6 +100,7 @@ func NewGardenWorker(
 		containerProvider:  containerProvider,
 
 		clock:            clock,
+		dbWorker:         dbWorker,
 		activeContainers: dbWorker.ActiveContainers(),
 		activeVolumes:    dbWorker.ActiveVolumes(),
 		buildContainers:  numBuildContainers,
@@ -190,6 +199,15 @@ func (worker *gardenWorker) FindOrCreateContainer(
 	)
 }
 
+func (worker *gardenWorker) FindOrChooseWorker(
+	logger lager.Logger,
+	owner db.ContainerOwner,
+	containerSpec ContainerSpec,
+	workerSpec WorkerSpec,
+) (Worker, error) {
+	return worker.Satisfying(logger, workerSpec)
+}
+
 func (worker *gardenWorker) FindContainerByHandle(logger lager.Logger, teamID int, handle string) (Container, bool, error) {
 	return worker.containerProvider.FindCreatedContainerByHandle(logger, handle, teamID)
 }
@@ -206,6 +224,30 @@ func (worker *gardenWorker) BuildContainers() int {
 	return worker.buildContainers
 }
 
+// HasContainer returns whether the worker has a container for the owner,
+// whether or not it's done being created.
+func (worker *gardenWorker) HasContainer(logger lager.Logger, owner db.ContainerOwner) (bool, error) {
+	creatingContainer, createdContainer, err := worker.dbWorker.FindContainerOnWorker(owner)
+	if err != nil {
+		logger.Error("failed-to-find-container-on-worker", err)
+		return false, err
+	}
+
+	return creatingContainer != nil || createdContainer != nil, nil
+}
+
+func (worker *gardenWorker) ActiveTasks() (int, error) {
+	return worker.dbWorker.ActiveTasks()
+}
+
+func (worker *gardenWorker) IncreaseActiveTasks(max int) (bool, error) {
+	return worker.dbWorker.IncreaseActiveTasks(max)
+}
+
+func (worker *gardenWorker) DecreaseActiveTasks() error {
+	return worker.dbWorker.DecreaseActiveTasks()
+}
+
 func (worker *gardenWorker) Satisfying(logger lager.Logger, spec WorkerSpec) (Worker, error) {
 	if spec.TeamID != worker.teamID && worker.teamID != 0 {
 		return nil, ErrTeamMismatch
@@ -11,6 +11,7 @@ import (
 	"github.com/concourse/baggageclaim/baggageclaimfakes"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	wfakes "github.com/concourse/concourse/atc/worker/workerfakes"
@@ -39,6 +40,7 @@ var _ = Describe("Worker", func() {
 		workerVersion          string
 		fakeGardenClient       *gardenfakes.FakeClient
 		fakeBaggageClaimClient *baggageclaimfakes.FakeClient
+		fakeDBWorker           *dbfakes.FakeWorker
 	)
 
 	BeforeEach(func() {
@@ -65,10 +67,11 @@ var _ = Describe("Worker", func() {
 		fakeContainerProvider = new(wfakes.FakeContainerProvider)
 		fakeGardenClient = new(gardenfakes.FakeClient)
 		fakeBaggageClaimClient = new(baggageclaimfakes.FakeClient)
+		fakeDBWorker = new(dbfakes.FakeWorker)
 	})
 
 	JustBeforeEach(func() {
-		dbWorker := new(dbfakes.FakeWorker)
+		dbWorker := fakeDBWorker
 		dbWorker.ActiveContainersReturns(activeContainers)
 		dbWorker.ResourceTypesReturns(resourceTypes)
 		dbWorker.PlatformReturns(platform)
@@ -191,6 +194,49 @@ var _ = Describe("Worker", func() {
 
 	})
 
+	Describe("HasContainer", func() {
+		var (
+			owner    db.ContainerOwner
+			found    bool
+			checkErr error
+		)
+
+		BeforeEach(func() {
+			owner = db.NewBuildStepContainerOwner(1, "some-plan", 42)
+		})
+
+		JustBeforeEach(func() {
+			found, checkErr = gardenWorker.HasContainer(logger, owner)
+		})
+
+		It("looks for the owner's container on the worker", func() {
+			Expect(fakeDBWorker.FindContainerOnWorkerCallCount()).To(Equal(1))
+			Expect(fakeDBWorker.FindContainerOnWorkerArgsForCall(0)).To(Equal(owner))
+			Expect(checkErr).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the container is being created", func() {
+			BeforeEach(func() {
+				fakeDBWorker.FindContainerOnWorkerReturns(new(dbfakes.FakeCreatingContainer), nil, nil)
+			})
+
+			It("finds it", func() {
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the container has been created", func() {
+			BeforeEach(func() {
+				fakeDBWorker.FindContainerOnWorkerReturns(nil, new(dbfakes.FakeCreatedContainer), nil)
+			})
+
+			It("finds it", func() {
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("Satisfying", func() {
 		var (
 			spec WorkerSpec
@@ -30,6 +30,22 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	FindOrChooseWorkerStub        func(lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec) (worker.Worker, error)
+	findOrChooseWorkerMutex       sync.RWMutex
+	findOrChooseWorkerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 db.ContainerOwner
+		arg3 worker.ContainerSpec
+		arg4 worker.WorkerSpec
+	}
+	findOrChooseWorkerReturns struct {
+		result1 worker.Worker
+		result2 error
+	}
+	findOrChooseWorkerReturnsOnCall map[int]struct {
+		result1 worker.Worker
+		result2 error
+	}
 	FindOrCreateContainerStub        func(context.Context, lager.Logger, worker.ImageFetchingDelegate, db.ContainerOwner, db.ContainerMetadata, worker.ContainerSpec, worker.WorkerSpec, creds.VersionedResourceTypes) (worker.Container, error)
 	findOrCreateContainerMutex       sync.RWMutex
 	findOrCreateContainerArgsForCall []struct {
@@ -165,6 +181,72 @@ func (fake *FakeClient) FindContainerByHandleReturnsOnCall(i int, result1 worker
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) FindOrChooseWorker(arg1 lager.Logger, arg2 db.ContainerOwner, arg3 worker.ContainerSpec, arg4 worker.WorkerSpec) (worker.Worker, error) {
+	fake.findOrChooseWorkerMutex.Lock()
+	ret, specificReturn := fake.findOrChooseWorkerReturnsOnCall[len(fake.findOrChooseWorkerArgsForCall)]
+	fake.findOrChooseWorkerArgsForCall = append(fake.findOrChooseWorkerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 db.ContainerOwner
+		arg3 worker.ContainerSpec
+		arg4 worker.WorkerSpec
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("FindOrChooseWorker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.findOrChooseWorkerMutex.Unlock()
+	if fake.FindOrChooseWorkerStub != nil {
+		return fake.FindOrChooseWorkerStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findOrChooseWorkerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) FindOrChooseWorkerCallCount() int {
+	fake.findOrChooseWorkerMutex.RLock()
+	defer fake.findOrChooseWorkerMutex.RUnlock()
+	return len(fake.findOrChooseWorkerArgsForCall)
+}
+
+func (fake *FakeClient) FindOrChooseWorkerCalls(stub func(lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec) (worker.Worker, error)) {
+	fake.findOrChooseWorkerMutex.Lock()
+	defer fake.findOrChooseWorkerMutex.Unlock()
+	fake.FindOrChooseWorkerStub = stub
+}
+
+func (fake *FakeClient) FindOrChooseWorkerArgsForCall(i int) (lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec) {
+	fake.findOrChooseWorkerMutex.RLock()
+	defer fake.findOrChooseWorkerMutex.RUnlock()
+	argsForCall := fake.findOrChooseWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) FindOrChooseWorkerReturns(result1 worker.Worker, result2 error) {
+	fake.findOrChooseWorkerMutex.Lock()
+	defer fake.findOrChooseWorkerMutex.Unlock()
+	fake.FindOrChooseWorkerStub = nil
+	fake.findOrChooseWorkerReturns = struct {
+		result1 worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) FindOrChooseWorkerReturnsOnCall(i int, result1 worker.Worker, result2 error) {
+	fake.findOrChooseWorkerMutex.Lock()
+	defer fake.findOrChooseWorkerMutex.Unlock()
+	fake.FindOrChooseWorkerStub = nil
+	if fake.findOrChooseWorkerReturnsOnCall == nil {
+		fake.findOrChooseWorkerReturnsOnCall = make(map[int]struct {
+			result1 worker.Worker
+			result2 error
+		})
+	}
+	fake.findOrChooseWorkerReturnsOnCall[i] = struct {
+		result1 worker.Worker
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindOrCreateContainer(arg1 context.Context, arg2 lager.Logger, arg3 worker.ImageFetchingDelegate, arg4 db.ContainerOwner, arg5 db.ContainerMetadata, arg6 worker.ContainerSpec, arg7 worker.WorkerSpec, arg8 creds.VersionedResourceTypes) (worker.Container, error) {
 	fake.findOrCreateContainerMutex.Lock()
 	ret, specificReturn := fake.findOrCreateContainerReturnsOnCall[len(fake.findOrCreateContainerArgsForCall)]
@@ -434,6 +516,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
 	defer fake.findContainerByHandleMutex.RUnlock()
+	fake.findOrChooseWorkerMutex.RLock()
+	defer fake.findOrChooseWorkerMutex.RUnlock()
 	fake.findOrCreateContainerMutex.RLock()
 	defer fake.findOrCreateContainerMutex.RUnlock()
 	fake.findResourceTypeByPathMutex.RLock()
@@ -39,6 +39,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// unauthenticated / delegating to handler
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.ReceiveGlobalWebhook,
 			atc.GetInfo,
 			atc.ListTeams,
 			atc.ListAllPipelines,
@@ -56,11 +57,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
-			atc.BuildEvents:
+			atc.BuildEvents,
+			atc.BuildTestResults:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 		// resource belongs to authorized team
 		case atc.AbortBuild,
+			atc.CommentBuild,
 			atc.SendInputToBuildPlan,
 			atc.ReadOutputFromBuildPlan:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
@@ -84,6 +87,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
+			atc.SearchJobLogs,
+			atc.ListFlakyTests,
+			atc.SearchPipelineLogs,
 			atc.GetResource,
 			atc.ListBuildsWithVersionAsInput,
 			atc.ListBuildsWithVersionAsOutput,
@@ -91,6 +97,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.GetResourceVersion,
 			atc.ListResources,
 			atc.ListResourceTypes,
+			atc.ListResourceChecks,
 			atc.ListResourceVersions:
 			newHandler = wrappa.checkPipelineAccessHandlerFactory.HandlerFor(handler, rejector)
 
@@ -119,6 +126,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.CheckResource,
 			atc.CheckResourceType,
 			atc.CreateJobBuild,
+			atc.RerunJobBuild,
 			atc.CreatePipelineBuild,
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
@@ -134,6 +142,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenamePipeline,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
+			atc.ArchivePipeline,
 			atc.ExposePipeline,
 			atc.HidePipeline,
 			atc.SaveConfig,
@@ -144,6 +144,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetInfo:              unauthenticated(inputHandlers[atc.GetInfo]),
 				atc.DownloadCLI:          unauthenticated(inputHandlers[atc.DownloadCLI]),
 				atc.CheckResourceWebHook: unauthenticated(inputHandlers[atc.CheckResourceWebHook]),
+				atc.ReceiveGlobalWebhook: unauthenticated(inputHandlers[atc.ReceiveGlobalWebhook]),
 				atc.ListAllPipelines:     unauthenticated(inputHandlers[atc.ListAllPipelines]),
 				atc.ListBuilds:           unauthenticated(inputHandlers[atc.ListBuilds]),
 				atc.ListPipelines:        unauthenticated(inputHandlers[atc.ListPipelines]),
@@ -160,9 +161,11 @@ var _ = Describe("APIAuthWrappa", func() {
 				// authorized or public pipeline and public job
 				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
+				atc.BuildTestResults:    checksIfPrivateJob(inputHandlers[atc.BuildTestResults]),
 
 				// resource belongs to authorized team
 				atc.AbortBuild:              checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.CommentBuild:            checkWritePermissionForBuild(inputHandlers[atc.CommentBuild]),
 				atc.SendInputToBuildPlan:    checkWritePermissionForBuild(inputHandlers[atc.SendInputToBuildPlan]),
 				atc.ReadOutputFromBuildPlan: checkWritePermissionForBuild(inputHandlers[atc.ReadOutputFromBuildPlan]),
 
@@ -183,6 +186,9 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListJobs:                      openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobs]),
 				atc.GetJob:                        openForPublicPipelineOrAuthorized(inputHandlers[atc.GetJob]),
 				atc.ListJobBuilds:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListJobBuilds]),
+				atc.SearchJobLogs:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.SearchJobLogs]),
+				atc.ListFlakyTests:                openForPublicPipelineOrAuthorized(inputHandlers[atc.ListFlakyTests]),
+				atc.SearchPipelineLogs:            openForPublicPipelineOrAuthorized(inputHandlers[atc.SearchPipelineLogs]),
 				atc.ListPipelineBuilds:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListPipelineBuilds]),
 				atc.GetResource:                   openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResource]),
 				atc.ListBuildsWithVersionAsInput:  openForPublicPipelineOrAuthorized(inputHandlers[atc.ListBuildsWithVersionAsInput]),
@@ -190,6 +196,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListResources:                 openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResources]),
 				atc.ListResourceTypes:             openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceTypes]),
 				atc.ListResourceVersions:          openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceVersions]),
+				atc.ListResourceChecks:            openForPublicPipelineOrAuthorized(inputHandlers[atc.ListResourceChecks]),
 				atc.GetResourceCausality:          openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceCausality]),
 				atc.GetResourceVersion:            openForPublicPipelineOrAuthorized(inputHandlers[atc.GetResourceVersion]),
 
@@ -217,6 +224,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.CheckResource:          authorized(inputHandlers[atc.CheckResource]),
 				atc.CheckResourceType:      authorized(inputHandlers[atc.CheckResourceType]),
 				atc.CreateJobBuild:         authorized(inputHandlers[atc.CreateJobBuild]),
+				atc.RerunJobBuild:          authorized(inputHandlers[atc.RerunJobBuild]),
 				atc.DeletePipeline:         authorized(inputHandlers[atc.DeletePipeline]),
 				atc.DisableResourceVersion: authorized(inputHandlers[atc.DisableResourceVersion]),
 				atc.EnableResourceVersion:  authorized(inputHandlers[atc.EnableResourceVersion]),
@@ -232,6 +240,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.SaveConfig:             authorized(inputHandlers[atc.SaveConfig]),
 				atc.UnpauseJob:             authorized(inputHandlers[atc.UnpauseJob]),
 				atc.UnpausePipeline:        authorized(inputHandlers[atc.UnpausePipeline]),
+				atc.ArchivePipeline:        authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.ExposePipeline:         authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:           authorized(inputHandlers[atc.HidePipeline]),
 				atc.CreatePipelineBuild:    authorized(inputHandlers[atc.CreatePipelineBuild]),
@@ -10,8 +10,9 @@ import (
 )
 
 type AbortBuildCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
-	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of a job to cancel"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Build       string                         `short:"b" long:"build" required:"true" description:"If job is specified: build number to cancel. If job not specified: build id"`
 }
 
 func (command *AbortBuildCommand) Execute([]string) error {
@@ -30,7 +31,7 @@ func (command *AbortBuildCommand) Execute([]string) error {
 	if command.Job.PipelineName == "" && command.Job.JobName == "" {
 		build, exists, err = target.Client().Build(command.Build)
 	} else {
-		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.Build)
 	}
 	if err != nil {
 		return err
@@ -0,0 +1,62 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/vito/go-interact/interact"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+)
+
+type ArchivePipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag       `short:"p"  long:"pipeline" required:"true" description:"Pipeline to archive"`
+	InstanceVar     []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	SkipInteractive bool                           `short:"n"  long:"non-interactive"          description:"Archive the pipeline without confirmation"`
+}
+
+func (command *ArchivePipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *ArchivePipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref(command.InstanceVar)
+	fmt.Printf("!!! archiving the pipeline `%s` will stop all of its jobs and checks\n\n", pipelineRef.String())
+
+	confirm := command.SkipInteractive
+	if !confirm {
+		err := interact.NewInteraction("are you sure?").Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return err
+		}
+	}
+
+	found, err := target.Team().ArchivePipeline(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		fmt.Printf("`%s` does not exist\n", pipelineRef.String())
+	} else {
+		fmt.Printf("archived '%s'\n", pipelineRef.String())
+	}
+
+	return nil
+}
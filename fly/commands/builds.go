@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -20,15 +22,18 @@ const timeDateLayout = "2006-01-02@15:04:05-0700"
 const inputTimeLayout = "2006-01-02 15:04:05"
 
 type BuildsCommand struct {
-	AllTeams    bool                     `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
-	Count       int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
-	CurrentTeam bool                     `long:"current-team" description:"Show builds for the currently targeted team"`
-	Job         flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
-	Json        bool                     `long:"json" description:"Print command result as JSON"`
-	Pipeline    flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
-	Teams       []string                 `short:"t"  long:"team" description:"Show builds for these teams"`
-	Since       string                   `long:"since" description:"Start of the range to filter builds"`
-	Until       string                   `long:"until" description:"End of the range to filter builds"`
+	AllTeams    bool                           `short:"a" long:"all-teams" description:"Show builds for the all teams that user has access to"`
+	Annotations bool                           `long:"annotations" description:"Show the annotations tasks attached to each build"`
+	Count       int                            `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	CurrentTeam bool                           `long:"current-team" description:"Show builds for the currently targeted team"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance of the pipeline or job (can be specified multiple times)"`
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
+	Pipeline    flaghelpers.PipelineFlag       `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
+	Teams       []string                       `short:"t"  long:"team" description:"Show builds for these teams"`
+	Tests       bool                           `long:"tests" description:"Show a summary of the test results reported by each build"`
+	Since       string                         `long:"since" description:"Start of the range to filter builds"`
+	Until       string                         `long:"until" description:"End of the range to filter builds"`
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -99,7 +104,7 @@ func (command *BuildsCommand) Execute([]string) error {
 
 		var found bool
 		builds, _, found, err = currentTeam.PipelineBuilds(
-			string(command.Pipeline),
+			command.Pipeline.Ref(command.InstanceVar),
 			page,
 		)
 		if err != nil {
@@ -112,7 +117,7 @@ func (command *BuildsCommand) Execute([]string) error {
 	} else if command.jobFlag() {
 		var found bool
 		builds, _, found, err = currentTeam.JobBuilds(
-			command.Job.PipelineName,
+			command.Job.PipelineRef(command.InstanceVar),
 			command.Job.JobName,
 			page,
 		)
@@ -177,6 +182,14 @@ func (command *BuildsCommand) Execute([]string) error {
 		},
 	}
 
+	if command.Annotations {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: "annotations", Color: color.New(color.Bold)})
+	}
+
+	if command.Tests {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: "tests", Color: color.New(color.Bold)})
+	}
+
 	var rangeUntil int
 	if command.Count < len(builds) {
 		rangeUntil = command.Count
@@ -192,7 +205,7 @@ func (command *BuildsCommand) Execute([]string) error {
 			pipelineJobCell.Contents = "one-off"
 			buildCell.Contents = "n/a"
 		} else {
-			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", b.PipelineName, b.JobName)
+			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", b.PipelineRef(), b.JobName)
 			buildCell.Contents = b.Name
 		}
 
@@ -216,7 +229,7 @@ func (command *BuildsCommand) Execute([]string) error {
 			statusCell.Color = ui.PausedColor
 		}
 
-		table.Data = append(table.Data, []ui.TableCell{
+		row := ui.TableRow{
 			{Contents: strconv.Itoa(b.ID)},
 			pipelineJobCell,
 			buildCell,
@@ -225,7 +238,17 @@ func (command *BuildsCommand) Execute([]string) error {
 			endTimeCell,
 			durationCell,
 			{Contents: b.TeamName},
-		})
+		}
+
+		if command.Annotations {
+			row = append(row, annotationsCell(b.Annotations))
+		}
+
+		if command.Tests {
+			row = append(row, testSummaryCell(b.TestSummary))
+		}
+
+		table.Data = append(table.Data, row)
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
@@ -272,3 +295,50 @@ func (command *BuildsCommand) jobFlag() bool {
 func (command *BuildsCommand) pipelineFlag() bool {
 	return command.Pipeline != ""
 }
+
+func annotationsCell(annotations map[string]string) ui.TableCell {
+	if len(annotations) == 0 {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	keys := make([]string, 0, len(annotations))
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = key + "=" + annotations[key]
+	}
+
+	return ui.TableCell{Contents: strings.Join(pairs, ", ")}
+}
+
+func testSummaryCell(summary *atc.TestSummary) ui.TableCell {
+	if summary == nil {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	parts := []string{fmt.Sprintf("%d passed", summary.Passed)}
+
+	if summary.Failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", summary.Failed))
+	}
+
+	if summary.Errored > 0 {
+		parts = append(parts, fmt.Sprintf("%d errored", summary.Errored))
+	}
+
+	if summary.Skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", summary.Skipped))
+	}
+
+	cell := ui.TableCell{Contents: strings.Join(parts, ", ")}
+	if summary.Failed > 0 || summary.Errored > 0 {
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
@@ -9,8 +9,9 @@ import (
 )
 
 type CheckResourceCommand struct {
-	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to check version for"`
-	Version  *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
+	Resource    flaghelpers.ResourceFlag       `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to check version for"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Version     *atc.Version                   `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource to check from, e.g. ref:abcd or path:thing-1.2.3.tgz"`
 }
 
 func (command *CheckResourceCommand) Execute(args []string) error {
@@ -29,13 +30,13 @@ func (command *CheckResourceCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResource(command.Resource.PipelineName, command.Resource.ResourceName, version)
+	found, err := target.Team().CheckResource(command.Resource.PipelineRef(command.InstanceVar), command.Resource.ResourceName, version)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineName, command.Resource.ResourceName)
+		return fmt.Errorf("pipeline '%s' or resource '%s' not found\n", command.Resource.PipelineRef(command.InstanceVar), command.Resource.ResourceName)
 	}
 
 	fmt.Printf("checked '%s'\n", command.Resource.ResourceName)
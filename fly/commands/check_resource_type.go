@@ -9,8 +9,9 @@ import (
 )
 
 type CheckResourceTypeCommand struct {
-	ResourceType flaghelpers.ResourceFlag `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of a resource-type to check"`
-	Version      *atc.Version             `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource type to check from, e.g. digest:sha256@..."`
+	ResourceType flaghelpers.ResourceFlag       `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of a resource-type to check"`
+	InstanceVar  []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Version      *atc.Version                   `short:"f" long:"from"                     value-name:"VERSION"           description:"Version of the resource type to check from, e.g. digest:sha256@..."`
 }
 
 func (command *CheckResourceTypeCommand) Execute(args []string) error {
@@ -29,13 +30,13 @@ func (command *CheckResourceTypeCommand) Execute(args []string) error {
 		version = *command.Version
 	}
 
-	found, err := target.Team().CheckResourceType(command.ResourceType.PipelineName, command.ResourceType.ResourceName, version)
+	found, err := target.Team().CheckResourceType(command.ResourceType.PipelineRef(command.InstanceVar), command.ResourceType.ResourceName, version)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("pipeline '%s' or resource-type '%s' not found\n", command.ResourceType.PipelineName, command.ResourceType.ResourceName)
+		return fmt.Errorf("pipeline '%s' or resource-type '%s' not found\n", command.ResourceType.PipelineRef(command.InstanceVar), command.ResourceType.ResourceName)
 	}
 
 	fmt.Printf("checked '%s'\n", command.ResourceType.ResourceName)
@@ -10,7 +10,8 @@ import (
 )
 
 type ChecklistCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"The pipeline from which to generate the Checkfile"`
+	Pipeline    flaghelpers.PipelineFlag       `short:"p" long:"pipeline" required:"true" description:"The pipeline from which to generate the Checkfile"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
 }
 
 func (command *ChecklistCommand) Validate() error {
@@ -33,14 +34,14 @@ func (command *ChecklistCommand) Execute([]string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref(command.InstanceVar)
 
-	config, _, _, _, err := target.Team().PipelineConfig(pipelineName)
+	config, _, _, _, err := target.Team().PipelineConfig(pipelineRef)
 	if err != nil {
 		return err
 	}
 
-	printCheckfile(target.Team().Name(), pipelineName, config, target.Client().URL())
+	printCheckfile(target.Team().Name(), pipelineRef.Name, config, target.Client().URL())
 
 	return nil
 }
@@ -0,0 +1,132 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ChecksCommand struct {
+	Resource    flaghelpers.ResourceFlag       `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to list the checks of"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Check       int                            `long:"check" value-name:"ID" description:"Print the error and stderr of the check with this ID"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ChecksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	checks, found, err := target.Team().ListResourceChecks(command.Resource.PipelineRef(command.InstanceVar), command.Resource.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline/resource not found")
+	}
+
+	if command.Check != 0 {
+		for _, check := range checks {
+			if check.ID == command.Check {
+				return command.printCheck(check)
+			}
+		}
+
+		displayhelpers.Failf("check %d not found", command.Check)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(checks)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "start", Color: color.New(color.Bold)},
+			{Contents: "end", Color: color.New(color.Bold)},
+			{Contents: "duration", Color: color.New(color.Bold)},
+			{Contents: "from", Color: color.New(color.Bold)},
+			{Contents: "new versions", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, check := range checks {
+		startTimeCell, endTimeCell, durationCell := populateTimeCells(time.Unix(check.StartTime, 0), time.Unix(check.EndTime, 0))
+
+		var statusCell ui.TableCell
+		statusCell.Contents = check.Status
+
+		switch check.Status {
+		case "pending":
+			statusCell.Color = ui.PendingColor
+		case "started":
+			statusCell.Color = ui.StartedColor
+		case "succeeded":
+			statusCell.Color = ui.SucceededColor
+		case "errored":
+			statusCell.Color = ui.ErroredColor
+		case "skipped":
+			statusCell.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(check.ID)},
+			statusCell,
+			startTimeCell,
+			endTimeCell,
+			durationCell,
+			versionCell(check.FromVersion),
+			{Contents: strconv.Itoa(check.NewVersions)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *ChecksCommand) printCheck(check atc.Check) error {
+	if command.Json {
+		return displayhelpers.JsonPrint(check)
+	}
+
+	if check.CheckError != "" {
+		fmt.Println(ui.ErroredColor.Sprint(check.CheckError))
+	}
+
+	fmt.Print(check.Stderr)
+
+	return nil
+}
+
+func versionCell(version atc.Version) ui.TableCell {
+	if len(version) == 0 {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	fields := []string{}
+	for k, v := range version {
+		fields = append(fields, k+":"+v)
+	}
+
+	sort.Strings(fields)
+
+	return ui.TableCell{Contents: strings.Join(fields, ",")}
+}
@@ -9,10 +9,11 @@ import (
 )
 
 type ClearTaskCacheCommand struct {
-	Job             flaghelpers.JobFlag `short:"j" long:"job"  required:"true"  description:"Job to clear cache from"`
-	StepName        string              `short:"s" long:"step"  required:"true" description:"Step name to clear cache from"`
-	CachePath       string              `short:"c" long:"cache-path"  default:"" description:"Cache directory to clear out"`
-	SkipInteractive bool                `short:"n"  long:"non-interactive"          description:"Destroy the task cache(s) without confirmation"`
+	Job             flaghelpers.JobFlag            `short:"j" long:"job"  required:"true"  description:"Job to clear cache from"`
+	InstanceVar     []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	StepName        string                         `short:"s" long:"step"  required:"true" description:"Step name to clear cache from"`
+	CachePath       string                         `short:"c" long:"cache-path"  default:"" description:"Cache directory to clear out"`
+	SkipInteractive bool                           `short:"n"  long:"non-interactive"          description:"Destroy the task cache(s) without confirmation"`
 }
 
 func (command *ClearTaskCacheCommand) Execute([]string) error {
@@ -27,7 +28,7 @@ func (command *ClearTaskCacheCommand) Execute([]string) error {
 	}
 
 	warningMsg := fmt.Sprintf("!!! this will remove the task cache(s) for `%s/%s`, task step `%s`",
-		command.Job.PipelineName, command.Job.JobName, command.StepName)
+		command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.StepName)
 	if len(command.CachePath) > 0 {
 		warningMsg += fmt.Sprintf(", at `%s`", command.CachePath)
 	}
@@ -43,7 +44,7 @@ func (command *ClearTaskCacheCommand) Execute([]string) error {
 		}
 	}
 
-	numRemoved, err := target.Team().ClearTaskCache(command.Job.PipelineName, command.Job.JobName, command.StepName, command.CachePath)
+	numRemoved, err := target.Team().ClearTaskCache(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.StepName, command.CachePath)
 
 	if err != nil {
 		fmt.Println(err.Error())
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CommentBuildCommand struct {
+	Job         flaghelpers.JobFlag            `short:"j" long:"job"     value-name:"PIPELINE/JOB"   description:"Name of a job to comment on"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Build       string                         `short:"b" long:"build"   required:"true" description:"If job is specified: build number to comment on. If job not specified: build id"`
+	Message     string                         `short:"m" long:"message" required:"true" description:"Comment to leave on the build, e.g. why it was rerun or aborted"`
+}
+
+func (command *CommentBuildCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineName == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	if _, err := target.Client().CommentBuild(strconv.Itoa(build.ID), command.Message); err != nil {
+		return err
+	}
+
+	fmt.Println("comment added to build")
+	return nil
+}
@@ -27,6 +27,7 @@ type ExecuteCommand struct {
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
 	InputMappings  []flaghelpers.VariablePairFlag     `short:"m" long:"input-mapping"       value-name:"[NAME=STRING]"    description:"Map a resource to a different name as task input"`
 	InputsFrom     flaghelpers.JobFlag                `short:"j" long:"inputs-from" value-name:"PIPELINE/JOB" description:"A job to base the inputs on"`
+	InstanceVar    []flaghelpers.VariablePairFlag     `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance of --inputs-from (can be specified multiple times)"`
 	Outputs        []flaghelpers.OutputPairFlag       `short:"o" long:"output"      value-name:"NAME=PATH"    description:"An output to fetch from the task (can be specified multiple times)"`
 	Image          string                             `long:"image" description:"Image resource for the one-off build"`
 	Tags           []string                           `          long:"tag"         value-name:"TAG"          description:"A tag for a specific environment (can be specified multiple times)"`
@@ -72,6 +73,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		inputMappings,
 		command.Image,
 		command.InputsFrom,
+		command.InstanceVar,
 	)
 	if err != nil {
 		return err
@@ -114,7 +116,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var buildURL *url.URL
 
 	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineRef(command.InstanceVar), plan)
 		if err != nil {
 			return err
 		}
@@ -124,6 +126,7 @@ func (command *ExecuteCommand) Execute(args []string) error {
 			return err
 		}
 
+		buildURL.RawQuery = build.PipelineRef().QueryParams().Encode()
 	} else {
 		build, err = target.Team().CreateBuild(plan)
 		if err != nil {
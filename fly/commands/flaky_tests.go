@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type FlakyTestsCommand struct {
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job whose tests to check"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Builds      int                            `short:"b" long:"builds" description:"Number of recent builds to consider (default: decided by the server)"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *FlakyTestsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	flakyTests, found, err := target.Team().FlakyTests(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.Builds)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline/job not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(flakyTests)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "suite", Color: color.New(color.Bold)},
+			{Contents: "test", Color: color.New(color.Bold)},
+			{Contents: "passed", Color: color.New(color.Bold)},
+			{Contents: "failed", Color: color.New(color.Bold)},
+			{Contents: "failure rate", Color: color.New(color.Bold)},
+			{Contents: "last failed build", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, flakyTest := range flakyTests {
+		failureRate := float64(flakyTest.Failed) / float64(flakyTest.Passed+flakyTest.Failed) * 100
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: flakyTest.Suite},
+			{Contents: flakyTest.Name},
+			{Contents: strconv.Itoa(flakyTest.Passed)},
+			{Contents: strconv.Itoa(flakyTest.Failed), Color: ui.FailedColor},
+			{Contents: fmt.Sprintf("%.0f%%", failureRate)},
+			{Contents: flakyTest.LastFailedBuild},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
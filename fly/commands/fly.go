@@ -42,6 +42,7 @@ type FlyCommand struct {
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	UnpausePipeline  UnpausePipelineCommand  `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
+	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	ExposePipeline   ExposePipelineCommand   `command:"expose-pipeline"     alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline     HidePipelineCommand     `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline   RenamePipelineCommand   `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
@@ -52,13 +53,18 @@ type FlyCommand struct {
 	Resources        ResourcesCommand        `command:"resources"           alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions ResourceVersionsCommand `command:"resource-versions"   alias:"rvs"  description:"List the versions of a resource"`
 	CheckResource    CheckResourceCommand    `command:"check-resource"      alias:"cr"   description:"Check a resource"`
+	Checks           ChecksCommand           `command:"checks"              alias:"cks"  description:"List the recent checks of a resource"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
+	Builds       BuildsCommand       `command:"builds"      alias:"bs" description:"List builds data"`
+	AbortBuild   AbortBuildCommand   `command:"abort-build" alias:"ab" description:"Abort a build"`
+	CommentBuild CommentBuildCommand `command:"comment-build" alias:"cb" description:"Leave a comment on a build"`
+	RerunBuild   RerunBuildCommand   `command:"rerun-build" alias:"rb" description:"Rerun a build with the same input versions"`
+	SearchLogs   SearchLogsCommand   `command:"search-logs" alias:"sl" description:"Search the logs of a job's or pipeline's builds"`
+	FlakyTests   FlakyTestsCommand   `command:"flaky-tests" alias:"ft" description:"List the tests that both passed and failed in a job's recent builds"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
@@ -17,8 +17,9 @@ import (
 )
 
 type GetPipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
-	JSON     bool                     `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
+	Pipeline    flaghelpers.PipelineFlag       `short:"p" long:"pipeline" required:"true" description:"Get configuration of this pipeline"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	JSON        bool                           `short:"j" long:"json"                     description:"Print config as json instead of yaml"`
 }
 
 func (command *GetPipelineCommand) Validate() error {
@@ -32,7 +33,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 	}
 
 	asJSON := command.JSON
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref(command.InstanceVar)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -44,7 +45,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	config, rawConfig, _, _, err := target.Team().PipelineConfig(pipelineName)
+	config, rawConfig, _, _, err := target.Team().PipelineConfig(pipelineRef)
 	if err != nil {
 		if _, ok := err.(concourse.PipelineConfigError); ok {
 			_ = dumpRawConfig(rawConfig, asJSON)
@@ -20,7 +20,7 @@ var _ = Describe("Helper Functions", func() {
 		expectedBuildID := "123"
 		expectedBuildName := "5"
 		expectedJobName := "myjob"
-		expectedPipelineName := "mypipeline"
+		expectedPipelineRef := atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
 		expectedBuild := atc.Build{
 			ID:      123,
 			Name:    expectedBuildName,
@@ -41,7 +41,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns the build", func() {
-					build, err := GetBuild(client, nil, "", expectedBuildID, "")
+					build, err := GetBuild(client, nil, "", expectedBuildID, atc.PipelineRef{})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(build).To(Equal(expectedBuild))
 					Expect(client.BuildCallCount()).To(Equal(1))
@@ -55,7 +55,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns an error", func() {
-					_, err := GetBuild(client, nil, "", expectedBuildID, "")
+					_, err := GetBuild(client, nil, "", expectedBuildID, atc.PipelineRef{})
 					Expect(err).To(MatchError("build not found"))
 				})
 			})
@@ -73,12 +73,12 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns the next build for that job", func() {
-						build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+						build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(build).To(Equal(expectedBuild))
 						Expect(team.JobCallCount()).To(Equal(1))
-						pipelineName, jobName := team.JobArgsForCall(0)
-						Expect(pipelineName).To(Equal(expectedPipelineName))
+						pipelineRef, jobName := team.JobArgsForCall(0)
+						Expect(pipelineRef).To(Equal(expectedPipelineRef))
 						Expect(jobName).To(Equal(expectedJobName))
 					})
 				})
@@ -93,12 +93,12 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns the finished build for that job", func() {
-						build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+						build, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 						Expect(err).NotTo(HaveOccurred())
 						Expect(build).To(Equal(expectedBuild))
 						Expect(team.JobCallCount()).To(Equal(1))
-						pipelineName, jobName := team.JobArgsForCall(0)
-						Expect(pipelineName).To(Equal(expectedPipelineName))
+						pipelineRef, jobName := team.JobArgsForCall(0)
+						Expect(pipelineRef).To(Equal(expectedPipelineRef))
 						Expect(jobName).To(Equal(expectedJobName))
 					})
 				})
@@ -112,7 +112,7 @@ var _ = Describe("Helper Functions", func() {
 					})
 
 					It("returns an error", func() {
-						_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+						_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 						Expect(err).To(HaveOccurred())
 					})
 				})
@@ -124,7 +124,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns an error", func() {
-					_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineName)
+					_, err := GetBuild(client, team, expectedJobName, "", expectedPipelineRef)
 					Expect(err).To(MatchError("job not found"))
 				})
 			})
@@ -137,12 +137,12 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns the build", func() {
-					build, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineName)
+					build, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineRef)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(build).To(Equal(expectedBuild))
 					Expect(team.JobBuildCallCount()).To(Equal(1))
-					pipelineName, jobName, buildName := team.JobBuildArgsForCall(0)
-					Expect(pipelineName).To(Equal(expectedPipelineName))
+					pipelineRef, jobName, buildName := team.JobBuildArgsForCall(0)
+					Expect(pipelineRef).To(Equal(expectedPipelineRef))
 					Expect(buildName).To(Equal(expectedBuildName))
 					Expect(jobName).To(Equal(expectedJobName))
 				})
@@ -154,7 +154,7 @@ var _ = Describe("Helper Functions", func() {
 				})
 
 				It("returns an error", func() {
-					_, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineName)
+					_, err := GetBuild(client, team, expectedJobName, expectedBuildName, expectedPipelineRef)
 					Expect(err).To(MatchError("build not found"))
 				})
 			})
@@ -207,7 +207,7 @@ var _ = Describe("Helper Functions", func() {
 			})
 
 			It("returns latest one off build", func() {
-				build, err := GetBuild(client, nil, "", "", "")
+				build, err := GetBuild(client, nil, "", "", atc.PipelineRef{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(build).To(Equal(expectedOneOffBuild))
 				Expect(client.BuildsCallCount()).To(Equal(2))
@@ -26,6 +26,7 @@ func DetermineInputs(
 	jobInputMappings map[string]string,
 	jobInputImage string,
 	inputsFrom flaghelpers.JobFlag,
+	inputsFromInstanceVars []flaghelpers.VariablePairFlag,
 ) ([]Input, *atc.ImageResource, error) {
 	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
 	if err != nil {
@@ -54,7 +55,7 @@ func DetermineInputs(
 		return nil, nil, err
 	}
 
-	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, jobInputImage)
+	inputsFromJob, imageResourceFromJob, err := FetchInputsFromJob(fact, team, inputsFrom, inputsFromInstanceVars, jobInputImage)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -146,29 +147,31 @@ func GenerateLocalInputs(fact atc.PlanFactory, inputMappings []flaghelpers.Input
 	return kvMap, nil
 }
 
-func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, imageName string) (map[string]Input, *atc.ImageResource, error) {
+func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom flaghelpers.JobFlag, instanceVars []flaghelpers.VariablePairFlag, imageName string) (map[string]Input, *atc.ImageResource, error) {
 	kvMap := map[string]Input{}
 
 	if inputsFrom.PipelineName == "" && inputsFrom.JobName == "" {
 		return kvMap, nil, nil
 	}
 
-	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	pipelineRef := inputsFrom.PipelineRef(instanceVars)
+
+	buildInputs, found, err := team.BuildInputsForJob(pipelineRef, inputsFrom.JobName)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
+		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", pipelineRef, inputsFrom.JobName)
 	}
 
-	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineName)
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineName)
+		return nil, nil, fmt.Errorf("versioned resource types of %s not found", pipelineRef)
 	}
 
 	var imageResource *atc.ImageResource
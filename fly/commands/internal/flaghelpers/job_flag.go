@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 	"github.com/jessevdk/go-flags"
 
@@ -37,6 +38,12 @@ func (job *JobFlag) UnmarshalFlag(value string) error {
 	return nil
 }
 
+// PipelineRef identifies the instance of the job's pipeline with the given
+// instance vars, e.g. as given by --instance-var flags.
+func (job JobFlag) PipelineRef(instanceVars []VariablePairFlag) atc.PipelineRef {
+	return PipelineFlag(job.PipelineName).Ref(instanceVars)
+}
+
 func (flag *JobFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -66,7 +73,7 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 			}
 		}
 	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
+		jobs, err := team.ListJobs(atc.PipelineRef{Name: vs[0]})
 		if err != nil {
 			return comps
 		}
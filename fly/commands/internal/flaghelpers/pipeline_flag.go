@@ -6,6 +6,7 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
@@ -18,6 +19,21 @@ func (flag *PipelineFlag) Validate() error {
 	return nil
 }
 
+// Ref identifies the instance of the pipeline with the given instance vars,
+// e.g. as given by --instance-var flags.
+func (flag PipelineFlag) Ref(instanceVars []VariablePairFlag) atc.PipelineRef {
+	ref := atc.PipelineRef{Name: string(flag)}
+
+	if len(instanceVars) > 0 {
+		ref.InstanceVars = atc.InstanceVars{}
+		for _, pair := range instanceVars {
+			ref.InstanceVars[pair.Name] = pair.Value
+		}
+	}
+
+	return ref
+}
+
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
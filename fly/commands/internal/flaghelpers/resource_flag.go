@@ -4,6 +4,7 @@ import (
 	"errors"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
@@ -32,3 +33,9 @@ func (resource *ResourceFlag) UnmarshalFlag(value string) error {
 
 	return nil
 }
+
+// PipelineRef identifies the instance of the resource's pipeline with the
+// given instance vars, e.g. as given by --instance-var flags.
+func (resource ResourceFlag) PipelineRef(instanceVars []VariablePairFlag) atc.PipelineRef {
+	return PipelineFlag(resource.PipelineName).Ref(instanceVars)
+}
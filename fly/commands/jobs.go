@@ -5,18 +5,20 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type JobsCommand struct {
-	Pipeline string `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool   `long:"json" description:"Print command result as JSON"`
+	Pipeline    string                         `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *JobsCommand) Execute([]string) error {
-	pipelineName := command.Pipeline
+	pipelineRef := flaghelpers.PipelineFlag(command.Pipeline).Ref(command.InstanceVar)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -31,7 +33,7 @@ func (command *JobsCommand) Execute([]string) error {
 	var headers []string
 	var jobs []atc.Job
 
-	jobs, err = target.Team().ListJobs(pipelineName)
+	jobs, err = target.Team().ListJobs(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -9,7 +9,8 @@ import (
 )
 
 type PausePipelineCommand struct {
-	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline" required:"true" description:"Pipeline to pause"`
+	Pipeline    flaghelpers.PipelineFlag       `short:"p"  long:"pipeline" required:"true" description:"Pipeline to pause"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
 }
 
 func (command *PausePipelineCommand) Validate() error {
@@ -22,7 +23,7 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref(command.InstanceVar)
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -34,15 +35,15 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().PausePipeline(pipelineName)
+	found, err := target.Team().PausePipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
 
 	if found {
-		fmt.Printf("paused '%s'\n", pipelineName)
+		fmt.Printf("paused '%s'\n", pipelineRef.String())
 	} else {
-		displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 	}
 
 	return nil
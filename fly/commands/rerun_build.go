@@ -0,0 +1,41 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RerunBuildCommand struct {
+	Job         flaghelpers.JobFlag            `short:"j" long:"job"   required:"true" value-name:"PIPELINE/JOB" description:"Name of the job whose build to rerun"`
+	Build       string                         `short:"b" long:"build" required:"true" description:"Number of the build to rerun with the same input versions"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Watch       bool                           `short:"w" long:"watch" description:"Start watching the build output"`
+}
+
+func (command *RerunBuildCommand) Execute(args []string) error {
+	pipelineRef, jobName := command.Job.PipelineRef(command.InstanceVar), command.Job.JobName
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	build, err := target.Team().RerunJobBuild(pipelineRef, jobName, command.Build)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("started %s/%s #%s (rerun of #%s)\n", pipelineRef, jobName, build.Name, command.Build)
+
+	if command.Watch {
+		return watchJobBuild(target, command.Job, command.InstanceVar, build)
+	}
+
+	return nil
+}
@@ -15,9 +15,10 @@ import (
 )
 
 type ResourceVersionsCommand struct {
-	Count    int                      `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
-	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+	Count       int                            `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
+	Resource    flaghelpers.ResourceFlag       `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -35,7 +36,7 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 
 	team := target.Team()
 
-	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineName, command.Resource.ResourceName, page)
+	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineRef(command.InstanceVar), command.Resource.ResourceName, page)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,120 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job whose builds to search"`
+	Pipeline    flaghelpers.PipelineFlag       `short:"p" long:"pipeline" description:"Name of a pipeline whose builds to search"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance of the pipeline or job (can be specified multiple times)"`
+	Query       string                         `short:"q" long:"query" required:"true" description:"Text to search the build logs for"`
+	Regex       bool                           `short:"r" long:"regex" description:"Treat the query as a regular expression"`
+	Count       int                            `short:"c" long:"count" default:"50" description:"Number of builds to search"`
+	Since       int                            `long:"since" description:"Only search builds with an ID lower than this one"`
+	Json        bool                           `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	if command.Job.JobName == "" && command.Pipeline == "" {
+		return errors.New("Must specify either --pipeline or --job")
+	}
+
+	if command.Job.JobName != "" && command.Pipeline != "" {
+		return errors.New("Cannot specify both --pipeline and --job")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	page := concourse.Page{
+		Since: command.Since,
+		Limit: command.Count,
+	}
+
+	var results []atc.BuildLogSearchResult
+	var found bool
+
+	if command.Pipeline != "" {
+		err = command.Pipeline.Validate()
+		if err != nil {
+			return err
+		}
+
+		results, _, found, err = target.Team().SearchPipelineLogs(command.Pipeline.Ref(command.InstanceVar), command.Query, command.Regex, page)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			displayhelpers.Failf("pipeline not found")
+		}
+	} else {
+		results, _, found, err = target.Team().SearchJobLogs(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName, command.Query, command.Regex, page)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			displayhelpers.Failf("pipeline/job not found")
+		}
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(results)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "pipeline/job", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "step", Color: color.New(color.Bold)},
+			{Contents: "line", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, result := range results {
+		for _, match := range result.Matches {
+			table.Data = append(table.Data, []ui.TableCell{
+				{Contents: strconv.Itoa(result.Build.ID)},
+				{Contents: fmt.Sprintf("%s/%s", result.Build.PipelineRef(), result.Build.JobName)},
+				{Contents: result.Build.Name},
+				{Contents: match.Origin.ID},
+				{Contents: match.Line},
+			})
+		}
+	}
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if len(results) > 0 && results[len(results)-1].Truncated {
+		last := results[len(results)-1].Build
+
+		fmt.Fprintf(ui.Stderr, "\nthe search stopped partway through build %d after reading as many events as the server allows\n", last.ID)
+		fmt.Fprintf(ui.Stderr, "run again with --since %d to search the older builds\n", last.ID)
+	}
+
+	return nil
+}
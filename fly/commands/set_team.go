@@ -24,9 +24,10 @@ func WireTeamConnectors(command *flags.Command) {
 }
 
 type SetTeamCommand struct {
-	TeamName        string               `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
-	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
-	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	TeamName               string               `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
+	SkipInteractive        bool                 `long:"non-interactive" description:"Force apply configuration"`
+	DisableSecretRedaction bool                 `long:"disable-secret-redaction" description:"Show credential manager secrets in the team's build logs rather than redacting them"`
+	AuthFlags              skycmd.AuthTeamFlags `group:"Authentication"`
 }
 
 func (command *SetTeamCommand) Execute([]string) error {
@@ -78,6 +79,10 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	}
 
+	if command.DisableSecretRedaction {
+		fmt.Println("\nSecret redaction: disabled")
+	}
+
 	confirm := true
 	if !command.SkipInteractive {
 		confirm = false
@@ -91,7 +96,10 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: atc.TeamAuth(authRoles)}
+	team := atc.Team{
+		Auth:                   atc.TeamAuth(authRoles),
+		DisableSecretRedaction: command.DisableSecretRedaction,
+	}
 
 	_, created, updated, err := target.Client().Team(command.TeamName).CreateOrUpdate(team)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
@@ -13,12 +14,13 @@ import (
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Watch       bool                           `short:"w" long:"watch" description:"Start watching the build output"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineRef, jobName := command.Job.PipelineRef(command.InstanceVar), command.Job.JobName
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -30,39 +32,52 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().CreateJobBuild(pipelineName, jobName)
+	build, err := target.Team().CreateJobBuild(pipelineRef, jobName)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("started %s/%s #%s\n", pipelineName, jobName, build.Name)
+	fmt.Printf("started %s/%s #%s\n", pipelineRef, jobName, build.Name)
 
 	if command.Watch {
-		terminate := make(chan os.Signal, 1)
-
-		go func(terminate <-chan os.Signal) {
-			<-terminate
-			fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
-			fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
-			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineName, jobName, build.Name)))
-			os.Exit(2)
-		}(terminate)
+		return watchJobBuild(target, command.Job, command.InstanceVar, build)
+	}
 
-		signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+	return nil
+}
 
-		fmt.Println("")
-		eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
-		if err != nil {
-			return err
-		}
+// watchJobBuild renders the build's events until it finishes, exiting with
+// the build's exit code, or detaches from it on interrupt.
+func watchJobBuild(target rc.Target, job flaghelpers.JobFlag, instanceVars []flaghelpers.VariablePairFlag, build atc.Build) error {
+	watchCommand := fmt.Sprintf("fly -t %s watch -j %s/%s", Fly.Target, job.PipelineName, job.JobName)
+	for _, pair := range instanceVars {
+		watchCommand += fmt.Sprintf(" --instance-var %s=%s", pair.Name, pair.Value)
+	}
 
-		renderOptions := eventstream.RenderOptions{}
+	terminate := make(chan os.Signal, 1)
 
-		exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
+	go func(terminate <-chan os.Signal) {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
+		fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
+		fmt.Fprintf(ui.Stderr, "    %s\n\n", ui.Embolden("%s -b %s", watchCommand, build.Name))
+		os.Exit(2)
+	}(terminate)
 
-		eventSource.Close()
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
 
-		os.Exit(exitCode)
+	fmt.Println("")
+	eventSource, err := target.Client().BuildEvents(fmt.Sprintf("%d", build.ID))
+	if err != nil {
+		return err
 	}
 
+	renderOptions := eventstream.RenderOptions{}
+
+	exitCode := eventstream.Render(os.Stdout, eventSource, renderOptions)
+
+	eventSource.Close()
+
+	os.Exit(exitCode)
+
 	return nil
 }
@@ -8,7 +8,8 @@ import (
 )
 
 type UnpauseJobCommand struct {
-	Job flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to unpause"`
+	Job         flaghelpers.JobFlag            `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to unpause"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
 }
 
 func (command *UnpauseJobCommand) Execute(args []string) error {
@@ -22,13 +23,13 @@ func (command *UnpauseJobCommand) Execute(args []string) error {
 		return err
 	}
 
-	found, err := target.Team().UnpauseJob(command.Job.PipelineName, command.Job.JobName)
+	found, err := target.Team().UnpauseJob(command.Job.PipelineRef(command.InstanceVar), command.Job.JobName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineName, command.Job.JobName)
+		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineRef(command.InstanceVar), command.Job.JobName)
 	}
 
 	fmt.Printf("unpaused '%s'\n", command.Job.JobName)
@@ -8,12 +8,15 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/eventstream"
 	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
 type WatchCommand struct {
-	Job       flaghelpers.JobFlag `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
-	Build     string              `short:"b" long:"build"                                  description:"Watches a specific build"`
-	Timestamp bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	Job         flaghelpers.JobFlag            `short:"j" long:"job"         value-name:"PIPELINE/JOB"  description:"Watches builds of the given job"`
+	InstanceVar []flaghelpers.VariablePairFlag `long:"instance-var" value-name:"[NAME=STRING]" description:"Instance var identifying the pipeline instance (can be specified multiple times)"`
+	Build       string                         `short:"b" long:"build"                                  description:"Watches a specific build"`
+	Timestamp   bool                           `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
+	WebSocket   bool                           `          long:"websocket"                              description:"Stream events over a WebSocket instead of Server-Sent Events"`
 }
 
 func (command *WatchCommand) Execute(args []string) error {
@@ -30,7 +33,7 @@ func (command *WatchCommand) Execute(args []string) error {
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" {
-		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineName)
+		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef(command.InstanceVar))
 		if err != nil {
 			return err
 		}
@@ -43,7 +46,12 @@ func (command *WatchCommand) Execute(args []string) error {
 		}
 	}
 
-	eventSource, err := client.BuildEvents(fmt.Sprintf("%d", buildId))
+	var eventSource concourse.Events
+	if command.WebSocket {
+		eventSource, err = client.BuildEventsOverWebSocket(fmt.Sprintf("%d", buildId))
+	} else {
+		eventSource, err = client.BuildEvents(fmt.Sprintf("%d", buildId))
+	}
 	if err != nil {
 		return err
 	}
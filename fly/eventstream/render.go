@@ -3,6 +3,7 @@ package eventstream
 import (
 	"fmt"
 	"io"
+	"sort"
 	"strings"
 
 	"github.com/concourse/concourse/atc/event"
@@ -45,6 +46,10 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			dstImpl.SetTimestamp(e.Time)
 			fmt.Fprintf(dstImpl, "\x1b[1minitializing\x1b[0m\n")
 
+		case event.WaitingForWorker:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1mwaiting for a worker with capacity to run the task\x1b[0m\n")
+
 		case event.StartTask:
 			buildConfig := e.TaskConfig
 
@@ -55,6 +60,23 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 		case event.FinishTask:
 			exitStatus = e.ExitStatus
 
+		case event.Annotations:
+			keys := make([]string, 0, len(e.Annotations))
+			for key := range e.Annotations {
+				keys = append(keys, key)
+			}
+
+			sort.Strings(keys)
+
+			dstImpl.SetTimestamp(e.Time)
+			for _, key := range keys {
+				fmt.Fprintf(dstImpl, "\x1b[1mannotated build with %s: %s\x1b[0m\n", key, e.Annotations[key])
+			}
+
+		case event.AcrossIteration:
+			dstImpl.SetTimestamp(e.Time)
+			fmt.Fprintf(dstImpl, "\x1b[1m%s: %v\x1b[0m\n", e.Var, e.Value)
+
 		case event.Error:
 			errCol := ui.ErroredColor.SprintFunc()
 			dstImpl.SetTimestamp(0)
@@ -93,7 +115,11 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 			}
 
 			printColorFunc := printColor.SprintFunc()
-			fmt.Fprintf(dstImpl, "%s\n", printColorFunc(e.Status))
+			if e.Reason != "" {
+				fmt.Fprintf(dstImpl, "%s (%s)\n", printColorFunc(e.Status), e.Reason)
+			} else {
+				fmt.Fprintf(dstImpl, "%s\n", printColorFunc(e.Status))
+			}
 
 			return exitStatus
 		}
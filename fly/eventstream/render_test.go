@@ -119,6 +119,52 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a WaitingForWorker event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForWorker{
+				Time: time.Now().Unix(),
+			}
+		})
+
+		It("prints that the task is waiting", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mwaiting for a worker with capacity to run the task\x1b[0m\n"))
+		})
+	})
+
+	Context("when an Annotations event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.Annotations{
+				Time: time.Now().Unix(),
+				Annotations: map[string]string{
+					"release":  "https://example.com/releases/1.2.3",
+					"coverage": "87.5",
+				},
+			}
+		})
+
+		It("prints each annotation in order", func() {
+			Expect(out.Contents()).To(ContainSubstring(
+				"\x1b[1mannotated build with coverage: 87.5\x1b[0m\n" +
+					"\x1b[1mannotated build with release: https://example.com/releases/1.2.3\x1b[0m\n",
+			))
+		})
+	})
+
+	Context("when an AcrossIteration event is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.AcrossIteration{
+				Time:  time.Now().Unix(),
+				Var:   "go_version",
+				Index: 1,
+				Value: "1.11",
+			}
+		})
+
+		It("prints the var and its value", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mgo_version: 1.11\x1b[0m\n"))
+		})
+	})
+
 	Context("and a StartTask event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.StartTask{
@@ -294,5 +340,23 @@ var _ = Describe("V1.0 Renderer", func() {
 				})
 			})
 		})
+
+		Context("with status 'aborted' because it timed out", func() {
+			BeforeEach(func() {
+				receivedEvents <- event.Status{
+					Status: atc.StatusAborted,
+					Time:   time.Now().Unix(),
+					Reason: atc.BuildTimedOut,
+				}
+			})
+
+			It("prints the reason", func() {
+				Expect(out.Contents()).To(ContainSubstring(ui.AbortedColor.SprintFunc()("aborted") + " (timed out)\n"))
+			})
+
+			It("exits 3", func() {
+				Expect(exitStatus).To(Equal(3))
+			})
+		})
 	})
 })
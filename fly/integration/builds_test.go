@@ -556,6 +556,141 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when passing the annotations argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--annotations")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = "limit=50"
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:           3,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "63",
+						Status:       "succeeded",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "main",
+						Annotations: map[string]string{
+							"release":  "https://example.com/releases/1.2.3",
+							"coverage": "87.5",
+						},
+					},
+					{
+						ID:           2,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "62",
+						Status:       "succeeded",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "main",
+					},
+				}
+			})
+
+			It("shows the annotations of each build", func() {
+				Eventually(session.Out).Should(PrintTable(ui.Table{
+					Headers: append(expectedHeaders, ui.TableCell{Contents: "annotations", Color: color.New(color.Bold)}),
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "63"},
+							{Contents: "succeeded"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "main"},
+							{Contents: "coverage=87.5, release=https://example.com/releases/1.2.3"},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "62"},
+							{Contents: "succeeded"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "main"},
+							{Contents: "none", Color: color.New(color.Faint)},
+						},
+					},
+				}))
+				Eventually(session).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when passing the tests argument", func() {
+			BeforeEach(func() {
+				cmdArgs = append(cmdArgs, "--tests")
+
+				expectedURL = "/api/v1/builds"
+				queryParams = "limit=50"
+				returnedStatusCode = http.StatusOK
+				returnedBuilds = []atc.Build{
+					{
+						ID:           3,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "63",
+						Status:       "failed",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "main",
+						TestSummary: &atc.TestSummary{
+							Passed:  120,
+							Failed:  2,
+							Skipped: 3,
+						},
+					},
+					{
+						ID:           2,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Name:         "62",
+						Status:       "succeeded",
+						StartTime:    succeededBuildStartTime.Unix(),
+						EndTime:      succeededBuildEndTime.Unix(),
+						TeamName:     "main",
+					},
+				}
+			})
+
+			It("shows the test summary of each build", func() {
+				Eventually(session.Out).Should(PrintTable(ui.Table{
+					Headers: append(expectedHeaders, ui.TableCell{Contents: "tests", Color: color.New(color.Bold)}),
+					Data: []ui.TableRow{
+						{
+							{Contents: "3"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "63"},
+							{Contents: "failed"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "main"},
+							{Contents: "120 passed, 2 failed, 3 skipped"},
+						},
+						{
+							{Contents: "2"},
+							{Contents: "some-pipeline/some-job"},
+							{Contents: "62"},
+							{Contents: "succeeded"},
+							{Contents: succeededBuildStartTime.Local().Format(timeDateLayout)},
+							{Contents: succeededBuildEndTime.Local().Format(timeDateLayout)},
+							{Contents: "1h15m0s"},
+							{Contents: "main"},
+							{Contents: "none", Color: color.New(color.Faint)},
+						},
+					},
+				}))
+				Eventually(session).Should(gexec.Exit(0))
+			})
+		})
+
 		Context("when passing teams argument", func() {
 
 			Context("when passing one team filter", func() {
@@ -0,0 +1,164 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("checks", func() {
+		var (
+			flyCmd *exec.Cmd
+			checks []atc.Check
+
+			startTime time.Time
+			endTime   time.Time
+		)
+
+		BeforeEach(func() {
+			startTime = time.Unix(1546900000, 0)
+			endTime = time.Unix(1546900005, 0)
+
+			checks = []atc.Check{
+				{
+					ID:         3,
+					Status:     "pending",
+					CreateTime: 1546900010,
+				},
+				{
+					ID:          2,
+					Status:      "succeeded",
+					CreateTime:  1546899999,
+					StartTime:   startTime.Unix(),
+					EndTime:     endTime.Unix(),
+					FromVersion: atc.Version{"ref": "v1", "branch": "master"},
+					NewVersions: 2,
+				},
+				{
+					ID:         1,
+					Status:     "errored",
+					CheckError: "exit status 1",
+					Stderr:     "some-stderr\n",
+				},
+			}
+		})
+
+		Context("when the resource is not given", func() {
+			It("fails", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("the required flag `-r, --resource' was not specified"))
+			})
+		})
+
+		Context("when the resource exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/checks"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, checks),
+					),
+				)
+			})
+
+			It("prints the checks", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-resource")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "end", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "from", Color: color.New(color.Bold)},
+						{Contents: "new versions", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "3"}, {Contents: "pending", Color: ui.PendingColor}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "0"}},
+						{{Contents: "2"}, {Contents: "succeeded", Color: ui.SucceededColor}, {Contents: startTime.Local().Format(timeDateLayout)}, {Contents: endTime.Local().Format(timeDateLayout)}, {Contents: "5s"}, {Contents: "branch:master,ref:v1"}, {Contents: "2"}},
+						{{Contents: "1"}, {Contents: "errored", Color: ui.ErroredColor}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "0"}},
+					},
+				}))
+			})
+
+			It("prints the checks as JSON", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-resource", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var printed []atc.Check
+				Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+				Expect(printed).To(Equal(checks))
+			})
+
+			Context("when a check is given", func() {
+				It("prints its error and stderr", func() {
+					flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-resource", "--check", "1")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say("exit status 1\n"))
+					Expect(sess.Out).To(gbytes.Say("some-stderr\n"))
+				})
+
+				Context("when the check is not one of the resource's", func() {
+					It("fails", func() {
+						flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-resource", "--check", "42")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gexec.Exit(1))
+						Expect(sess.Err).To(gbytes.Say("check 42 not found"))
+					})
+				})
+			})
+		})
+
+		Context("when the resource does not exist", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "checks", "-r", "some-pipeline/some-resource")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/resources/some-resource/checks"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline/resource not found"))
+			})
+		})
+	})
+})
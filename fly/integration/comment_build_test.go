@@ -0,0 +1,132 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("CommentBuild", func() {
+	var expectedCommentURL = "/api/v1/builds/23/comments"
+
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "failed",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/123",
+	}
+
+	var commentHandler http.HandlerFunc
+
+	BeforeEach(func() {
+		commentHandler = ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", expectedCommentURL),
+			ghttp.VerifyJSON(`{"author":"","comment":"rerunning after the outage","time":0}`),
+			ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.BuildComment{
+				Author:  "some-user",
+				Comment: "rerunning after the outage",
+				Time:    10,
+			}),
+		)
+	})
+
+	Context("when the job name is not specified", func() {
+		Context("and the build id is specified", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+					),
+					commentHandler,
+				)
+			})
+
+			It("comments on the build", func() {
+				Expect(func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "comment-build", "-b", "23", "-m", "rerunning after the outage")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(gbytes.Say("comment added to build"))
+				}).To(Change(func() int {
+					return len(atcServer.ReceivedRequests())
+				}).By(3))
+			})
+		})
+
+		Context("and the build id does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "comment-build", "-b", "42", "-m", "rerunning after the outage")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("error: build does not exist"))
+			})
+		})
+	})
+
+	Context("when the job name is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				commentHandler,
+			)
+		})
+
+		It("comments on the build", func() {
+			Expect(func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "comment-build", "-j", "my-pipeline/my-job", "-b", "42", "-m", "rerunning after the outage")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("comment added to build"))
+			}).To(Change(func() int {
+				return len(atcServer.ReceivedRequests())
+			}).By(3))
+		})
+	})
+
+	Context("when the message is not specified", func() {
+		It("asks the user to specify a message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "comment-build", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("m", "message") + "' was not specified"))
+		})
+	})
+})
@@ -0,0 +1,112 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("flaky-tests", func() {
+		var (
+			flyCmd     *exec.Cmd
+			flakyTests []atc.FlakyTest
+		)
+
+		BeforeEach(func() {
+			flakyTests = []atc.FlakyTest{
+				{Suite: "api.BuildsTest", Name: "aborts builds", Passed: 3, Failed: 1, LastFailedBuild: "41"},
+			}
+		})
+
+		Context("when the job is not given", func() {
+			It("fails", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "flaky-tests")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("the required flag `-j, --job' was not specified"))
+			})
+		})
+
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "flaky-tests", "-j", "some-pipeline/some-job", "-b", "30")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/flaky-tests", "builds=30"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, flakyTests),
+					),
+				)
+			})
+
+			It("prints the flaky tests", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "api.BuildsTest"}, {Contents: "aborts builds"}, {Contents: "3"}, {Contents: "1"}, {Contents: "25%"}, {Contents: "41"}},
+					},
+				}))
+			})
+		})
+
+		Context("when printing JSON", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "flaky-tests", "-j", "some-pipeline/some-job", "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/flaky-tests", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, flakyTests),
+					),
+				)
+			})
+
+			It("prints the flaky tests as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var printed []atc.FlakyTest
+				Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+				Expect(printed).To(Equal(flakyTests))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "flaky-tests", "-j", "some-pipeline/some-job")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/flaky-tests"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline/job not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		var (
+			flyCmd  *exec.Cmd
+			results []atc.BuildLogSearchResult
+		)
+
+		BeforeEach(func() {
+			results = []atc.BuildLogSearchResult{
+				{
+					Build: atc.Build{ID: 12, Name: "3", PipelineName: "some-pipeline", JobName: "some-job"},
+					Matches: []atc.BuildLogMatch{
+						{Line: "connection reset by peer", Origin: atc.BuildLogOrigin{ID: "some-step", Source: "stderr"}},
+					},
+				},
+			}
+		})
+
+		Context("when neither a pipeline nor a job is given", func() {
+			It("fails", func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-q", "connection reset")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Must specify either --pipeline or --job"))
+			})
+		})
+
+		Context("when searching a job", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-j", "some-pipeline/some-job", "-q", "connection reset", "-c", "500")
+			})
+
+			Context("and the job exists", func() {
+				JustBeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/logs", "limit=500&query=connection+reset"),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, results),
+						),
+					)
+				})
+
+				It("prints the matching lines", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(PrintTable(ui.Table{
+						Data: []ui.TableRow{
+							{{Contents: "12"}, {Contents: "some-pipeline/some-job"}, {Contents: "3"}, {Contents: "some-step"}, {Contents: "connection reset by peer"}},
+						},
+					}))
+				})
+
+				Context("and the search was truncated", func() {
+					BeforeEach(func() {
+						results[0].Truncated = true
+					})
+
+					It("says how to continue", func() {
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess).Should(gexec.Exit(0))
+						Expect(sess.Err).To(gbytes.Say("run again with --since 12"))
+					})
+				})
+			})
+
+			Context("and the job does not exist", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/logs"),
+							ghttp.RespondWith(http.StatusNotFound, ""),
+						),
+					)
+				})
+
+				It("fails", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("pipeline/job not found"))
+				})
+			})
+		})
+
+		Context("when searching a pipeline with a regex", func() {
+			BeforeEach(func() {
+				flyCmd = exec.Command(flyPath, "-t", targetName, "search-logs", "-p", "some-pipeline", "-q", "reset.*peer", "--regex", "--json")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/logs", "limit=50&query=reset.%2Apeer&regex=true"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, results),
+					),
+				)
+			})
+
+			It("prints the results as JSON", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var printed []atc.BuildLogSearchResult
+				Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+				Expect(printed).To(Equal(results))
+			})
+		})
+	})
+})
@@ -95,6 +95,31 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("Setting auth for a custom role", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_custom_role.yml"}
+				})
+
+				It("shows the users configured for the custom role", func() {
+					sess, err := gexec.Start(flyCmd, nil, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("Team Name: venture"))
+
+					Eventually(sess.Out).Should(gbytes.Say("Users \\(owner\\):"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-owner"))
+					Eventually(sess.Out).Should(gbytes.Say("Groups \\(owner\\):"))
+					Eventually(sess.Out).Should(gbytes.Say("- none"))
+
+					Eventually(sess.Out).Should(gbytes.Say("Users \\(pipeline-operator\\):"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-operator"))
+					Eventually(sess.Out).Should(gbytes.Say("Groups \\(pipeline-operator\\):"))
+					Eventually(sess.Out).Should(gbytes.Say("- none"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
 			Context("Setting github auth", func() {
 				BeforeEach(func() {
 					cmdParams = []string{"-c", "fixtures/team_config_with_github_auth.yml"}
@@ -356,6 +381,55 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending with secret redaction disabled", func() {
+			BeforeEach(func() {
+				cmdParams = []string{
+					"--local-user", "brock-obama",
+					"--disable-secret-redaction",
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": [
+										"local:brock-obama"
+									],
+									"groups": []
+								}
+							},
+							"disable_secret_redaction": true
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+
+							DisableSecretRedaction: true,
+						}),
+					),
+				)
+			})
+
+			It("sends the flag and shows it in the summary", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, nil, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("Secret redaction: disabled"))
+
+				Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
@@ -411,6 +485,34 @@ var _ = Describe("Fly CLI", func() {
 					Eventually(sess).Should(gexec.Exit(1))
 				})
 			})
+
+			Context("when the server rejects a role", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_custom_role.yml"}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.RespondWith(http.StatusBadRequest, "unknown role 'pipeline-operator'"),
+						),
+					)
+				})
+
+				It("reports the error", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, nil, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess.Err).Should(gbytes.Say("unknown role 'pipeline-operator'"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
 		})
 	})
 
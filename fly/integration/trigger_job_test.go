@@ -130,6 +130,29 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when instance vars are specified", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("POST", path, `vars=%7B%22branch%22%3A%22feature%22%7D`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+						),
+					)
+				})
+
+				It("starts a build of the job in the pipeline instance", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job", "--instance-var", "branch=feature")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/branch:feature/awesome-job #42`))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
 			Context("when the pipeline/job doesn't exist", func() {
 				BeforeEach(func() {
 					atcServer.AppendHandlers(
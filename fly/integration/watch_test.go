@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"os/exec"
 
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
@@ -123,6 +124,47 @@ var _ = Describe("Watching", func() {
 		})
 	})
 
+	Context("with --websocket", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/3/events"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var upgrader websocket.Upgrader
+						conn, err := upgrader.Upgrade(w, r, nil)
+						Expect(err).NotTo(HaveOccurred())
+
+						defer conn.Close()
+
+						close(streaming)
+
+						var id uint
+						for e := range events {
+							payload, err := json.Marshal(event.Message{Event: e})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.WriteJSON(event.WebSocketMessage{
+								ID:   id,
+								Name: "event",
+								Data: (*json.RawMessage)(&payload),
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							id++
+						}
+
+						err = conn.WriteJSON(event.WebSocketMessage{ID: id, Name: "end"})
+						Expect(err).NotTo(HaveOccurred())
+					},
+				),
+			)
+		})
+
+		It("watches the build over a WebSocket", func() {
+			watch("--build", "3", "--websocket")
+		})
+	})
+
 	Context("with a specific job and pipeline", func() {
 		Context("when the job has no builds", func() {
 			BeforeEach(func() {
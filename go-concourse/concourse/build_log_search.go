@@ -0,0 +1,62 @@
+package concourse
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) SearchJobLogs(pipelineRef atc.PipelineRef, jobName string, query string, regex bool, page Page) ([]atc.BuildLogSearchResult, Pagination, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	return team.searchLogs(atc.SearchJobLogs, pipelineRef, params, query, regex, page)
+}
+
+func (team *team) SearchPipelineLogs(pipelineRef atc.PipelineRef, query string, regex bool, page Page) ([]atc.BuildLogSearchResult, Pagination, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	return team.searchLogs(atc.SearchPipelineLogs, pipelineRef, params, query, regex, page)
+}
+
+func (team *team) searchLogs(requestName string, pipelineRef atc.PipelineRef, params rata.Params, query string, regex bool, page Page) ([]atc.BuildLogSearchResult, Pagination, bool, error) {
+	queryParams := page.QueryParams()
+	queryParams.Set(atc.BuildLogSearchQuery, query)
+
+	if regex {
+		queryParams.Set(atc.BuildLogSearchRegex, "true")
+	}
+
+	var results []atc.BuildLogSearchResult
+
+	headers := http.Header{}
+	err := team.connection.Send(internal.Request{
+		RequestName: requestName,
+		Params:      params,
+		Query:       pipelineQuery(pipelineRef, queryParams),
+	}, &internal.Response{
+		Result:  &results,
+		Headers: &headers,
+	})
+	switch err.(type) {
+	case nil:
+		pagination, err := paginationFromHeaders(headers)
+		if err != nil {
+			return results, Pagination{}, false, err
+		}
+
+		return results, pagination, true, nil
+	case internal.ResourceNotFoundError:
+		return results, Pagination{}, false, nil
+	default:
+		return results, Pagination{}, false, err
+	}
+}
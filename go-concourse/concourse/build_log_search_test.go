@@ -0,0 +1,85 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Build Log Search", func() {
+	var expectedResults []atc.BuildLogSearchResult
+
+	BeforeEach(func() {
+		expectedResults = []atc.BuildLogSearchResult{
+			{
+				Build: atc.Build{ID: 3, Name: "some-build"},
+				Matches: []atc.BuildLogMatch{
+					{Line: "some-line", Origin: atc.BuildLogOrigin{ID: "some-step", Source: "stdout"}},
+				},
+			},
+		}
+	})
+
+	Describe("SearchJobLogs", func() {
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				header := http.Header{}
+				header.Add("Link", `<http://some-url.com/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/logs?query=some-query&since=3&limit=2>; rel="next"`)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/logs", "limit=2&query=some-query&regex=true&since=5"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults, header),
+					),
+				)
+			})
+
+			It("returns the results and the next page", func() {
+				results, pagination, found, err := team.SearchJobLogs(atc.PipelineRef{Name: "some-pipeline"}, "some-job", "some-query", true, concourse.Page{Since: 5, Limit: 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(results).To(Equal(expectedResults))
+				Expect(pagination.Next).To(Equal(&concourse.Page{Since: 3, Limit: 2}))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/logs"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, _, found, err := team.SearchJobLogs(atc.PipelineRef{Name: "some-pipeline"}, "some-job", "some-query", false, concourse.Page{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("SearchPipelineLogs", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/logs", "query=some-query"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+				),
+			)
+		})
+
+		It("returns the results", func() {
+			results, _, found, err := team.SearchPipelineLogs(atc.PipelineRef{Name: "some-pipeline"}, "some-query", false, concourse.Page{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(results).To(Equal(expectedResults))
+		})
+	})
+})
@@ -90,7 +90,36 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 
 		It("takes a pipeline and a job and creates the build", func() {
-			build, err := team.CreateJobBuild(pipelineName, jobName)
+			build, err := team.CreateJobBuild(atc.PipelineRef{Name: pipelineName}, jobName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build).To(Equal(expectedBuild))
+		})
+	})
+
+	Describe("RerunJobBuild", func() {
+		var expectedBuild atc.Build
+
+		BeforeEach(func() {
+			expectedBuild = atc.Build{
+				ID:      124,
+				Name:    "43",
+				Status:  "pending",
+				JobName: "myjob",
+				APIURL:  "api/v1/builds/124",
+				RerunOf: &atc.RerunOfBuild{ID: 100, Name: "42"},
+			}
+			expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds/42"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+			)
+		})
+
+		It("takes a pipeline, a job and a build and reruns the build", func() {
+			build, err := team.RerunJobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "42")
 			Expect(err).NotTo(HaveOccurred())
 			Expect(build).To(Equal(expectedBuild))
 		})
@@ -123,7 +152,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("returns the given build", func() {
-				build, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				build, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(build).To(Equal(expectedBuild))
@@ -143,7 +172,7 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 
 			It("return false and no error", func() {
-				_, found, err := team.JobBuild("mypipeline", "myjob", "mybuild")
+				_, found, err := team.JobBuild(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mybuild")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -423,6 +452,42 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("CommentBuild", func() {
+		var (
+			buildComment atc.BuildComment
+			commentErr   error
+		)
+
+		BeforeEach(func() {
+			expectedURL := "/api/v1/builds/123/comments"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", expectedURL),
+					ghttp.VerifyJSON(`{"author":"","comment":"rerunning after the outage","time":0}`),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.BuildComment{
+						Author:  "some-user",
+						Comment: "rerunning after the outage",
+						Time:    10,
+					}),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			buildComment, commentErr = client.CommentBuild("123", "rerunning after the outage")
+		})
+
+		It("returns the saved comment", func() {
+			Expect(commentErr).NotTo(HaveOccurred())
+			Expect(buildComment).To(Equal(atc.BuildComment{
+				Author:  "some-user",
+				Comment: "rerunning after the outage",
+				Time:    10,
+			}))
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -19,9 +19,9 @@ func (checkResourceError CheckResourceError) Error() string {
 	return fmt.Sprintf("check failed with exit status '%d':\n%s\n", checkResourceError.ExitStatus, checkResourceError.Stderr)
 }
 
-func (team *team) CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error) {
+func (team *team) CheckResource(pipelineRef atc.PipelineRef, resourceName string, version atc.Version) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"resource_name": resourceName,
 		"team_name":     team.name,
 	}
@@ -36,6 +36,7 @@ func (team *team) CheckResource(pipelineName string, resourceName string, versio
 		ReturnResponseBody: true,
 		RequestName:        atc.CheckResource,
 		Params:             params,
+		Query:              pipelineRef.QueryParams(),
 		Body:               bytes.NewBuffer(jsonBytes),
 		Header:             http.Header{"Content-Type": []string{"application/json"}},
 	}, &response)
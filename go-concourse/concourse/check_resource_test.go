@@ -26,7 +26,7 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("sends check resource request to ATC", func() {
-			found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			found, err := team.CheckResource(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -47,7 +47,7 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("returns a ResourceNotFoundError", func() {
-			found, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			found, err := team.CheckResource(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -72,7 +72,7 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("returns an error", func() {
-			_, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, err := team.CheckResource(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.CheckResourceError)
@@ -95,7 +95,7 @@ var _ = Describe("CheckResource", func() {
 		})
 
 		It("returns an error with body", func() {
-			_, err := team.CheckResource("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, err := team.CheckResource(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.CheckResourceError)
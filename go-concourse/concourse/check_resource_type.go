@@ -10,9 +10,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error) {
+func (team *team) CheckResourceType(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (bool, error) {
 	params := rata.Params{
-		"pipeline_name":      pipelineName,
+		"pipeline_name":      pipelineRef.Name,
 		"resource_type_name": resourceTypeName,
 		"team_name":          team.name,
 	}
@@ -27,6 +27,7 @@ func (team *team) CheckResourceType(pipelineName string, resourceTypeName string
 		ReturnResponseBody: true,
 		RequestName:        atc.CheckResourceType,
 		Params:             params,
+		Query:              pipelineRef.QueryParams(),
 		Body:               bytes.NewBuffer(jsonBytes),
 		Header:             http.Header{"Content-Type": []string{"application/json"}},
 	}, &response)
@@ -26,7 +26,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("sends check resource request to ATC", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			found, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -46,7 +46,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns a ResourceNotFoundError", func() {
-			found, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			found, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -70,7 +70,7 @@ var _ = Describe("CheckResourceType", func() {
 		})
 
 		It("returns an error", func() {
-			_, err := team.CheckResourceType("mypipeline", "myresource", atc.Version{"ref": "fake-ref"})
+			_, err := team.CheckResourceType(atc.PipelineRef{Name: "mypipeline"}, "myresource", atc.Version{"ref": "fake-ref"})
 			Expect(err).To(HaveOccurred())
 
 			cre, ok := err.(concourse.CheckResourceError)
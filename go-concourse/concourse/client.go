@@ -17,8 +17,11 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	BuildEventsOverWebSocket(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
+	BuildTestResults(buildID int) ([]atc.TestResult, bool, error)
 	AbortBuild(buildID string) error
+	CommentBuild(buildID string, comment string) (atc.BuildComment, error)
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SendInputToBuildPlan(buildID int, planID atc.PlanID, src io.Reader) (bool, error)
 	ReadOutputFromBuildPlan(buildID int, planID atc.PlanID) (io.ReadCloser, bool, error)
@@ -51,6 +51,19 @@ type FakeClient struct {
 		result1 concourse.Events
 		result2 error
 	}
+	BuildEventsOverWebSocketStub        func(string) (concourse.Events, error)
+	buildEventsOverWebSocketMutex       sync.RWMutex
+	buildEventsOverWebSocketArgsForCall []struct {
+		arg1 string
+	}
+	buildEventsOverWebSocketReturns struct {
+		result1 concourse.Events
+		result2 error
+	}
+	buildEventsOverWebSocketReturnsOnCall map[int]struct {
+		result1 concourse.Events
+		result2 error
+	}
 	BuildPlanStub        func(int) (atc.PublicBuildPlan, bool, error)
 	buildPlanMutex       sync.RWMutex
 	buildPlanArgsForCall []struct {
@@ -81,6 +94,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildTestResultsStub        func(int) ([]atc.TestResult, bool, error)
+	buildTestResultsMutex       sync.RWMutex
+	buildTestResultsArgsForCall []struct {
+		arg1 int
+	}
+	buildTestResultsReturns struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}
+	buildTestResultsReturnsOnCall map[int]struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -96,6 +124,20 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	CommentBuildStub        func(string, string) (atc.BuildComment, error)
+	commentBuildMutex       sync.RWMutex
+	commentBuildArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	commentBuildReturns struct {
+		result1 atc.BuildComment
+		result2 error
+	}
+	commentBuildReturnsOnCall map[int]struct {
+		result1 atc.BuildComment
+		result2 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -451,6 +493,69 @@ func (fake *FakeClient) BuildEventsReturnsOnCall(i int, result1 concourse.Events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) BuildEventsOverWebSocket(arg1 string) (concourse.Events, error) {
+	fake.buildEventsOverWebSocketMutex.Lock()
+	ret, specificReturn := fake.buildEventsOverWebSocketReturnsOnCall[len(fake.buildEventsOverWebSocketArgsForCall)]
+	fake.buildEventsOverWebSocketArgsForCall = append(fake.buildEventsOverWebSocketArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("BuildEventsOverWebSocket", []interface{}{arg1})
+	fake.buildEventsOverWebSocketMutex.Unlock()
+	if fake.BuildEventsOverWebSocketStub != nil {
+		return fake.BuildEventsOverWebSocketStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.buildEventsOverWebSocketReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) BuildEventsOverWebSocketCallCount() int {
+	fake.buildEventsOverWebSocketMutex.RLock()
+	defer fake.buildEventsOverWebSocketMutex.RUnlock()
+	return len(fake.buildEventsOverWebSocketArgsForCall)
+}
+
+func (fake *FakeClient) BuildEventsOverWebSocketCalls(stub func(string) (concourse.Events, error)) {
+	fake.buildEventsOverWebSocketMutex.Lock()
+	defer fake.buildEventsOverWebSocketMutex.Unlock()
+	fake.BuildEventsOverWebSocketStub = stub
+}
+
+func (fake *FakeClient) BuildEventsOverWebSocketArgsForCall(i int) string {
+	fake.buildEventsOverWebSocketMutex.RLock()
+	defer fake.buildEventsOverWebSocketMutex.RUnlock()
+	argsForCall := fake.buildEventsOverWebSocketArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildEventsOverWebSocketReturns(result1 concourse.Events, result2 error) {
+	fake.buildEventsOverWebSocketMutex.Lock()
+	defer fake.buildEventsOverWebSocketMutex.Unlock()
+	fake.BuildEventsOverWebSocketStub = nil
+	fake.buildEventsOverWebSocketReturns = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) BuildEventsOverWebSocketReturnsOnCall(i int, result1 concourse.Events, result2 error) {
+	fake.buildEventsOverWebSocketMutex.Lock()
+	defer fake.buildEventsOverWebSocketMutex.Unlock()
+	fake.BuildEventsOverWebSocketStub = nil
+	if fake.buildEventsOverWebSocketReturnsOnCall == nil {
+		fake.buildEventsOverWebSocketReturnsOnCall = make(map[int]struct {
+			result1 concourse.Events
+			result2 error
+		})
+	}
+	fake.buildEventsOverWebSocketReturnsOnCall[i] = struct {
+		result1 concourse.Events
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) BuildPlan(arg1 int) (atc.PublicBuildPlan, bool, error) {
 	fake.buildPlanMutex.Lock()
 	ret, specificReturn := fake.buildPlanReturnsOnCall[len(fake.buildPlanArgsForCall)]
@@ -583,6 +688,72 @@ func (fake *FakeClient) BuildResourcesReturnsOnCall(i int, result1 atc.BuildInpu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildTestResults(arg1 int) ([]atc.TestResult, bool, error) {
+	fake.buildTestResultsMutex.Lock()
+	ret, specificReturn := fake.buildTestResultsReturnsOnCall[len(fake.buildTestResultsArgsForCall)]
+	fake.buildTestResultsArgsForCall = append(fake.buildTestResultsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("BuildTestResults", []interface{}{arg1})
+	fake.buildTestResultsMutex.Unlock()
+	if fake.BuildTestResultsStub != nil {
+		return fake.BuildTestResultsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildTestResultsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildTestResultsCallCount() int {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	return len(fake.buildTestResultsArgsForCall)
+}
+
+func (fake *FakeClient) BuildTestResultsCalls(stub func(int) ([]atc.TestResult, bool, error)) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = stub
+}
+
+func (fake *FakeClient) BuildTestResultsArgsForCall(i int) int {
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
+	argsForCall := fake.buildTestResultsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildTestResultsReturns(result1 []atc.TestResult, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	fake.buildTestResultsReturns = struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTestResultsReturnsOnCall(i int, result1 []atc.TestResult, result2 bool, result3 error) {
+	fake.buildTestResultsMutex.Lock()
+	defer fake.buildTestResultsMutex.Unlock()
+	fake.BuildTestResultsStub = nil
+	if fake.buildTestResultsReturnsOnCall == nil {
+		fake.buildTestResultsReturnsOnCall = make(map[int]struct {
+			result1 []atc.TestResult
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildTestResultsReturnsOnCall[i] = struct {
+		result1 []atc.TestResult
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -649,6 +820,70 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CommentBuild(arg1 string, arg2 string) (atc.BuildComment, error) {
+	fake.commentBuildMutex.Lock()
+	ret, specificReturn := fake.commentBuildReturnsOnCall[len(fake.commentBuildArgsForCall)]
+	fake.commentBuildArgsForCall = append(fake.commentBuildArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CommentBuild", []interface{}{arg1, arg2})
+	fake.commentBuildMutex.Unlock()
+	if fake.CommentBuildStub != nil {
+		return fake.CommentBuildStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.commentBuildReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CommentBuildCallCount() int {
+	fake.commentBuildMutex.RLock()
+	defer fake.commentBuildMutex.RUnlock()
+	return len(fake.commentBuildArgsForCall)
+}
+
+func (fake *FakeClient) CommentBuildCalls(stub func(string, string) (atc.BuildComment, error)) {
+	fake.commentBuildMutex.Lock()
+	defer fake.commentBuildMutex.Unlock()
+	fake.CommentBuildStub = stub
+}
+
+func (fake *FakeClient) CommentBuildArgsForCall(i int) (string, string) {
+	fake.commentBuildMutex.RLock()
+	defer fake.commentBuildMutex.RUnlock()
+	argsForCall := fake.commentBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CommentBuildReturns(result1 atc.BuildComment, result2 error) {
+	fake.commentBuildMutex.Lock()
+	defer fake.commentBuildMutex.Unlock()
+	fake.CommentBuildStub = nil
+	fake.commentBuildReturns = struct {
+		result1 atc.BuildComment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CommentBuildReturnsOnCall(i int, result1 atc.BuildComment, result2 error) {
+	fake.commentBuildMutex.Lock()
+	defer fake.commentBuildMutex.Unlock()
+	fake.CommentBuildStub = nil
+	if fake.commentBuildReturnsOnCall == nil {
+		fake.commentBuildReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildComment
+			result2 error
+		})
+	}
+	fake.commentBuildReturnsOnCall[i] = struct {
+		result1 atc.BuildComment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -1425,12 +1660,18 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
+	fake.buildEventsOverWebSocketMutex.RLock()
+	defer fake.buildEventsOverWebSocketMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
+	fake.buildTestResultsMutex.RLock()
+	defer fake.buildTestResultsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.commentBuildMutex.RLock()
+	defer fake.commentBuildMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1,9 +1,13 @@
 package concourse
 
 import (
+	"net/url"
+	"strconv"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/gorilla/websocket"
 	"github.com/tedsuo/rata"
 )
 
@@ -25,3 +29,27 @@ func (client *client) BuildEvents(buildID string) (Events, error) {
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+func (client *client) BuildEventsOverWebSocket(buildID string) (Events, error) {
+	dial := func(lastEventID *uint) (*websocket.Conn, error) {
+		query := url.Values{}
+		if lastEventID != nil {
+			query.Set("last_event_id", strconv.FormatUint(uint64(*lastEventID), 10))
+		}
+
+		return client.connection.ConnectToWebSocket(internal.Request{
+			RequestName: atc.BuildEvents,
+			Params: rata.Params{
+				"build_id": buildID,
+			},
+			Query: query,
+		})
+	}
+
+	conn, err := dial(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return eventstream.NewWebSocketEventStream(conn, dial), nil
+}
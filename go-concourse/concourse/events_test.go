@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
@@ -128,4 +129,166 @@ var _ = Describe("ATC Handler Events", func() {
 			})
 		})
 	})
+	Describe("Events over a WebSocket", func() {
+		buildID := "3"
+
+		var upgrader websocket.Upgrader
+
+		eventsHandler := func(events ...atc.Event) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%s/events", buildID)),
+				func(w http.ResponseWriter, r *http.Request) {
+					conn, err := upgrader.Upgrade(w, r, nil)
+					Expect(err).NotTo(HaveOccurred())
+
+					defer conn.Close()
+
+					var id uint
+					for _, e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						err = conn.WriteJSON(event.WebSocketMessage{
+							ID:   id,
+							Name: "event",
+							Data: (*json.RawMessage)(&payload),
+						})
+						Expect(err).NotTo(HaveOccurred())
+
+						id++
+					}
+
+					err = conn.WriteJSON(event.WebSocketMessage{ID: id, Name: "end"})
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+		}
+
+		Context("when the server returns events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					eventsHandler(
+						event.Status{Status: atc.StatusStarted},
+						event.Status{Status: atc.StatusSucceeded},
+					),
+				)
+			})
+
+			It("returns events that can stream events", func() {
+				stream, err := client.BuildEventsOverWebSocket(buildID)
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusStarted,
+				}))
+
+				next, err = stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusSucceeded,
+				}))
+
+				_, err = stream.NextEvent()
+				Expect(err).To(Equal(io.EOF))
+
+				err = stream.Close()
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("when the connection drops", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%s/events", buildID), ""),
+						func(w http.ResponseWriter, r *http.Request) {
+							conn, err := upgrader.Upgrade(w, r, nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							payload, err := json.Marshal(event.Message{Event: event.Status{Status: atc.StatusStarted}})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.WriteJSON(event.WebSocketMessage{
+								ID:   0,
+								Name: "event",
+								Data: (*json.RawMessage)(&payload),
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.UnderlyingConn().Close()
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%s/events", buildID), "last_event_id=0"),
+						func(w http.ResponseWriter, r *http.Request) {
+							conn, err := upgrader.Upgrade(w, r, nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							defer conn.Close()
+
+							payload, err := json.Marshal(event.Message{Event: event.Status{Status: atc.StatusSucceeded}})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.WriteJSON(event.WebSocketMessage{
+								ID:   1,
+								Name: "event",
+								Data: (*json.RawMessage)(&payload),
+							})
+							Expect(err).NotTo(HaveOccurred())
+
+							err = conn.WriteJSON(event.WebSocketMessage{ID: 2, Name: "end"})
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("reconnects, resuming after the last event", func() {
+				stream, err := client.BuildEventsOverWebSocket(buildID)
+				Expect(err).NotTo(HaveOccurred())
+
+				next, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusStarted,
+				}))
+
+				next, err = stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(next).To(Equal(event.Status{
+					Status: atc.StatusSucceeded,
+				}))
+
+				_, err = stream.NextEvent()
+				Expect(err).To(Equal(io.EOF))
+
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(2))
+			})
+		})
+
+		Context("when the server returns 401", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, ""))
+			})
+
+			It("returns ErrUnauthorized", func() {
+				_, err := client.BuildEventsOverWebSocket(buildID)
+				Expect(err).To(Equal(concourse.ErrUnauthorized))
+			})
+		})
+
+		Context("when the server returns 403", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, ""))
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := client.BuildEventsOverWebSocket(buildID)
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
 })
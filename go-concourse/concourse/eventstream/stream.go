@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
+	"github.com/gorilla/websocket"
 	"github.com/vito/go-sse/sse"
 )
 
@@ -52,3 +54,111 @@ func (s *SSEEventStream) NextEvent() (atc.Event, error) {
 func (s *SSEEventStream) Close() error {
 	return s.sseReader.Close()
 }
+
+// WebSocketDialer connects to a WebSocket event stream, resuming after the
+// given event ID unless it is nil.
+type WebSocketDialer func(lastEventID *uint) (*websocket.Conn, error)
+
+// WebSocketEventStream reads events from a WebSocket, redialing to resume
+// after the last event it read if the connection drops.
+type WebSocketEventStream struct {
+	dial WebSocketDialer
+
+	lock        sync.Mutex
+	conn        *websocket.Conn
+	lastEventID *uint
+	closed      bool
+}
+
+func NewWebSocketEventStream(conn *websocket.Conn, dial WebSocketDialer) *WebSocketEventStream {
+	return &WebSocketEventStream{conn: conn, dial: dial}
+}
+
+func (s *WebSocketEventStream) NextEvent() (atc.Event, error) {
+	var wsMessage event.WebSocketMessage
+	err := s.readMessage(&wsMessage)
+	if err != nil {
+		if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return nil, io.EOF
+		}
+
+		return nil, err
+	}
+
+	switch wsMessage.Name {
+	case "event":
+		if wsMessage.Data == nil {
+			return nil, fmt.Errorf("event %d has no data", wsMessage.ID)
+		}
+
+		var message event.Message
+		err = json.Unmarshal(*wsMessage.Data, &message)
+		if err != nil {
+			return nil, err
+		}
+
+		s.lock.Lock()
+		id := wsMessage.ID
+		s.lastEventID = &id
+		s.lock.Unlock()
+
+		return message.Event, nil
+
+	case "end":
+		return nil, io.EOF
+
+	default:
+		return nil, fmt.Errorf("unknown event name: %s", wsMessage.Name)
+	}
+}
+
+// readMessage reads the next message, redialing if the connection fails for
+// any reason other than the server closing it normally at the end of the
+// stream or sending a malformed message.
+func (s *WebSocketEventStream) readMessage(wsMessage *event.WebSocketMessage) error {
+	for {
+		s.lock.Lock()
+		conn := s.conn
+		s.lock.Unlock()
+
+		err := conn.ReadJSON(wsMessage)
+		if err == nil || websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+			return err
+		}
+
+		switch err.(type) {
+		case *json.SyntaxError, *json.UnmarshalTypeError:
+			return err
+		}
+
+		s.lock.Lock()
+		if s.closed {
+			s.lock.Unlock()
+			return err
+		}
+
+		_ = conn.Close()
+
+		newConn, err := s.dial(s.lastEventID)
+		if err != nil {
+			s.lock.Unlock()
+			return err
+		}
+
+		s.conn = newConn
+		s.lock.Unlock()
+	}
+}
+
+func (s *WebSocketEventStream) Close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.closed {
+		return nil
+	}
+
+	s.closed = true
+
+	return s.conn.Close()
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -13,8 +14,10 @@ import (
 	"log"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/gorilla/websocket"
 	"github.com/tedsuo/rata"
 	"github.com/vito/go-sse/sse"
+	"golang.org/x/oauth2"
 )
 
 //go:generate counterfeiter . Connection
@@ -25,6 +28,7 @@ type Connection interface {
 
 	Send(request Request, response *Response) error
 	ConnectToEventStream(request Request) (*sse.EventSource, error)
+	ConnectToWebSocket(request Request) (*websocket.Conn, error)
 }
 
 type Request struct {
@@ -135,6 +139,66 @@ func (connection *connection) ConnectToEventStream(passedRequest Request) (*sse.
 	return source, nil
 }
 
+func (connection *connection) ConnectToWebSocket(passedRequest Request) (*websocket.Conn, error) {
+	req, err := connection.createHTTPRequest(passedRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer, err := webSocketDialer(connection.httpClient.Transport, req)
+	if err != nil {
+		return nil, err
+	}
+
+	wsURL := *req.URL
+
+	switch wsURL.Scheme {
+	case "http":
+		wsURL.Scheme = "ws"
+	case "https":
+		wsURL.Scheme = "wss"
+	default:
+		return nil, fmt.Errorf("unknown target scheme: %s", wsURL.Scheme)
+	}
+
+	conn, response, err := dialer.Dial(wsURL.String(), req.Header)
+	if err == websocket.ErrBadHandshake {
+		defer response.Body.Close()
+		return nil, connection.populateResponse(response, false, nil)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+// webSocketDialer configures a dialer to connect the way the HTTP client's
+// transport would have, as WebSocket handshakes don't go through it. The
+// transport's token is added to the request's headers.
+func webSocketDialer(transport http.RoundTripper, req *http.Request) (*websocket.Dialer, error) {
+	dialer := &websocket.Dialer{
+		Proxy: http.ProxyFromEnvironment,
+	}
+
+	if oauthTransport, ok := transport.(*oauth2.Transport); ok {
+		token, err := oauthTransport.Source.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		token.SetAuthHeader(req)
+
+		transport = oauthTransport.Base
+	}
+
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		dialer.TLSClientConfig = httpTransport.TLSClientConfig
+		dialer.Proxy = httpTransport.Proxy
+	}
+
+	return dialer, nil
+}
+
 func (connection *connection) createHTTPRequest(passedRequest Request) (*http.Request, error) {
 	body := connection.getBody(passedRequest)
 
@@ -13,11 +13,13 @@ import (
 	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	. "github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/google/jsonapi"
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 	"github.com/tedsuo/rata"
 	"github.com/vito/go-sse/sse"
+	"golang.org/x/oauth2"
 )
 
 var _ = Describe("ATC Connection", func() {
@@ -585,6 +587,71 @@ var _ = Describe("ATC Connection", func() {
 			Expect(err).To(MatchError(io.EOF))
 		})
 	})
+
+	Describe("#ConnectToWebSocket", func() {
+		buildID := "3"
+
+		BeforeEach(func() {
+			connection = NewConnection(atcServer.URL(), &http.Client{
+				Transport: &oauth2.Transport{
+					Source: oauth2.StaticTokenSource(&oauth2.Token{
+						TokenType:   "Bearer",
+						AccessToken: "some-token",
+					}),
+					Base: &http.Transport{},
+				},
+			}, tracing)
+		})
+
+		Context("when the server upgrades the connection", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", fmt.Sprintf("/api/v1/builds/%s/events", buildID)),
+						ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var upgrader websocket.Upgrader
+							conn, err := upgrader.Upgrade(w, r, nil)
+							Expect(err).NotTo(HaveOccurred())
+
+							defer conn.Close()
+
+							err = conn.WriteMessage(websocket.TextMessage, []byte("sup"))
+							Expect(err).NotTo(HaveOccurred())
+						},
+					),
+				)
+			})
+
+			It("returns the connection, authorized with the client's token", func() {
+				conn, err := connection.ConnectToWebSocket(Request{
+					RequestName: atc.BuildEvents,
+					Params:      rata.Params{"build_id": buildID},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				defer conn.Close()
+
+				_, message, err := conn.ReadMessage()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(message)).To(Equal("sup"))
+			})
+		})
+
+		Context("when the server refuses to upgrade", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(ghttp.RespondWith(http.StatusUnauthorized, ""))
+			})
+
+			It("returns the error for the response", func() {
+				_, err := connection.ConnectToWebSocket(Request{
+					RequestName: atc.BuildEvents,
+					Params:      rata.Params{"build_id": buildID},
+				})
+				Expect(err).To(Equal(ErrUnauthorized))
+			})
+		})
+	})
 })
 
 type BasicAuthTransport struct {
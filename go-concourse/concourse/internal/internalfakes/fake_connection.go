@@ -6,6 +6,7 @@ import (
 	sync "sync"
 
 	internal "github.com/concourse/concourse/go-concourse/concourse/internal"
+	websocket "github.com/gorilla/websocket"
 	sse "github.com/vito/go-sse/sse"
 )
 
@@ -23,6 +24,19 @@ type FakeConnection struct {
 		result1 *sse.EventSource
 		result2 error
 	}
+	ConnectToWebSocketStub        func(internal.Request) (*websocket.Conn, error)
+	connectToWebSocketMutex       sync.RWMutex
+	connectToWebSocketArgsForCall []struct {
+		arg1 internal.Request
+	}
+	connectToWebSocketReturns struct {
+		result1 *websocket.Conn
+		result2 error
+	}
+	connectToWebSocketReturnsOnCall map[int]struct {
+		result1 *websocket.Conn
+		result2 error
+	}
 	HTTPClientStub        func() *http.Client
 	hTTPClientMutex       sync.RWMutex
 	hTTPClientArgsForCall []struct {
@@ -122,6 +136,69 @@ func (fake *FakeConnection) ConnectToEventStreamReturnsOnCall(i int, result1 *ss
 	}{result1, result2}
 }
 
+func (fake *FakeConnection) ConnectToWebSocket(arg1 internal.Request) (*websocket.Conn, error) {
+	fake.connectToWebSocketMutex.Lock()
+	ret, specificReturn := fake.connectToWebSocketReturnsOnCall[len(fake.connectToWebSocketArgsForCall)]
+	fake.connectToWebSocketArgsForCall = append(fake.connectToWebSocketArgsForCall, struct {
+		arg1 internal.Request
+	}{arg1})
+	fake.recordInvocation("ConnectToWebSocket", []interface{}{arg1})
+	fake.connectToWebSocketMutex.Unlock()
+	if fake.ConnectToWebSocketStub != nil {
+		return fake.ConnectToWebSocketStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.connectToWebSocketReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConnection) ConnectToWebSocketCallCount() int {
+	fake.connectToWebSocketMutex.RLock()
+	defer fake.connectToWebSocketMutex.RUnlock()
+	return len(fake.connectToWebSocketArgsForCall)
+}
+
+func (fake *FakeConnection) ConnectToWebSocketCalls(stub func(internal.Request) (*websocket.Conn, error)) {
+	fake.connectToWebSocketMutex.Lock()
+	defer fake.connectToWebSocketMutex.Unlock()
+	fake.ConnectToWebSocketStub = stub
+}
+
+func (fake *FakeConnection) ConnectToWebSocketArgsForCall(i int) internal.Request {
+	fake.connectToWebSocketMutex.RLock()
+	defer fake.connectToWebSocketMutex.RUnlock()
+	argsForCall := fake.connectToWebSocketArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConnection) ConnectToWebSocketReturns(result1 *websocket.Conn, result2 error) {
+	fake.connectToWebSocketMutex.Lock()
+	defer fake.connectToWebSocketMutex.Unlock()
+	fake.ConnectToWebSocketStub = nil
+	fake.connectToWebSocketReturns = struct {
+		result1 *websocket.Conn
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConnection) ConnectToWebSocketReturnsOnCall(i int, result1 *websocket.Conn, result2 error) {
+	fake.connectToWebSocketMutex.Lock()
+	defer fake.connectToWebSocketMutex.Unlock()
+	fake.ConnectToWebSocketStub = nil
+	if fake.connectToWebSocketReturnsOnCall == nil {
+		fake.connectToWebSocketReturnsOnCall = make(map[int]struct {
+			result1 *websocket.Conn
+			result2 error
+		})
+	}
+	fake.connectToWebSocketReturnsOnCall[i] = struct {
+		result1 *websocket.Conn
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConnection) HTTPClient() *http.Client {
 	fake.hTTPClientMutex.Lock()
 	ret, specificReturn := fake.hTTPClientReturnsOnCall[len(fake.hTTPClientArgsForCall)]
@@ -292,6 +369,8 @@ func (fake *FakeConnection) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.connectToEventStreamMutex.RLock()
 	defer fake.connectToEventStreamMutex.RUnlock()
+	fake.connectToWebSocketMutex.RLock()
+	defer fake.connectToWebSocketMutex.RUnlock()
 	fake.hTTPClientMutex.RLock()
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.sendMutex.RLock()
@@ -9,13 +9,13 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
-	if pipelineName == "" {
+func (team *team) ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error) {
+	if pipelineRef.Name == "" {
 		return []atc.Job{}, NameRequiredError("pipeline")
 	}
 
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -23,6 +23,7 @@ func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobs,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &jobs,
 	})
@@ -30,13 +31,13 @@ func (team *team) ListJobs(pipelineName string) ([]atc.Job, error) {
 	return jobs, err
 }
 
-func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
-	if pipelineName == "" {
+func (team *team) Job(pipelineRef atc.PipelineRef, jobName string) (atc.Job, bool, error) {
+	if pipelineRef.Name == "" {
 		return atc.Job{}, false, NameRequiredError("pipeline")
 	}
 
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -45,6 +46,7 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &job,
 	})
@@ -58,9 +60,9 @@ func (team *team) Job(pipelineName, jobName string) (atc.Job, bool, error) {
 	}
 }
 
-func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
+func (team *team) JobBuilds(pipelineRef atc.PipelineRef, jobName string, page Page) ([]atc.Build, Pagination, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -71,7 +73,7 @@ func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]a
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListJobBuilds,
 		Params:      params,
-		Query:       page.QueryParams(),
+		Query:       pipelineQuery(pipelineRef, page.QueryParams()),
 	}, &internal.Response{
 		Result:  &builds,
 		Headers: &headers,
@@ -91,9 +93,9 @@ func (team *team) JobBuilds(pipelineName string, jobName string, page Page) ([]a
 	}
 }
 
-func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
+func (team *team) PauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -101,6 +103,7 @@ func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.PauseJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{})
 
 	switch err.(type) {
@@ -113,9 +116,9 @@ func (team *team) PauseJob(pipelineName string, jobName string) (bool, error) {
 	}
 }
 
-func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error) {
+func (team *team) UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"team_name":     team.name,
 	}
@@ -123,6 +126,7 @@ func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error)
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.UnpauseJob,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{})
 
 	switch err.(type) {
@@ -135,10 +139,10 @@ func (team *team) UnpauseJob(pipelineName string, jobName string) (bool, error)
 	}
 }
 
-func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error) {
+func (team *team) ClearTaskCache(pipelineRef atc.PipelineRef, jobName string, stepName string, cachePath string) (int64, error) {
 	params := rata.Params{
 		"team_name":     team.name,
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"job_name":      jobName,
 		"step_name":     stepName,
 	}
@@ -157,7 +161,7 @@ func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName s
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ClearTaskCache,
 		Params:      params,
-		Query:       queryParams,
+		Query:       pipelineQuery(pipelineRef, queryParams),
 	}, &response)
 
 	if err != nil {
@@ -22,7 +22,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns empty job and name required error", func() {
-				pipelines, err := team.ListJobs("")
+				pipelines, err := team.ListJobs(atc.PipelineRef{})
 				Expect(err).To(HaveOccurred())
 				Expect(pipelines).To(Equal(expectedJobs))
 			})
@@ -52,7 +52,37 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns jobs that belong to the pipeline", func() {
-				pipelines, err := team.ListJobs("mypipeline")
+				pipelines, err := team.ListJobs(atc.PipelineRef{Name: "mypipeline"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(pipelines).To(Equal(expectedJobs))
+			})
+		})
+
+		Context("when the pipeline has instance vars", func() {
+			BeforeEach(func() {
+				expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/jobs"
+
+				expectedJobs = []atc.Job{
+					{
+						Name:                 "myjob-1",
+						PipelineName:         "mypipeline",
+						PipelineInstanceVars: atc.InstanceVars{"branch": "release-5.1"},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, `vars=%7B%22branch%22%3A%22release-5.1%22%7D`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedJobs),
+					),
+				)
+			})
+
+			It("returns jobs that belong to the pipeline instance", func() {
+				pipelines, err := team.ListJobs(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "release-5.1"},
+				})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelines).To(Equal(expectedJobs))
 			})
@@ -115,7 +145,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns the given job for that pipeline", func() {
-				job, found, err := team.Job("mypipeline", "myjob")
+				job, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(job).To(Equal(expectedJob))
 				Expect(found).To(BeTrue())
@@ -135,7 +165,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, found, err := team.Job("mypipeline", "myjob")
+				_, found, err := team.Job(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -173,7 +203,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -187,7 +217,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds since that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -199,7 +229,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Limit: 5})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Limit: 5})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -214,7 +244,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("calls to get all builds until that id", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -226,7 +256,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("appends limit to the url", func() {
-					builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Until: 26, Limit: 15})
+					builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Until: 26, Limit: 15})
 					Expect(err).NotTo(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(builds).To(Equal(expectedBuilds))
@@ -241,7 +271,24 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("sends both the since and the until", func() {
-				builds, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{Since: 24, Until: 26})
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{Since: 24, Until: 26})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(builds).To(Equal(expectedBuilds))
+			})
+		})
+
+		Context("when the pipeline has instance vars", func() {
+			BeforeEach(func() {
+				expectedURL = fmt.Sprint("/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/builds")
+				expectedQuery = fmt.Sprint(`since=24&vars=%7B%22branch%22%3A%22release-5.1%22%7D`)
+			})
+
+			It("sends the instance vars along with the page", func() {
+				builds, _, found, err := team.JobBuilds(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "release-5.1"},
+				}, "myjob", concourse.Page{Since: 24})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(builds).To(Equal(expectedBuilds))
@@ -261,7 +308,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and an error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).To(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -280,7 +327,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, _, found, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -305,7 +352,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				})
 
 				It("returns the pagination data from the header", func() {
-					_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+					_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(pagination.Previous).To(Equal(&concourse.Page{Since: 452, Limit: 123}))
@@ -327,7 +374,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			})
 
 			It("returns pagination data with nil pages", func() {
-				_, pagination, _, err := team.JobBuilds("mypipeline", "myjob", concourse.Page{})
+				_, pagination, _, err := team.JobBuilds(atc.PipelineRef{Name: "mypipeline"}, "myjob", concourse.Page{})
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(pagination.Previous).To(BeNil())
@@ -360,7 +407,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -376,7 +423,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -392,7 +439,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.PauseJob(pipelineName, jobName)
+					paused, err := team.PauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -426,7 +473,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns no error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(paused).To(BeTrue())
 				}).To(Change(func() int {
@@ -442,7 +489,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).To(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -458,7 +505,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("calls the pause job and returns an error", func() {
 				Expect(func() {
-					paused, err := team.UnpauseJob(pipelineName, jobName)
+					paused, err := team.UnpauseJob(atc.PipelineRef{Name: pipelineName}, jobName)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(paused).To(BeFalse())
 				}).To(Change(func() int {
@@ -492,7 +539,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 			Context("when no cache path is given", func() {
 				It("succeeds", func() {
 					Expect(func() {
-						numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "")
+						numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "")
 						Expect(err).NotTo(HaveOccurred())
 						Expect(numDeleted).To(Equal(int64(1)))
 					}).To(Change(func() int {
@@ -505,7 +552,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 				Context("when the cache path exists", func() {
 					It("succeeds", func() {
 						Expect(func() {
-							numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "mystep", "mycachepath")
+							numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "mystep", "mycachepath")
 							Expect(err).NotTo(HaveOccurred())
 							Expect(numDeleted).To(Equal(int64(1)))
 						}).To(Change(func() int {
@@ -530,7 +577,7 @@ var _ = Describe("ATC Handler Jobs", func() {
 
 			It("returns that 0 caches were deleted", func() {
 				Expect(func() {
-					numDeleted, err := team.ClearTaskCache("mypipeline", "myjob", "my-nonexistent-step", "mycachepath")
+					numDeleted, err := team.ClearTaskCache(atc.PipelineRef{Name: "mypipeline"}, "myjob", "my-nonexistent-step", "mycachepath")
 					Expect(err).NotTo(HaveOccurred())
 					Expect(numDeleted).To(BeZero())
 				}).To(Change(func() int {
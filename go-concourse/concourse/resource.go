@@ -6,9 +6,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) Resource(pipelineName string, resourceName string) (atc.Resource, bool, error) {
+func (team *team) Resource(pipelineRef atc.PipelineRef, resourceName string) (atc.Resource, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"resource_name": resourceName,
 		"team_name":     team.name,
 	}
@@ -17,6 +17,7 @@ func (team *team) Resource(pipelineName string, resourceName string) (atc.Resour
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetResource,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &resource,
 	})
@@ -30,13 +31,38 @@ func (team *team) Resource(pipelineName string, resourceName string) (atc.Resour
 	}
 }
 
-func (team *team) ListResources(pipelineName string) ([]atc.Resource, error) {
-	if pipelineName == "" {
+func (team *team) ListResourceChecks(pipelineRef atc.PipelineRef, resourceName string) ([]atc.Check, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"resource_name": resourceName,
+		"team_name":     team.name,
+	}
+
+	var checks []atc.Check
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListResourceChecks,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &checks,
+	})
+	switch err.(type) {
+	case nil:
+		return checks, true, nil
+	case internal.ResourceNotFoundError:
+		return checks, false, nil
+	default:
+		return checks, false, err
+	}
+}
+
+func (team *team) ListResources(pipelineRef atc.PipelineRef) ([]atc.Resource, error) {
+	if pipelineRef.Name == "" {
 		return []atc.Resource{}, NameRequiredError("pipeline")
 	}
 
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -44,6 +70,7 @@ func (team *team) ListResources(pipelineName string) ([]atc.Resource, error) {
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListResources,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &resources,
 	})
@@ -19,7 +19,7 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 
 			It("returns empty resource and name required error", func() {
-				pipelines, err := team.ListResources("")
+				pipelines, err := team.ListResources(atc.PipelineRef{})
 				Expect(err).To(HaveOccurred())
 				Expect(pipelines).To(Equal(expectedResources))
 			})
@@ -49,7 +49,7 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 
 			It("returns resources that belong to the pipeline", func() {
-				pipelines, err := team.ListResources("some-pipeline")
+				pipelines, err := team.ListResources(atc.PipelineRef{Name: "some-pipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelines).To(Equal(expectedResources))
 			})
@@ -70,7 +70,7 @@ var _ = Describe("ATC Handler Resource", func() {
 		})
 
 		JustBeforeEach(func() {
-			resource, found, clientErr = team.Resource("some-pipeline", "myresource")
+			resource, found, clientErr = team.Resource(atc.PipelineRef{Name: "some-pipeline"}, "myresource")
 		})
 
 		Context("when the server returns the resource", func() {
@@ -122,4 +122,82 @@ var _ = Describe("ATC Handler Resource", func() {
 			})
 		})
 	})
+
+	Describe("ListResourceChecks", func() {
+		var expectedChecks []atc.Check
+
+		var checks []atc.Check
+		var found bool
+		var clientErr error
+
+		BeforeEach(func() {
+			expectedChecks = []atc.Check{
+				{
+					ID:          2,
+					Status:      "succeeded",
+					FromVersion: atc.Version{"ref": "v1"},
+					NewVersions: 1,
+				},
+				{
+					ID:         1,
+					Status:     "errored",
+					CheckError: "some-error",
+					Stderr:     "some-stderr",
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			checks, found, clientErr = team.ListResourceChecks(atc.PipelineRef{Name: "some-pipeline"}, "myresource")
+		})
+
+		Context("when the server returns the checks", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/checks"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedChecks),
+					),
+				)
+			})
+
+			It("returns the checks", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(checks).To(Equal(expectedChecks))
+			})
+		})
+
+		Context("when the server returns a 404", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/checks"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false for found and a nil error", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the server returns a 500", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/pipelines/some-pipeline/resources/myresource/checks"),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns false for found and an error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
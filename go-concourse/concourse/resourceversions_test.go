@@ -40,7 +40,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		})
 
 		JustBeforeEach(func() {
-			versions, pagination, found, clientErr = team.ResourceVersions("mypipeline", "myresource", page)
+			versions, pagination, found, clientErr = team.ResourceVersions(atc.PipelineRef{Name: "mypipeline"}, "myresource", page)
 		})
 
 		Context("when since, until, and limit are 0", func() {
@@ -255,7 +255,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns no error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(disabled).To(BeTrue())
 				}).To(Change(func() int {
@@ -271,7 +271,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns an error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).To(HaveOccurred())
 					Expect(disabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -287,7 +287,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the disable resource and returns an error", func() {
 				Expect(func() {
-					disabled, err := team.DisableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					disabled, err := team.DisableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(disabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -322,7 +322,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns no error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(enabled).To(BeTrue())
 				}).To(Change(func() int {
@@ -338,7 +338,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns an error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).To(HaveOccurred())
 					Expect(enabled).To(BeFalse())
 				}).To(Change(func() int {
@@ -354,7 +354,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 			It("calls the enable resource and returns an error", func() {
 				Expect(func() {
-					enabled, err := team.EnableResourceVersion(pipelineName, resourceName, resourceVersionID)
+					enabled, err := team.EnableResourceVersion(atc.PipelineRef{Name: pipelineName}, resourceName, resourceVersionID)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(enabled).To(BeFalse())
 				}).To(Change(func() int {
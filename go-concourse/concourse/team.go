@@ -14,44 +14,50 @@ type Team interface {
 	RenameTeam(teamName, name string) (bool, error)
 	DestroyTeam(teamName string) error
 
-	Pipeline(name string) (atc.Pipeline, bool, error)
-	PipelineBuilds(pipelineName string, page Page) ([]atc.Build, Pagination, bool, error)
-	DeletePipeline(pipelineName string) (bool, error)
-	PausePipeline(pipelineName string) (bool, error)
-	UnpausePipeline(pipelineName string) (bool, error)
-	ExposePipeline(pipelineName string) (bool, error)
-	HidePipeline(pipelineName string) (bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error)
+	PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error)
+	SearchPipelineLogs(pipelineRef atc.PipelineRef, query string, regex bool, page Page) ([]atc.BuildLogSearchResult, Pagination, bool, error)
+	DeletePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	PausePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	UnpausePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	ArchivePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	ExposePipeline(pipelineRef atc.PipelineRef) (bool, error)
+	HidePipeline(pipelineRef atc.PipelineRef) (bool, error)
 	RenamePipeline(pipelineName, name string) (bool, error)
 	ListPipelines() ([]atc.Pipeline, error)
-	PipelineConfig(pipelineName string) (atc.Config, atc.RawConfig, string, bool, error)
-	CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
-
-	CreatePipelineBuild(pipelineName string, plan atc.Plan) (atc.Build, error)
-
-	BuildInputsForJob(pipelineName string, jobName string) ([]atc.BuildInput, bool, error)
-
-	Job(pipelineName, jobName string) (atc.Job, bool, error)
-	JobBuild(pipelineName, jobName, buildName string) (atc.Build, bool, error)
-	JobBuilds(pipelineName string, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
-	CreateJobBuild(pipelineName string, jobName string) (atc.Build, error)
-	ListJobs(pipelineName string) ([]atc.Job, error)
-
-	PauseJob(pipelineName string, jobName string) (bool, error)
-	UnpauseJob(pipelineName string, jobName string) (bool, error)
-
-	ClearTaskCache(pipelineName string, jobName string, stepName string, cachePath string) (int64, error)
-
-	Resource(pipelineName string, resourceName string) (atc.Resource, bool, error)
-	ListResources(pipelineName string) ([]atc.Resource, error)
-	VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error)
-	ResourceVersions(pipelineName string, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error)
-	CheckResource(pipelineName string, resourceName string, version atc.Version) (bool, error)
-	CheckResourceType(pipelineName string, resourceTypeName string, version atc.Version) (bool, error)
-	DisableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
-	EnableResourceVersion(pipelineName string, resourceName string, resourceVersionID int) (bool, error)
-
-	BuildsWithVersionAsInput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
-	BuildsWithVersionAsOutput(pipelineName string, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
+	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, atc.RawConfig, string, bool, error)
+	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+
+	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
+
+	BuildInputsForJob(pipelineRef atc.PipelineRef, jobName string) ([]atc.BuildInput, bool, error)
+
+	Job(pipelineRef atc.PipelineRef, jobName string) (atc.Job, bool, error)
+	JobBuild(pipelineRef atc.PipelineRef, jobName, buildName string) (atc.Build, bool, error)
+	JobBuilds(pipelineRef atc.PipelineRef, jobName string, page Page) ([]atc.Build, Pagination, bool, error)
+	SearchJobLogs(pipelineRef atc.PipelineRef, jobName string, query string, regex bool, page Page) ([]atc.BuildLogSearchResult, Pagination, bool, error)
+	FlakyTests(pipelineRef atc.PipelineRef, jobName string, builds int) ([]atc.FlakyTest, bool, error)
+	CreateJobBuild(pipelineRef atc.PipelineRef, jobName string) (atc.Build, error)
+	RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error)
+	ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error)
+
+	PauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
+	UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
+
+	ClearTaskCache(pipelineRef atc.PipelineRef, jobName string, stepName string, cachePath string) (int64, error)
+
+	Resource(pipelineRef atc.PipelineRef, resourceName string) (atc.Resource, bool, error)
+	ListResources(pipelineRef atc.PipelineRef) ([]atc.Resource, error)
+	ListResourceChecks(pipelineRef atc.PipelineRef, resourceName string) ([]atc.Check, bool, error)
+	VersionedResourceTypes(pipelineRef atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
+	ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page) ([]atc.ResourceVersion, Pagination, bool, error)
+	CheckResource(pipelineRef atc.PipelineRef, resourceName string, version atc.Version) (bool, error)
+	CheckResourceType(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (bool, error)
+	DisableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
+	EnableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
+
+	BuildsWithVersionAsInput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
+	BuildsWithVersionAsOutput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 
 	ListContainers(queryList map[string]string) ([]atc.Container, error)
 	ListVolumes() ([]atc.Volume, error)
@@ -0,0 +1,66 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildTestResults(buildID int) ([]atc.TestResult, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var results []atc.TestResult
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.BuildTestResults,
+		Params:      params,
+	}, &internal.Response{
+		Result: &results,
+	})
+
+	switch err.(type) {
+	case nil:
+		return results, true, nil
+	case internal.ResourceNotFoundError:
+		return results, false, nil
+	default:
+		return results, false, err
+	}
+}
+
+// FlakyTests lists the job's tests that both passed and failed over its
+// given number of recent builds. Zero leaves the number up to the ATC.
+func (team *team) FlakyTests(pipelineRef atc.PipelineRef, jobName string, builds int) ([]atc.FlakyTest, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.name,
+	}
+
+	query := url.Values{}
+	if builds != 0 {
+		query.Set("builds", strconv.Itoa(builds))
+	}
+
+	var flakyTests []atc.FlakyTest
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListFlakyTests,
+		Params:      params,
+		Query:       pipelineQuery(pipelineRef, query),
+	}, &internal.Response{
+		Result: &flakyTests,
+	})
+
+	switch err.(type) {
+	case nil:
+		return flakyTests, true, nil
+	case internal.ResourceNotFoundError:
+		return flakyTests, false, nil
+	default:
+		return flakyTests, false, err
+	}
+}
@@ -0,0 +1,121 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Test Results", func() {
+	Describe("BuildTestResults", func() {
+		expectedURL := "/api/v1/builds/6/tests"
+
+		Context("when the build exists", func() {
+			var expectedResults []atc.TestResult
+
+			BeforeEach(func() {
+				expectedResults = []atc.TestResult{
+					{Suite: "math", Name: "adds", Status: atc.TestPassed, Duration: 0.5},
+					{Suite: "math", Name: "subtracts", Status: atc.TestFailed, Message: "off by one"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedResults),
+					),
+				)
+			})
+
+			It("returns the build's test results", func() {
+				results, found, err := client.BuildTestResults(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(results).To(Equal(expectedResults))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := client.BuildTestResults(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("FlakyTests", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/flaky-tests"
+
+		Context("when the job exists", func() {
+			var expectedFlakyTests []atc.FlakyTest
+
+			BeforeEach(func() {
+				expectedFlakyTests = []atc.FlakyTest{
+					{Suite: "math", Name: "subtracts", Passed: 3, Failed: 2, LastFailedBuild: "7"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "builds=50"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedFlakyTests),
+					),
+				)
+			})
+
+			It("returns the job's flaky tests", func() {
+				flakyTests, found, err := team.FlakyTests(atc.PipelineRef{Name: "some-pipeline"}, "some-job", 50)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(flakyTests).To(Equal(expectedFlakyTests))
+			})
+		})
+
+		Context("when the number of builds is not given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.FlakyTest{}),
+					),
+				)
+			})
+
+			It("leaves it up to the ATC", func() {
+				_, found, err := team.FlakyTests(atc.PipelineRef{Name: "some-pipeline"}, "some-job", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := team.FlakyTests(atc.PipelineRef{Name: "some-pipeline"}, "some-job", 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -6,9 +6,9 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) VersionedResourceTypes(pipelineName string) (atc.VersionedResourceTypes, bool, error) {
+func (team *team) VersionedResourceTypes(pipelineRef atc.PipelineRef) (atc.VersionedResourceTypes, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -16,6 +16,7 @@ func (team *team) VersionedResourceTypes(pipelineName string) (atc.VersionedReso
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.ListResourceTypes,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &internal.Response{
 		Result: &versionedResourceTypes,
 	})
@@ -63,6 +63,7 @@ import Date exposing (Date)
 import Dict exposing (Dict)
 import Json.Decode
 import Json.Decode.Extra exposing ((|:))
+import Json.Encode
 
 
 -- AuthToken
@@ -330,6 +331,7 @@ type BuildStep
     | BuildStepGet StepName (Maybe Version)
     | BuildStepPut StepName
     | BuildStepDependentGet StepName
+    | BuildStepSetPipeline StepName
     | BuildStepAggregate (Array BuildPlan)
     | BuildStepDo (Array BuildPlan)
     | BuildStepOnSuccess HookedPlan
@@ -363,8 +365,10 @@ decodeBuildPlan_ =
             , Json.Decode.field "get" <| lazy (\_ -> decodeBuildStepGet)
             , Json.Decode.field "put" <| lazy (\_ -> decodeBuildStepPut)
             , Json.Decode.field "dependent_get" <| lazy (\_ -> decodeBuildStepDependentGet)
+            , Json.Decode.field "set_pipeline" <| lazy (\_ -> decodeBuildStepSetPipeline)
             , Json.Decode.field "aggregate" <| lazy (\_ -> decodeBuildStepAggregate)
             , Json.Decode.field "do" <| lazy (\_ -> decodeBuildStepDo)
+            , Json.Decode.field "across" <| lazy (\_ -> decodeBuildStepAcross)
             , Json.Decode.field "on_success" <| lazy (\_ -> decodeBuildStepOnSuccess)
             , Json.Decode.field "on_failure" <| lazy (\_ -> decodeBuildStepOnFailure)
             , Json.Decode.field "on_abort" <| lazy (\_ -> decodeBuildStepOnAbort)
@@ -400,6 +404,12 @@ decodeBuildStepDependentGet =
         |: Json.Decode.field "name" Json.Decode.string
 
 
+decodeBuildStepSetPipeline : Json.Decode.Decoder BuildStep
+decodeBuildStepSetPipeline =
+    Json.Decode.succeed BuildStepSetPipeline
+        |: Json.Decode.field "name" Json.Decode.string
+
+
 decodeBuildStepAggregate : Json.Decode.Decoder BuildStep
 decodeBuildStepAggregate =
     Json.Decode.succeed BuildStepAggregate
@@ -412,6 +422,17 @@ decodeBuildStepDo =
         |: Json.Decode.array (lazy (\_ -> decodeBuildPlan_))
 
 
+decodeBuildStepAcross : Json.Decode.Decoder BuildStep
+decodeBuildStepAcross =
+    -- each iteration is shown like a step of an aggregate; values resolved
+    -- from a var are only known at run time, so their step is shown once
+    Json.Decode.succeed BuildStepAggregate
+        |: Json.Decode.oneOf
+            [ Json.Decode.field "steps" <| Json.Decode.array (Json.Decode.field "step" <| lazy (\_ -> decodeBuildPlan_))
+            , Json.Decode.field "step" <| Json.Decode.map (Array.repeat 1) (lazy (\_ -> decodeBuildPlan_))
+            ]
+
+
 decodeBuildStepOnSuccess : Json.Decode.Decoder BuildStep
 decodeBuildStepOnSuccess =
     Json.Decode.map BuildStepOnSuccess <|
@@ -575,6 +596,7 @@ type alias Pipeline =
     , public : Bool
     , teamName : TeamName
     , groups : List PipelineGroup
+    , instanceVars : Dict String String
     }
 
 
@@ -594,6 +616,15 @@ decodePipeline =
         |: Json.Decode.field "public" Json.Decode.bool
         |: Json.Decode.field "team_name" Json.Decode.string
         |: (defaultTo [] <| Json.Decode.field "groups" (Json.Decode.list decodePipelineGroup))
+        |: (defaultTo Dict.empty <| Json.Decode.field "instance_vars" <| Json.Decode.dict decodeInstanceVar)
+
+
+decodeInstanceVar : Json.Decode.Decoder String
+decodeInstanceVar =
+    Json.Decode.oneOf
+        [ Json.Decode.string
+        , Json.Decode.map (Json.Encode.encode 0) Json.Decode.value
+        ]
 
 
 decodePipelineGroup : Json.Decode.Decoder PipelineGroup
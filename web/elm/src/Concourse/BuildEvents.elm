@@ -77,6 +77,23 @@ decodeBuildEvent eventType =
                 "data"
                 (Json.Decode.map StartTask (Json.Decode.field "origin" decodeOrigin))
 
+        "waiting-for-worker" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map2 (\origin time -> Log origin "waiting for a worker with capacity to run the task\n" time)
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.float)
+                )
+
+        "annotations" ->
+            Json.Decode.field
+                "data"
+                (Json.Decode.map3 (\origin annotations time -> Log origin (annotationsLog annotations) time)
+                    (Json.Decode.field "origin" decodeOrigin)
+                    (Json.Decode.field "annotations" <| Json.Decode.dict Json.Decode.string)
+                    (Json.Decode.maybe <| Json.Decode.field "time" <| Json.Decode.map dateFromSeconds Json.Decode.float)
+                )
+
         "finish-task" ->
             Json.Decode.field
                 "data"
@@ -95,6 +112,13 @@ decodeBuildEvent eventType =
             Json.Decode.fail ("unknown event type: " ++ unknown)
 
 
+annotationsLog : Dict String String -> String
+annotationsLog annotations =
+    Dict.toList annotations
+        |> List.map (\( key, value ) -> "annotated build with " ++ key ++ ": " ++ value ++ "\n")
+        |> String.concat
+
+
 subscribe : Int -> Sub Msg
 subscribe build =
     EventSource.listen ( "/api/v1/builds/" ++ toString build ++ "/events", [ "end", "event" ] ) parseMsg
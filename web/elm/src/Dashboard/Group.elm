@@ -174,6 +174,7 @@ allPipelines data =
                     { id = p.id
                     , name = p.name
                     , teamName = p.teamName
+                    , instanceVars = p.instanceVars
                     , public = p.public
                     , jobs = jobs
                     , resourceError =
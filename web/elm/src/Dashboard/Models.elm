@@ -2,12 +2,14 @@ module Dashboard.Models exposing (Pipeline)
 
 import Concourse
 import Concourse.PipelineStatus as PipelineStatus
+import Dict exposing (Dict)
 
 
 type alias Pipeline =
     { id : Int
     , name : String
     , teamName : String
+    , instanceVars : Dict String String
     , public : Bool
     , jobs : List Concourse.Job
     , resourceError : Bool
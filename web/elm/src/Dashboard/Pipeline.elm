@@ -11,6 +11,7 @@ import Dashboard.Models exposing (Pipeline)
 import Dashboard.Msgs exposing (Msg(..))
 import Dashboard.Styles as Styles
 import DashboardPreview
+import Dict
 import Html exposing (..)
 import Html.Attributes exposing (..)
 import Html.Events exposing (on, onMouseEnter, onMouseLeave)
@@ -90,11 +91,26 @@ headerView pipeline =
             ]
             [ Html.div [ class "dashboard-pipeline-name" ]
                 [ Html.text pipeline.name ]
+            , instanceVarsView pipeline
             , Html.div [ classList [ ( "dashboard-resource-error", pipeline.resourceError ) ] ] []
             ]
         ]
 
 
+instanceVarsView : Pipeline -> Html Msg
+instanceVarsView pipeline =
+    if Dict.isEmpty pipeline.instanceVars then
+        Html.text ""
+    else
+        Html.div [ class "dashboard-pipeline-instance-vars" ]
+            [ pipeline.instanceVars
+                |> Dict.toList
+                |> List.map (\( key, value ) -> key ++ ":" ++ value)
+                |> String.join ","
+                |> Html.text
+            ]
+
+
 footerView : Pipeline -> Time -> Bool -> Html Msg
 footerView pipeline now hovered =
     let
@@ -41,6 +41,7 @@ type StepTree
     | Get Step
     | Put Step
     | DependentGet Step
+    | SetPipeline Step
     | Aggregate (Array StepTree)
     | Do (Array StepTree)
     | OnSuccess HookedStep
@@ -144,6 +145,9 @@ init hl resources plan =
         Concourse.BuildStepDependentGet name ->
             initBottom hl DependentGet plan.id name
 
+        Concourse.BuildStepSetPipeline name ->
+            initBottom hl SetPipeline plan.id name
+
         Concourse.BuildStepAggregate plans ->
             let
                 inited =
@@ -265,6 +269,9 @@ treeIsActive tree =
         DependentGet step ->
             stepIsActive step
 
+        SetPipeline step ->
+            stepIsActive step
+
 
 stepIsActive : Step -> Bool
 stepIsActive =
@@ -379,6 +386,9 @@ map f tree =
         DependentGet step ->
             DependentGet (f step)
 
+        SetPipeline step ->
+            SetPipeline (f step)
+
         _ ->
             tree
 
@@ -626,6 +636,9 @@ viewTree model tree =
         Put step ->
             viewStep model step "fa-arrow-up"
 
+        SetPipeline step ->
+            viewStep model step "fa-refresh"
+
         Try step ->
             viewTree model step
 
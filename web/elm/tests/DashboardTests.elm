@@ -1188,6 +1188,7 @@ all =
                                             , name = "pipeline"
                                             , teamName = "team"
                                             , public = True
+                                            , instanceVars = Dict.empty
                                             , jobs = []
                                             , resourceError = False
                                             , status = PipelineStatus.PipelineStatusPending False
@@ -1245,6 +1246,7 @@ all =
                                             , name = "pipeline"
                                             , teamName = "team"
                                             , public = True
+                                            , instanceVars = Dict.empty
                                             , jobs = []
                                             , resourceError = False
                                             , status = PipelineStatus.PipelineStatusPaused
@@ -1943,6 +1945,7 @@ givenPipelineWithJob user =
           , name = "pipeline"
           , paused = False
           , public = True
+          , instanceVars = Dict.empty
           , teamName = "team"
           , groups = []
           }
@@ -1987,6 +1990,7 @@ oneTeamOnePipelinePaused teamName user =
           , name = "pipeline"
           , paused = True
           , public = True
+          , instanceVars = Dict.empty
           , teamName = teamName
           , groups = []
           }
@@ -2006,6 +2010,7 @@ oneTeamOnePipelineNonPublic teamName user =
           , name = "pipeline"
           , paused = False
           , public = False
+          , instanceVars = Dict.empty
           , teamName = teamName
           , groups = []
           }
@@ -2028,6 +2033,7 @@ onePipeline teamName =
     , name = "pipeline"
     , paused = False
     , public = True
+    , instanceVars = Dict.empty
     , teamName = teamName
     , groups = []
     }
@@ -2039,6 +2045,7 @@ onePipelinePaused teamName =
     , name = "pipeline"
     , paused = True
     , public = True
+    , instanceVars = Dict.empty
     , teamName = teamName
     , groups = []
     }
@@ -2058,6 +2065,7 @@ apiData pipelines user =
                                 , name = p
                                 , paused = False
                                 , public = True
+                                , instanceVars = Dict.empty
                                 , teamName = teamName
                                 , groups = []
                                 }
@@ -1,6 +1,7 @@
 module PipelineTests exposing (..)
 
 import Char
+import Dict
 import Expect exposing (..)
 import Html.Attributes as Attr
 import Json.Encode
@@ -623,6 +624,7 @@ all =
                                             , name = "pipeline"
                                             , paused = True
                                             , public = True
+                                            , instanceVars = Dict.empty
                                             , teamName = "team"
                                             , groups = []
                                             }